@@ -0,0 +1,9 @@
+// Package gorm implements contract.ConstrainedPresenceVerifier on top of *gorm.DB,
+// so the exists and unique rules can query a GORM-managed database.
+//
+// It lives in its own module so the core validator does not depend on GORM:
+//
+//	go get github.com/next-trace/scg-validator/adapters/gorm
+//
+//	gormadapter.Register(db, "users", "teams")
+package gorm
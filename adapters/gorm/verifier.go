@@ -0,0 +1,81 @@
+package gorm
+
+import (
+	"context"
+	"sort"
+
+	gormio "gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry/database"
+)
+
+// Verifier checks presence constraints with GORM. Table and column names are quoted by the
+// GORM dialector and values are always bound as query parameters.
+type Verifier struct {
+	db *gormio.DB
+}
+
+// Ensure Verifier implements contract.ConstrainedPresenceVerifier
+var _ contract.ConstrainedPresenceVerifier = (*Verifier)(nil)
+
+// New creates a verifier using db
+func New(db *gormio.DB) *Verifier {
+	return &Verifier{db: db}
+}
+
+// Register creates a verifier using db and registers it for each table
+func Register(db *gormio.DB, tables ...string) *Verifier {
+	verifier := New(db)
+	for _, table := range tables {
+		database.RegisterPresenceVerifier(table, verifier)
+	}
+	return verifier
+}
+
+// Exists reports whether a row with column field equal to value exists in table
+func (v *Verifier) Exists(table, field string, value any) (bool, error) {
+	count, err := v.Count(contract.PresenceQuery{Table: table, Column: field, Value: value})
+	return count > 0, err
+}
+
+// Unique reports whether no row with column field equal to value exists in table
+func (v *Verifier) Unique(table, field string, value any) (bool, error) {
+	count, err := v.Count(contract.PresenceQuery{Table: table, Column: field, Value: value})
+	return count == 0, err
+}
+
+// Count returns the number of rows matching query, running in query.Context when set
+func (v *Verifier) Count(query contract.PresenceQuery) (int64, error) {
+	ctx := query.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	tx := v.db.WithContext(ctx).Table(query.Table).
+		Where(clause.Eq{Column: clause.Column{Name: query.Column}, Value: query.Value})
+	if query.ExceptColumn != "" {
+		tx = tx.Where(clause.Neq{Column: clause.Column{Name: query.ExceptColumn}, Value: query.ExceptValue})
+	}
+
+	// Sort columns so the generated SQL is stable (and statement caches stay effective)
+	columns := make([]string, 0, len(query.Where))
+	for column := range query.Where {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	for _, column := range columns {
+		tx = tx.Where(clause.Eq{Column: clause.Column{Name: column}, Value: query.Where[column]})
+	}
+	for _, column := range query.WhereNull {
+		tx = tx.Where(clause.Eq{Column: clause.Column{Name: column}, Value: nil})
+	}
+	for _, column := range query.WhereNotNull {
+		tx = tx.Where(clause.Neq{Column: clause.Column{Name: column}, Value: nil})
+	}
+
+	var count int64
+	err := tx.Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,87 @@
+package gorm_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	gormio "gorm.io/gorm"
+	"gorm.io/gorm/utils/tests"
+
+	gormadapter "github.com/next-trace/scg-validator/adapters/gorm"
+	"github.com/next-trace/scg-validator/contract"
+)
+
+type tenantKey struct{}
+
+// captured records the last statement built by GORM
+type captured struct {
+	sql    string
+	vars   []any
+	tenant any
+}
+
+func newDryRunDB(t *testing.T, c *captured) *gormio.DB {
+	t.Helper()
+	db, err := gormio.Open(tests.DummyDialector{}, &gormio.Config{DryRun: true})
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	err = db.Callback().Query().After("gorm:query").Register("capture", func(tx *gormio.DB) {
+		c.sql = tx.Statement.SQL.String()
+		c.vars = tx.Statement.Vars
+		c.tenant = tx.Statement.Context.Value(tenantKey{})
+	})
+	if err != nil {
+		t.Fatalf("register callback: %v", err)
+	}
+	return db
+}
+
+func TestVerifier_CountBuildsQuotedConstrainedQuery(t *testing.T) {
+	c := &captured{}
+	verifier := gormadapter.New(newDryRunDB(t, c))
+
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+	_, err := verifier.Count(contract.PresenceQuery{
+		Context:      ctx,
+		Table:        "users",
+		Column:       "email",
+		Value:        "a@b.co",
+		ExceptColumn: "id",
+		ExceptValue:  7,
+		Where:        map[string]any{"tenant_id": "acme", "status": "active"},
+		WhereNull:    []string{"deleted_at"},
+	})
+	if err != nil {
+		t.Fatalf("count: %v", err)
+	}
+
+	want := "SELECT count(*) FROM `users` WHERE `email` = ? AND `id` <> ? AND `status` = ? AND `tenant_id` = ? " +
+		"AND `deleted_at` IS NULL"
+	if strings.TrimSpace(c.sql) != want {
+		t.Fatalf("unexpected SQL:\n got %s\nwant %s", c.sql, want)
+	}
+	if len(c.vars) != 4 || c.vars[0] != "a@b.co" || c.vars[1] != 7 {
+		t.Fatalf("unexpected vars: %#v", c.vars)
+	}
+	if c.tenant != "acme" {
+		t.Fatalf("expected the query context to be propagated, got %v", c.tenant)
+	}
+}
+
+func TestVerifier_ExistsAndUnique(t *testing.T) {
+	c := &captured{}
+	verifier := gormadapter.Register(newDryRunDB(t, c), "accounts")
+
+	// A dry run counts no rows
+	if exists, err := verifier.Exists("accounts", "email", "x"); err != nil || exists {
+		t.Fatalf("Exists() = %v, %v", exists, err)
+	}
+	if unique, err := verifier.Unique("accounts", "email", "x"); err != nil || !unique {
+		t.Fatalf("Unique() = %v, %v", unique, err)
+	}
+	if !strings.Contains(c.sql, "FROM `accounts` WHERE `email` = ?") {
+		t.Fatalf("unexpected SQL: %s", c.sql)
+	}
+}
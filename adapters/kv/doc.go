@@ -0,0 +1,8 @@
+// Package kv backs the exists and unique rules with a key-value store instead of SQL,
+// for session, token or cache-backed lookups such as "token": "exists:sessions".
+//
+// A rule's table and value map to a key ("sessions:<token>" by default). Any store
+// implementing KeyStore works; Redis speaks the Redis protocol without extra dependencies.
+//
+//	kv.Register(kv.NewRedis("localhost:6379"), "sessions")
+package kv
@@ -0,0 +1,185 @@
+package kv_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/next-trace/scg-validator/adapters/kv"
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/validator"
+)
+
+// fakeRedis serves AUTH, SELECT and EXISTS over RESP
+type fakeRedis struct {
+	listener net.Listener
+	password string
+	keys     map[string]bool
+
+	mu       sync.Mutex
+	accepted int
+	commands []string
+}
+
+func startFakeRedis(t *testing.T, password string, keys ...string) *fakeRedis {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen: %v", err)
+	}
+	server := &fakeRedis{listener: listener, password: password, keys: map[string]bool{}}
+	for _, key := range keys {
+		server.keys[key] = true
+	}
+	go server.serve()
+	t.Cleanup(func() { _ = listener.Close() })
+	return server
+}
+
+func (s *fakeRedis) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.accepted++
+		s.mu.Unlock()
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeRedis) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	reader := bufio.NewReader(conn)
+	authed := s.password == ""
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.commands = append(s.commands, strings.Join(args, " "))
+		s.mu.Unlock()
+
+		switch {
+		case args[0] == "AUTH" && args[1] == s.password:
+			authed = true
+			fmt.Fprint(conn, "+OK\r\n")
+		case args[0] == "AUTH":
+			fmt.Fprint(conn, "-WRONGPASS invalid password\r\n")
+		case !authed:
+			fmt.Fprint(conn, "-NOAUTH Authentication required\r\n")
+		case args[0] == "SELECT":
+			fmt.Fprint(conn, "+OK\r\n")
+		case args[0] == "EXISTS" && args[1] == "slow":
+			time.Sleep(200 * time.Millisecond)
+			fmt.Fprint(conn, ":0\r\n")
+		case args[0] == "EXISTS" && s.keys[args[1]]:
+			fmt.Fprint(conn, ":1\r\n")
+		case args[0] == "EXISTS":
+			fmt.Fprint(conn, ":0\r\n")
+		default:
+			fmt.Fprint(conn, "-ERR unknown command\r\n")
+		}
+	}
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	count, _ := strconv.Atoi(strings.TrimSpace(header[1:]))
+	args := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		if _, err := reader.ReadString('\n'); err != nil { // $len
+			return nil, err
+		}
+		arg, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, strings.TrimSuffix(arg, "\r\n"))
+	}
+	return args, nil
+}
+
+func TestRedis_KeyExistsWithAuthAndPooling(t *testing.T) {
+	server := startFakeRedis(t, "s3cret", "sessions:abc")
+	client := kv.NewRedis(server.listener.Addr().String(), kv.WithPassword("s3cret"), kv.WithDB(2))
+	defer func() { _ = client.Close() }()
+
+	for i := 0; i < 3; i++ {
+		exists, err := client.KeyExists(context.Background(), "sessions:abc")
+		if err != nil || !exists {
+			t.Fatalf("KeyExists() = %v, %v", exists, err)
+		}
+	}
+	if exists, err := client.KeyExists(context.Background(), "sessions:nope"); err != nil || exists {
+		t.Fatalf("KeyExists() = %v, %v", exists, err)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if server.accepted != 1 {
+		t.Fatalf("expected a single pooled connection, got %d", server.accepted)
+	}
+	if server.commands[0] != "AUTH s3cret" || server.commands[1] != "SELECT 2" {
+		t.Fatalf("unexpected handshake: %#v", server.commands)
+	}
+}
+
+func TestRedis_Errors(t *testing.T) {
+	server := startFakeRedis(t, "s3cret")
+
+	badAuth := kv.NewRedis(server.listener.Addr().String(), kv.WithPassword("wrong"))
+	if _, err := badAuth.KeyExists(context.Background(), "k"); err == nil || !strings.Contains(err.Error(), "WRONGPASS") {
+		t.Fatalf("expected auth error, got %v", err)
+	}
+
+	client := kv.NewRedis(server.listener.Addr().String(), kv.WithPassword("s3cret"))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.KeyExists(ctx, "slow"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline error, got %v", err)
+	}
+}
+
+// mapStore is an in-memory KeyStore
+type mapStore map[string]bool
+
+func (m mapStore) KeyExists(_ context.Context, key string) (bool, error) { return m[key], nil }
+
+func TestVerifier_BacksExistsRule(t *testing.T) {
+	kv.Register(mapStore{"kv_sessions:abc": true}, "kv_sessions")
+	v := validator.New()
+	rules := map[string]string{"token": "exists:kv_sessions"}
+
+	if res := v.ValidateWithResult(map[string]any{"token": "abc"}, rules); !res.IsValid() {
+		t.Fatalf("expected known session to pass, got %#v", res.Errors())
+	}
+	if res := v.ValidateWithResult(map[string]any{"token": "zzz"}, rules); res.IsValid() {
+		t.Fatal("expected unknown session to fail")
+	}
+}
+
+func TestVerifier_KeyFuncAndConstraints(t *testing.T) {
+	verifier := kv.New(mapStore{"token/abc": true}, kv.WithKeyFunc(func(q contract.PresenceQuery) string {
+		return fmt.Sprintf("%s/%v", q.Column, q.Value)
+	}))
+	if exists, err := verifier.Exists("sessions", "token", "abc"); err != nil || !exists {
+		t.Fatalf("Exists() = %v, %v", exists, err)
+	}
+	_, err := verifier.Count(contract.PresenceQuery{Table: "sessions", WhereNull: []string{"revoked_at"}})
+	if !errors.Is(err, kv.ErrConstraintsUnsupported) {
+		t.Fatalf("expected ErrConstraintsUnsupported, got %v", err)
+	}
+}
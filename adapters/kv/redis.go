@@ -0,0 +1,216 @@
+package kv
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultMaxIdle is the number of idle Redis connections kept for reuse
+const defaultMaxIdle = 4
+
+// ErrUnexpectedReply is returned when Redis answers with a reply of the wrong type
+var ErrUnexpectedReply = errors.New("unexpected redis reply")
+
+// Redis is a minimal Redis client implementing KeyStore with the EXISTS command.
+// Connections are pooled and honor context deadlines and cancellation.
+type Redis struct {
+	addr     string
+	password string
+	db       int
+	maxIdle  int
+	dialer   net.Dialer
+
+	mu   sync.Mutex
+	idle []*redisConn
+}
+
+// Ensure Redis implements KeyStore
+var _ KeyStore = (*Redis)(nil)
+
+// RedisOption configures a Redis client
+type RedisOption func(*Redis)
+
+// WithPassword authenticates new connections with AUTH
+func WithPassword(password string) RedisOption {
+	return func(r *Redis) {
+		r.password = password
+	}
+}
+
+// WithDB selects the logical database of new connections with SELECT
+func WithDB(db int) RedisOption {
+	return func(r *Redis) {
+		r.db = db
+	}
+}
+
+// WithMaxIdle sets how many idle connections are kept for reuse
+func WithMaxIdle(maxIdle int) RedisOption {
+	return func(r *Redis) {
+		r.maxIdle = maxIdle
+	}
+}
+
+// NewRedis creates a client for the Redis server at addr (host:port).
+// Connections are opened lazily.
+func NewRedis(addr string, options ...RedisOption) *Redis {
+	r := &Redis{addr: addr, maxIdle: defaultMaxIdle}
+	for _, option := range options {
+		option(r)
+	}
+	return r
+}
+
+// KeyExists reports whether key exists
+func (r *Redis) KeyExists(ctx context.Context, key string) (bool, error) {
+	conn, err := r.get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	reply, err := conn.do(ctx, "EXISTS", key)
+	if err != nil {
+		_ = conn.Close()
+		return false, err
+	}
+	r.put(conn)
+
+	count, ok := reply.(int64)
+	if !ok {
+		return false, fmt.Errorf("%w: %v", ErrUnexpectedReply, reply)
+	}
+	return count > 0, nil
+}
+
+// Close closes idle connections
+func (r *Redis) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var errs []error
+	for _, conn := range r.idle {
+		errs = append(errs, conn.Close())
+	}
+	r.idle = nil
+	return errors.Join(errs...)
+}
+
+// get returns an idle connection or dials a new one
+func (r *Redis) get(ctx context.Context) (*redisConn, error) {
+	r.mu.Lock()
+	if n := len(r.idle); n > 0 {
+		conn := r.idle[n-1]
+		r.idle = r.idle[:n-1]
+		r.mu.Unlock()
+		return conn, nil
+	}
+	r.mu.Unlock()
+
+	netConn, err := r.dialer.DialContext(ctx, "tcp", r.addr)
+	if err != nil {
+		return nil, err
+	}
+	conn := &redisConn{Conn: netConn, reader: bufio.NewReader(netConn)}
+
+	if r.password != "" {
+		if _, err := conn.do(ctx, "AUTH", r.password); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	if r.db != 0 {
+		if _, err := conn.do(ctx, "SELECT", strconv.Itoa(r.db)); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// put returns a healthy connection to the pool
+func (r *Redis) put(conn *redisConn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.idle) >= r.maxIdle {
+		_ = conn.Close()
+		return
+	}
+	r.idle = append(r.idle, conn)
+}
+
+// redisConn is a connection speaking RESP
+type redisConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+// do sends a command and reads its reply, aborting when ctx is done
+func (c *redisConn) do(ctx context.Context, args ...string) (any, error) {
+	deadline, _ := ctx.Deadline()
+	if err := c.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = c.SetDeadline(time.Unix(1, 0))
+	})
+	defer stop()
+
+	var command strings.Builder
+	fmt.Fprintf(&command, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&command, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := c.Write([]byte(command.String())); err != nil {
+		return nil, contextError(ctx, err)
+	}
+
+	reply, err := c.readReply()
+	return reply, contextError(ctx, err)
+}
+
+// readReply reads a simple string, error or integer reply
+func (c *redisConn) readReply() (any, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, ErrUnexpectedReply
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, fmt.Errorf("redis: %s", line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnexpectedReply, line)
+	}
+}
+
+// contextError prefers the context error over the I/O error it caused. The socket deadline is
+// the context's, so a read can time out just before ctx.Err is set; that is still the deadline.
+func contextError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if deadline, ok := ctx.Deadline(); ok && errors.Is(err, os.ErrDeadlineExceeded) && !time.Now().Before(deadline) {
+		return context.DeadlineExceeded
+	}
+	return err
+}
@@ -0,0 +1,92 @@
+package kv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry/database"
+)
+
+// ErrConstraintsUnsupported is returned for presence queries with constraints (except, where, ...)
+var ErrConstraintsUnsupported = errors.New("key-value presence verifier does not support constraints")
+
+// KeyStore reports whether a key exists. Wrap go-redis, memcached or in-memory caches to use them.
+type KeyStore interface {
+	KeyExists(ctx context.Context, key string) (bool, error)
+}
+
+// KeyFunc maps a presence query to the key to look up
+type KeyFunc func(query contract.PresenceQuery) string
+
+// DefaultKey builds "table:value" keys, e.g. "sessions:3f2a..."
+func DefaultKey(query contract.PresenceQuery) string {
+	return fmt.Sprintf("%s:%v", query.Table, query.Value)
+}
+
+// Verifier checks key existence in a KeyStore
+type Verifier struct {
+	store KeyStore
+	key   KeyFunc
+}
+
+// Ensure Verifier implements contract.ConstrainedPresenceVerifier
+var _ contract.ConstrainedPresenceVerifier = (*Verifier)(nil)
+
+// Option configures a Verifier
+type Option func(*Verifier)
+
+// WithKeyFunc customizes how presence queries map to keys
+func WithKeyFunc(key KeyFunc) Option {
+	return func(v *Verifier) {
+		v.key = key
+	}
+}
+
+// New creates a verifier on top of store
+func New(store KeyStore, options ...Option) *Verifier {
+	v := &Verifier{store: store, key: DefaultKey}
+	for _, option := range options {
+		option(v)
+	}
+	return v
+}
+
+// Register creates a verifier on top of store and registers it for each table (key namespace)
+func Register(store KeyStore, tables ...string) *Verifier {
+	verifier := New(store)
+	for _, table := range tables {
+		database.RegisterPresenceVerifier(table, verifier)
+	}
+	return verifier
+}
+
+// Exists reports whether the key of table and value exists
+func (v *Verifier) Exists(table, field string, value any) (bool, error) {
+	count, err := v.Count(contract.PresenceQuery{Table: table, Column: field, Value: value})
+	return count > 0, err
+}
+
+// Unique reports whether the key of table and value does not exist
+func (v *Verifier) Unique(table, field string, value any) (bool, error) {
+	count, err := v.Count(contract.PresenceQuery{Table: table, Column: field, Value: value})
+	return count == 0, err
+}
+
+// Count returns 1 when the query's key exists and 0 otherwise
+func (v *Verifier) Count(query contract.PresenceQuery) (int64, error) {
+	if query.HasConstraints() {
+		return 0, ErrConstraintsUnsupported
+	}
+	ctx := query.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	exists, err := v.store.KeyExists(ctx, v.key(query))
+	if err != nil || !exists {
+		return 0, err
+	}
+	return 1, nil
+}
@@ -0,0 +1,183 @@
+package metrics
+
+import (
+	"expvar"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// Prometheus metric names exported by WritePrometheus
+const (
+	EvaluationsMetric = "scg_validator_rule_evaluations_total"
+	FailuresMetric    = "scg_validator_rule_failures_total"
+	DurationMetric    = "scg_validator_rule_duration_seconds"
+)
+
+// prometheusContentType is the content type of the Prometheus text exposition format
+const prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultBuckets are the upper bounds, in seconds, of the duration histogram: from 100µs for
+// in-memory rules up to 5s for database and remote rules
+var DefaultBuckets = []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5}
+
+// Bucket is one cumulative histogram bucket: the evaluations that took at most UpperBound seconds
+type Bucket struct {
+	UpperBound float64 `json:"le"`
+	Count      uint64  `json:"count"`
+}
+
+// RuleStats are the metrics of one rule
+type RuleStats struct {
+	Evaluations uint64        `json:"evaluations"`
+	Failures    uint64        `json:"failures"`
+	Duration    time.Duration `json:"duration_ns"`
+	Buckets     []Bucket      `json:"buckets"`
+}
+
+// Collector aggregates rule metrics. It implements contract.RuleObserver, expvar.Var and
+// http.Handler (serving the Prometheus text format).
+type Collector struct {
+	buckets []float64
+
+	mu    sync.Mutex
+	rules map[string]*ruleMetrics
+}
+
+// ruleMetrics are the running metrics of one rule; counts[i] holds the evaluations of bucket i only
+type ruleMetrics struct {
+	evaluations uint64
+	failures    uint64
+	duration    time.Duration
+	counts      []uint64
+}
+
+// Ensure Collector implements contract.RuleObserver
+var _ contract.RuleObserver = (*Collector)(nil)
+
+// NewCollector creates a collector with the given histogram upper bounds in seconds
+// (DefaultBuckets when none are given)
+func NewCollector(buckets ...float64) *Collector {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	bounds := append([]float64{}, buckets...)
+	sort.Float64s(bounds)
+	return &Collector{buckets: bounds, rules: make(map[string]*ruleMetrics)}
+}
+
+// ObserveRule records one evaluation of rule
+func (c *Collector) ObserveRule(rule string, failed bool, duration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	metrics := c.rules[rule]
+	if metrics == nil {
+		metrics = &ruleMetrics{counts: make([]uint64, len(c.buckets))}
+		c.rules[rule] = metrics
+	}
+	metrics.evaluations++
+	if failed {
+		metrics.failures++
+	}
+	metrics.duration += duration
+	seconds := duration.Seconds()
+	for i, bound := range c.buckets {
+		if seconds <= bound {
+			metrics.counts[i]++
+			break
+		}
+	}
+}
+
+// Stats returns a snapshot of the metrics by rule name
+func (c *Collector) Stats() map[string]RuleStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := make(map[string]RuleStats, len(c.rules))
+	for rule, metrics := range c.rules {
+		buckets := make([]Bucket, len(c.buckets))
+		cumulative := uint64(0)
+		for i, bound := range c.buckets {
+			cumulative += metrics.counts[i]
+			buckets[i] = Bucket{UpperBound: bound, Count: cumulative}
+		}
+		stats[rule] = RuleStats{
+			Evaluations: metrics.evaluations,
+			Failures:    metrics.failures,
+			Duration:    metrics.duration,
+			Buckets:     buckets,
+		}
+	}
+	return stats
+}
+
+// Reset discards every metric collected so far
+func (c *Collector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rules = make(map[string]*ruleMetrics)
+}
+
+// String renders the stats as JSON, implementing expvar.Var
+func (c *Collector) String() string {
+	return expvar.Func(func() any { return c.Stats() }).String()
+}
+
+// Publish exports the collector under name on the expvar /debug/vars page.
+// Like expvar.Publish, it panics if name is already in use.
+func (c *Collector) Publish(name string) {
+	expvar.Publish(name, c)
+}
+
+// WritePrometheus writes the metrics in the Prometheus text exposition format
+func (c *Collector) WritePrometheus(w io.Writer) error {
+	stats := c.Stats()
+	rules := make([]string, 0, len(stats))
+	for rule := range stats {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+
+	var out strings.Builder
+	writeHeader(&out, EvaluationsMetric, "counter", "Rule evaluations.")
+	for _, rule := range rules {
+		fmt.Fprintf(&out, "%s{rule=%q} %d\n", EvaluationsMetric, rule, stats[rule].Evaluations)
+	}
+	writeHeader(&out, FailuresMetric, "counter", "Rule evaluations that returned an error.")
+	for _, rule := range rules {
+		fmt.Fprintf(&out, "%s{rule=%q} %d\n", FailuresMetric, rule, stats[rule].Failures)
+	}
+	writeHeader(&out, DurationMetric, "histogram", "Rule evaluation duration in seconds.")
+	for _, rule := range rules {
+		ruleStats := stats[rule]
+		for _, bucket := range ruleStats.Buckets {
+			fmt.Fprintf(&out, "%s_bucket{rule=%q,le=%q} %d\n",
+				DurationMetric, rule, strconv.FormatFloat(bucket.UpperBound, 'g', -1, 64), bucket.Count)
+		}
+		fmt.Fprintf(&out, "%s_bucket{rule=%q,le=\"+Inf\"} %d\n", DurationMetric, rule, ruleStats.Evaluations)
+		fmt.Fprintf(&out, "%s_sum{rule=%q} %s\n",
+			DurationMetric, rule, strconv.FormatFloat(ruleStats.Duration.Seconds(), 'g', -1, 64))
+		fmt.Fprintf(&out, "%s_count{rule=%q} %d\n", DurationMetric, rule, ruleStats.Evaluations)
+	}
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+// ServeHTTP serves the metrics in the Prometheus text format, e.g. on /metrics
+func (c *Collector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", prometheusContentType)
+	_ = c.WritePrometheus(w)
+}
+
+func writeHeader(out *strings.Builder, name, kind, help string) {
+	fmt.Fprintf(out, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
@@ -0,0 +1,83 @@
+package metrics_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/next-trace/scg-validator/adapters/metrics"
+	"github.com/next-trace/scg-validator/engine"
+	"github.com/next-trace/scg-validator/validator"
+)
+
+func TestCollector_RecordsRuleMetrics(t *testing.T) {
+	collector := metrics.NewCollector()
+	v := validator.New(engine.WithMetrics(collector))
+
+	rules := map[string]string{"email": "required|email"}
+	_ = v.Validate(map[string]any{"email": "nope"}, rules)
+	_ = v.Validate(map[string]any{"email": "jane@example.com"}, rules)
+
+	stats := collector.Stats()
+	if got := stats["email"]; got.Evaluations != 2 || got.Failures != 1 {
+		t.Fatalf("unexpected email stats: %#v", got)
+	}
+	if got := stats["required"]; got.Evaluations != 2 || got.Failures != 0 {
+		t.Fatalf("unexpected required stats: %#v", got)
+	}
+	if len(stats["email"].Buckets) != len(metrics.DefaultBuckets) {
+		t.Fatalf("expected one bucket per default bound, got %#v", stats["email"].Buckets)
+	}
+}
+
+func TestCollector_Histogram(t *testing.T) {
+	collector := metrics.NewCollector(0.01, 0.001)
+	collector.ObserveRule("exists", false, 500*time.Microsecond)
+	collector.ObserveRule("exists", true, 5*time.Millisecond)
+	collector.ObserveRule("exists", false, time.Second)
+
+	buckets := collector.Stats()["exists"].Buckets
+	if len(buckets) != 2 || buckets[0].UpperBound != 0.001 || buckets[0].Count != 1 || buckets[1].Count != 2 {
+		t.Fatalf("unexpected cumulative buckets: %#v", buckets)
+	}
+
+	collector.Reset()
+	if len(collector.Stats()) != 0 {
+		t.Fatal("expected Reset to discard the metrics")
+	}
+}
+
+func TestCollector_Exporters(t *testing.T) {
+	collector := metrics.NewCollector(0.5)
+	collector.ObserveRule("email", true, 100*time.Millisecond)
+
+	var decoded map[string]metrics.RuleStats
+	if err := json.Unmarshal([]byte(collector.String()), &decoded); err != nil {
+		t.Fatalf("expected the expvar value to be JSON: %v", err)
+	}
+	if decoded["email"].Failures != 1 {
+		t.Fatalf("unexpected expvar stats: %#v", decoded)
+	}
+
+	recorder := httptest.NewRecorder()
+	collector.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body := recorder.Body.String()
+	for _, line := range []string{
+		"# TYPE scg_validator_rule_evaluations_total counter",
+		`scg_validator_rule_evaluations_total{rule="email"} 1`,
+		`scg_validator_rule_failures_total{rule="email"} 1`,
+		`scg_validator_rule_duration_seconds_bucket{rule="email",le="0.5"} 1`,
+		`scg_validator_rule_duration_seconds_bucket{rule="email",le="+Inf"} 1`,
+		`scg_validator_rule_duration_seconds_sum{rule="email"} 0.1`,
+		`scg_validator_rule_duration_seconds_count{rule="email"} 1`,
+	} {
+		if !strings.Contains(body, line+"\n") {
+			t.Fatalf("expected %q in:\n%s", line, body)
+		}
+	}
+	if got := recorder.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/plain") {
+		t.Fatalf("unexpected content type %q", got)
+	}
+}
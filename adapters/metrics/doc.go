@@ -0,0 +1,9 @@
+// Package metrics collects per-rule execution metrics (evaluations, failures and a duration
+// histogram) and exports them through expvar or the Prometheus text format, without extra
+// dependencies, to find the rules that are slow or fail most in production.
+//
+//	collector := metrics.NewCollector()
+//	collector.Publish("validator_rules")
+//	http.Handle("/metrics", collector)
+//	v := validator.New(engine.WithMetrics(collector))
+package metrics
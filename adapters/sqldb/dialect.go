@@ -0,0 +1,52 @@
+package sqldb
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Dialect selects the placeholder and identifier quoting style of the database
+type Dialect int
+
+const (
+	// Postgres uses $1, $2 placeholders and "double quoted" identifiers
+	Postgres Dialect = iota
+	// MySQL uses ? placeholders and `backtick quoted` identifiers
+	MySQL
+	// SQLite uses ? placeholders and "double quoted" identifiers
+	SQLite
+)
+
+// ErrInvalidIdentifier is returned for table or column names that are not plain identifiers
+var ErrInvalidIdentifier = errors.New("invalid SQL identifier")
+
+// identifierPattern matches one segment of a (possibly schema-qualified) identifier
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// placeholder returns the bind parameter for the n-th argument (1-based)
+func (d Dialect) placeholder(n int) string {
+	if d == Postgres {
+		return "$" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+// quote quotes a table or column name such as "users" or "public.users"
+func (d Dialect) quote(identifier string) (string, error) {
+	quote := `"`
+	if d == MySQL {
+		quote = "`"
+	}
+
+	segments := strings.Split(identifier, ".")
+	for i, segment := range segments {
+		if !identifierPattern.MatchString(segment) {
+			return "", fmt.Errorf("%w: %q", ErrInvalidIdentifier, identifier)
+		}
+		segments[i] = quote + segment + quote
+	}
+	return strings.Join(segments, "."), nil
+}
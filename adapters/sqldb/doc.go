@@ -0,0 +1,7 @@
+// Package sqldb implements contract.ConstrainedPresenceVerifier on top of *sql.DB for the
+// exists and unique rules. Queries are built once per constraint shape and kept as prepared
+// statements, so repeated validations only bind new arguments.
+//
+//	verifier := sqldb.Register(db, sqldb.Postgres, "users", "teams")
+//	defer verifier.Close()
+package sqldb
@@ -0,0 +1,164 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry/database"
+)
+
+// Verifier checks presence constraints with database/sql, caching one prepared
+// statement per generated query.
+type Verifier struct {
+	db      *sql.DB
+	dialect Dialect
+
+	mu    sync.Mutex
+	stmts map[string]*sql.Stmt
+}
+
+// Ensure Verifier implements contract.ConstrainedPresenceVerifier
+var _ contract.ConstrainedPresenceVerifier = (*Verifier)(nil)
+
+// New creates a verifier using db with the placeholder and quoting style of dialect
+func New(db *sql.DB, dialect Dialect) *Verifier {
+	return &Verifier{
+		db:      db,
+		dialect: dialect,
+		stmts:   make(map[string]*sql.Stmt),
+	}
+}
+
+// Register creates a verifier using db and registers it for each table
+func Register(db *sql.DB, dialect Dialect, tables ...string) *Verifier {
+	verifier := New(db, dialect)
+	for _, table := range tables {
+		database.RegisterPresenceVerifier(table, verifier)
+	}
+	return verifier
+}
+
+// Exists reports whether a row with column field equal to value exists in table
+func (v *Verifier) Exists(table, field string, value any) (bool, error) {
+	count, err := v.Count(contract.PresenceQuery{Table: table, Column: field, Value: value})
+	return count > 0, err
+}
+
+// Unique reports whether no row with column field equal to value exists in table
+func (v *Verifier) Unique(table, field string, value any) (bool, error) {
+	count, err := v.Count(contract.PresenceQuery{Table: table, Column: field, Value: value})
+	return count == 0, err
+}
+
+// Count returns the number of rows matching query, running in query.Context when set
+func (v *Verifier) Count(query contract.PresenceQuery) (int64, error) {
+	ctx := query.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	statement, args, err := v.buildQuery(query)
+	if err != nil {
+		return 0, err
+	}
+
+	stmt, err := v.prepare(ctx, statement)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	err = stmt.QueryRowContext(ctx, args...).Scan(&count)
+	return count, err
+}
+
+// prepare returns the cached prepared statement for query, preparing it on first use
+func (v *Verifier) prepare(ctx context.Context, query string) (*sql.Stmt, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if stmt, ok := v.stmts[query]; ok {
+		return stmt, nil
+	}
+	stmt, err := v.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	v.stmts[query] = stmt
+	return stmt, nil
+}
+
+// Close closes all cached prepared statements
+func (v *Verifier) Close() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	var errs []error
+	for query, stmt := range v.stmts {
+		errs = append(errs, stmt.Close())
+		delete(v.stmts, query)
+	}
+	return errors.Join(errs...)
+}
+
+// buildQuery renders the COUNT query of a presence check and its arguments.
+// Where columns are sorted so equal constraint sets share one prepared statement.
+func (v *Verifier) buildQuery(query contract.PresenceQuery) (string, []any, error) {
+	table, err := v.dialect.quote(query.Table)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var conditions []string
+	var args []any
+	addCondition := func(column, operator string, value any, bind bool) error {
+		quoted, err := v.dialect.quote(column)
+		if err != nil {
+			return err
+		}
+		if !bind {
+			conditions = append(conditions, quoted+" "+operator)
+			return nil
+		}
+		args = append(args, value)
+		conditions = append(conditions, quoted+" "+operator+" "+v.dialect.placeholder(len(args)))
+		return nil
+	}
+
+	if err := addCondition(query.Column, "=", query.Value, true); err != nil {
+		return "", nil, err
+	}
+	if query.ExceptColumn != "" {
+		if err := addCondition(query.ExceptColumn, "<>", query.ExceptValue, true); err != nil {
+			return "", nil, err
+		}
+	}
+
+	columns := make([]string, 0, len(query.Where))
+	for column := range query.Where {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	for _, column := range columns {
+		if err := addCondition(column, "=", query.Where[column], true); err != nil {
+			return "", nil, err
+		}
+	}
+	for _, column := range query.WhereNull {
+		if err := addCondition(column, "IS NULL", nil, false); err != nil {
+			return "", nil, err
+		}
+	}
+	for _, column := range query.WhereNotNull {
+		if err := addCondition(column, "IS NOT NULL", nil, false); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return "SELECT COUNT(*) FROM " + table + " WHERE " + strings.Join(conditions, " AND "), args, nil
+}
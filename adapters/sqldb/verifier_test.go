@@ -0,0 +1,148 @@
+package sqldb_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/next-trace/scg-validator/adapters/sqldb"
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// recorder collects what the fake driver was asked to do
+type recorder struct {
+	mu       sync.Mutex
+	prepared []string
+	args     [][]driver.Value
+	count    int64
+}
+
+var (
+	recorders    sync.Map
+	registerOnce sync.Once
+)
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	rec, _ := recorders.Load(name)
+	return &fakeConn{rec: rec.(*recorder)}, nil
+}
+
+type fakeConn struct{ rec *recorder }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	c.rec.mu.Lock()
+	defer c.rec.mu.Unlock()
+	c.rec.prepared = append(c.rec.prepared, query)
+	return &fakeStmt{rec: c.rec}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type fakeStmt struct{ rec *recorder }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec(_ []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.rec.mu.Lock()
+	defer s.rec.mu.Unlock()
+	s.rec.args = append(s.rec.args, args)
+	return &fakeRows{count: s.rec.count}, nil
+}
+
+type fakeRows struct {
+	count int64
+	done  bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"count"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.count
+	return nil
+}
+
+func openFake(t *testing.T) (*sql.DB, *recorder) {
+	t.Helper()
+	registerOnce.Do(func() { sql.Register("sqldb_fake", fakeDriver{}) })
+	rec := &recorder{}
+	recorders.Store(t.Name(), rec)
+	db, err := sql.Open("sqldb_fake", t.Name())
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	return db, rec
+}
+
+func TestVerifier_DialectsAndStatementCache(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect sqldb.Dialect
+		want    string
+	}{
+		{"postgres", sqldb.Postgres, `SELECT COUNT(*) FROM "public"."users" WHERE "email" = $1 AND "id" <> $2 ` +
+			`AND "tenant_id" = $3 AND "deleted_at" IS NULL`},
+		{"mysql", sqldb.MySQL, "SELECT COUNT(*) FROM `public`.`users` WHERE `email` = ? AND `id` <> ? AND `tenant_id` = ? " +
+			"AND `deleted_at` IS NULL"},
+		{"sqlite", sqldb.SQLite, `SELECT COUNT(*) FROM "public"."users" WHERE "email" = ? AND "id" <> ? ` +
+			`AND "tenant_id" = ? AND "deleted_at" IS NULL`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, rec := openFake(t)
+			verifier := sqldb.New(db, tt.dialect)
+			defer func() { _ = verifier.Close() }()
+
+			for _, email := range []string{"a@b.co", "c@d.co"} {
+				count, err := verifier.Count(contract.PresenceQuery{
+					Context: context.Background(),
+					Table:   "public.users", Column: "email", Value: email,
+					ExceptColumn: "id", ExceptValue: int64(7),
+					Where:     map[string]any{"tenant_id": "acme"},
+					WhereNull: []string{"deleted_at"},
+				})
+				if err != nil || count != 0 {
+					t.Fatalf("Count() = %d, %v", count, err)
+				}
+			}
+
+			if len(rec.prepared) != 1 || rec.prepared[0] != tt.want {
+				t.Fatalf("expected one cached statement %q, got %#v", tt.want, rec.prepared)
+			}
+			if len(rec.args) != 2 || rec.args[1][0] != "c@d.co" || rec.args[1][1] != int64(7) {
+				t.Fatalf("unexpected bound arguments: %#v", rec.args)
+			}
+		})
+	}
+}
+
+func TestVerifier_ExistsUniqueAndIdentifiers(t *testing.T) {
+	db, rec := openFake(t)
+	rec.count = 1
+	verifier := sqldb.Register(db, sqldb.SQLite, "sqldb_users")
+
+	if exists, err := verifier.Exists("sqldb_users", "email", "a@b.co"); err != nil || !exists {
+		t.Fatalf("Exists() = %v, %v", exists, err)
+	}
+	if unique, err := verifier.Unique("sqldb_users", "email", "a@b.co"); err != nil || unique {
+		t.Fatalf("Unique() = %v, %v", unique, err)
+	}
+	if _, err := verifier.Exists("users; DROP TABLE users", "email", "x"); !errors.Is(err, sqldb.ErrInvalidIdentifier) {
+		t.Fatalf("expected ErrInvalidIdentifier, got %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+package builder
+
+import (
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/parser"
+	"github.com/next-trace/scg-validator/rules"
+)
+
+// Condition decides at build time whether a conditional block applies
+type Condition func(data contract.DataProvider) bool
+
+// Builder composes field rules into the map[string]string accepted by the validator
+type Builder struct {
+	fields []string
+	rules  map[string][]string
+	blocks []conditionalBlock
+	bases  []*contract.RuleSet
+}
+
+// conditionalBlock is a group of field rules guarded by a condition
+type conditionalBlock struct {
+	condition Condition
+	negate    bool
+	apply     func(b *Builder)
+}
+
+// New creates an empty Builder
+func New() *Builder {
+	return &Builder{rules: make(map[string][]string)}
+}
+
+// Field appends rules to a field. Each rule may itself be a pipe-separated rule string.
+func (b *Builder) Field(field string, rules ...string) *Builder {
+	if _, exists := b.rules[field]; !exists {
+		b.fields = append(b.fields, field)
+	}
+	for _, rule := range rules {
+		if rule != "" {
+			b.rules[field] = append(b.rules[field], rule)
+		}
+	}
+	if b.rules[field] == nil {
+		b.rules[field] = []string{}
+	}
+	return b
+}
+
+// Warn appends rules to a field whose failures are reported as warnings (see Result.Warnings)
+// instead of errors, for soft data-quality checks. Each rule may be a pipe-separated rule string.
+func (b *Builder) Warn(field string, rules ...string) *Builder {
+	warnings := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		for _, component := range parser.SplitRules(rule) {
+			if component != "" && !strings.HasPrefix(component, parser.WarningPrefix) {
+				component = parser.WarningPrefix + component
+			}
+			warnings = append(warnings, component)
+		}
+	}
+	return b.Field(field, parser.JoinRules(warnings))
+}
+
+// Extends composes the builder on top of a base rule set (e.g. shared audit or pagination fields).
+// The builder's rules merge into the base ones with rules.MergeRules; see BuildRuleSet for messages
+// and attributes. Several bases are merged in the order they are given.
+func (b *Builder) Extends(base *contract.RuleSet) *Builder {
+	b.bases = append(b.bases, base)
+	return b
+}
+
+// When adds the rules declared by fn only if condition reports true for the validated data
+func (b *Builder) When(condition Condition, fn func(b *Builder)) *Builder {
+	b.blocks = append(b.blocks, conditionalBlock{condition: condition, apply: fn})
+	return b
+}
+
+// Unless adds the rules declared by fn only if condition reports false for the validated data
+func (b *Builder) Unless(condition Condition, fn func(b *Builder)) *Builder {
+	b.blocks = append(b.blocks, conditionalBlock{condition: condition, negate: true, apply: fn})
+	return b
+}
+
+// Build resolves the conditional blocks against data and returns the rule map.
+// Rules added to the same field by several blocks are joined in declaration order.
+func (b *Builder) Build(data contract.DataProvider) map[string]string {
+	return b.BuildRuleSet(data).Rules
+}
+
+// BuildRuleSet resolves the builder like Build and merges the result into the extended bases
+// with rules.Merge, so their messages, attributes and profiles are kept.
+func (b *Builder) BuildRuleSet(data contract.DataProvider) *contract.RuleSet {
+	resolved := b.resolve(data)
+
+	built := contract.NewRuleSet()
+	for _, field := range resolved.fields {
+		built.Rules[field] = strings.Join(resolved.rules[field], "|")
+	}
+	if len(b.bases) == 0 {
+		return built
+	}
+	return rules.Merge(nil, append(append([]*contract.RuleSet{}, b.bases...), built)...)
+}
+
+// resolve flattens the conditional blocks into a builder without conditions
+func (b *Builder) resolve(data contract.DataProvider) *Builder {
+	resolved := New()
+	for _, field := range b.fields {
+		resolved.Field(field, b.rules[field]...)
+	}
+
+	for _, block := range b.blocks {
+		if block.condition(data) == block.negate {
+			continue
+		}
+		nested := New()
+		block.apply(nested)
+		nested = nested.resolve(data)
+		for _, field := range nested.fields {
+			resolved.Field(field, nested.rules[field]...)
+		}
+	}
+	return resolved
+}
@@ -0,0 +1,83 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func isPremium(data contract.DataProvider) bool {
+	plan, _ := data.Get("plan")
+	return plan == "premium"
+}
+
+func TestBuilder_WhenUnless(t *testing.T) {
+	b := New().
+		Field("plan", "required").
+		When(isPremium, func(b *Builder) {
+			b.Field("card_number", "required", "digits:16")
+			b.Field("plan", "in:premium,basic")
+		}).
+		Unless(isPremium, func(b *Builder) {
+			b.Field("coupon", "nullable|string")
+		})
+
+	premium := b.Build(contract.NewSimpleDataProvider(map[string]any{"plan": "premium"}))
+	if premium["card_number"] != "required|digits:16" || premium["plan"] != "required|in:premium,basic" {
+		t.Fatalf("unexpected premium rules: %#v", premium)
+	}
+	if _, ok := premium["coupon"]; ok {
+		t.Fatalf("Unless block must be skipped: %#v", premium)
+	}
+
+	basic := b.Build(contract.NewSimpleDataProvider(map[string]any{"plan": "basic"}))
+	if _, ok := basic["card_number"]; ok || basic["coupon"] != "nullable|string" || basic["plan"] != "required" {
+		t.Fatalf("unexpected basic rules: %#v", basic)
+	}
+}
+
+func TestBuilder_NestedBlocks(t *testing.T) {
+	always := func(contract.DataProvider) bool { return true }
+	rules := New().When(always, func(b *Builder) {
+		b.When(always, func(b *Builder) { b.Field("deep", "required") })
+	}).Build(contract.NewSimpleDataProvider(nil))
+
+	if rules["deep"] != "required" {
+		t.Fatalf("expected nested block to apply, got %#v", rules)
+	}
+}
+
+func TestBuilder_Warn(t *testing.T) {
+	rules := New().
+		Field("bio", "nullable|string").
+		Warn("bio", "min:20|warn:max:500", `regex:[^a|b]`).
+		Build(contract.NewSimpleDataProvider(nil))
+
+	if want := `nullable|string|warn:min:20|warn:max:500|warn:regex:[^a|b]`; rules["bio"] != want {
+		t.Fatalf("got %q, want %q", rules["bio"], want)
+	}
+}
+
+func TestBuilder_Extends(t *testing.T) {
+	pagination := contract.NewRuleSet()
+	pagination.Rules["page"] = "integer|min:1"
+	pagination.Rules["per_page"] = "integer|max:100"
+	pagination.Attributes["per_page"] = "page size"
+
+	b := New().
+		Extends(pagination).
+		Field("per_page", "max:50").
+		Field("query", "required")
+
+	set := b.BuildRuleSet(contract.NewSimpleDataProvider(map[string]any{}))
+	rules := set.Rules
+	if rules["page"] != "integer|min:1" || rules["per_page"] != "integer|max:50" || rules["query"] != "required" {
+		t.Fatalf("unexpected rules: %#v", set.Rules)
+	}
+	if set.Attributes["per_page"] != "page size" {
+		t.Fatalf("expected base attributes to be kept: %#v", set.Attributes)
+	}
+	if pagination.Rules["per_page"] != "integer|max:100" {
+		t.Fatal("extending must not modify the base rule set")
+	}
+}
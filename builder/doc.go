@@ -0,0 +1,3 @@
+// Package builder offers a fluent API for composing rule maps, including
+// conditional blocks that are added or skipped based on runtime data.
+package builder
@@ -0,0 +1,129 @@
+// Command scg-validator generates typed validators from struct tags or rule set documents.
+//
+//	//go:generate go run github.com/next-trace/scg-validator/cmd/scg-validator gen -type SignupRequest
+//	//go:generate go run github.com/next-trace/scg-validator/cmd/scg-validator gen -rules signup.yaml -name Signup
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/next-trace/scg-validator/engine"
+	"github.com/next-trace/scg-validator/gen"
+	"github.com/next-trace/scg-validator/rules/loader"
+)
+
+const usage = `usage: scg-validator gen [flags]
+
+Generates ValidateX functions, field constants and rule sets for the struct types
+given by -type, or for the rule set document given by -rules. The validators of the
+types listed in -static run generated code instead of the validator.
+
+`
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "gen" {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err := runGen(os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "scg-validator: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// runGen runs the gen command with its arguments
+func runGen(args []string) error {
+	flags := flag.NewFlagSet("gen", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+	types := flags.String("type", "", "comma-separated struct types to generate validators for")
+	rulesFile := flags.String("rules", "", "YAML or JSON rule set document to generate a validator for")
+	name := flags.String("name", "", "name of the validator generated for -rules (default: -type)")
+	dir := flags.String("dir", ".", "directory of the package holding the types")
+	pkgName := flags.String("pkg", "", "package of the generated file (default: the package in -dir)")
+	embed := flags.String("embed", string(engine.EmbedPrefixed), "keying of embedded struct fields: prefix or flatten")
+	output := flags.String("output", "", "output file (default: <name>_validator.go in -dir)")
+	static := flags.String("static", "", "comma-separated types whose validators are generated as static code")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	schemas, pkg, err := loadSchemas(*types, *rulesFile, *name, *dir, engine.EmbedMode(*embed))
+	if err != nil {
+		return err
+	}
+	if *pkgName != "" {
+		pkg = *pkgName
+	}
+	for _, typeName := range strings.Split(*static, ",") {
+		for _, schema := range schemas {
+			if schema.Type != "" && schema.Type == strings.TrimSpace(typeName) {
+				schema.Static = true
+			}
+		}
+	}
+
+	source, err := gen.Generate(pkg, schemas...)
+	if err != nil {
+		return err
+	}
+	path := *output
+	if path == "" {
+		path = filepath.Join(*dir, strings.ToLower(schemas[0].Name)+"_validator.go")
+	}
+	return os.WriteFile(path, source, 0o600)
+}
+
+// loadSchemas returns the schemas to generate and the package they belong to
+func loadSchemas(types, rulesFile, name, dir string, mode engine.EmbedMode) ([]*gen.Schema, string, error) {
+	pkgName := os.Getenv("GOPACKAGE")
+	pkg, pkgErr := gen.LoadPackage(dir)
+	if pkgErr == nil {
+		pkgName = pkg.Name
+	}
+
+	if strings.Contains(types, ",") && (rulesFile != "" || name != "") {
+		return nil, "", errors.New("-rules and -name take a single -type")
+	}
+	if rulesFile != "" {
+		if name == "" {
+			name = types
+		}
+		if name == "" {
+			return nil, "", errors.New("-rules requires -name or -type")
+		}
+		set, err := loader.LoadFile(rulesFile)
+		if err != nil {
+			return nil, "", err
+		}
+		schema := gen.FromRuleSet(name, types, set)
+		schema.Embedding = mode
+		return []*gen.Schema{schema}, pkgName, nil
+	}
+
+	if types == "" {
+		return nil, "", errors.New("-type or -rules is required")
+	}
+	if pkgErr != nil {
+		return nil, "", pkgErr
+	}
+	var schemas []*gen.Schema
+	for _, typeName := range strings.Split(types, ",") {
+		schema, err := pkg.Struct(strings.TrimSpace(typeName), mode)
+		if err != nil {
+			return nil, "", err
+		}
+		if name != "" {
+			schema.Name = name
+		}
+		schemas = append(schemas, schema)
+	}
+	return schemas, pkgName, nil
+}
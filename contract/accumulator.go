@@ -0,0 +1,41 @@
+package contract
+
+// ResultAccumulator is the Result the engine records outcomes into while validating. Frameworks
+// can supply their own (e.g. one that streams errors or caps their number) through a
+// ResultFactory instead of forking the engine; embed *ValidationErrors to override a single method.
+type ResultAccumulator interface {
+	Result
+	ValidatedResult
+
+	// AddRuleError records the error message of a failed rule for field
+	AddRuleError(field string, rule ParsedRule, message string)
+
+	// AddWarning records the failure of a warning rule for field
+	AddWarning(field, message string)
+
+	// AddSkipped records that rule was not evaluated for field
+	AddSkipped(field, rule string)
+
+	// AddScore records the weight of a rule evaluated in scoring mode
+	AddScore(field string, weight float64, failed bool)
+
+	// SetValidated replaces the validated input carried by the result
+	SetValidated(data map[string]any)
+
+	// MarkTruncated records that errors were left out, e.g. by an error budget
+	MarkTruncated()
+}
+
+// ResultFactory creates the accumulator of one validation run
+type ResultFactory func() ResultAccumulator
+
+// Ensure ValidationErrors implements ResultAccumulator and the optional result interfaces
+var (
+	_ ResultAccumulator = (*ValidationErrors)(nil)
+	_ RuleErrorsResult  = (*ValidationErrors)(nil)
+	_ WarningsResult    = (*ValidationErrors)(nil)
+	_ ScoredResult      = (*ValidationErrors)(nil)
+	_ FilterableResult  = (*ValidationErrors)(nil)
+	_ SkippedResult     = (*ValidationErrors)(nil)
+	_ TruncatedResult   = (*ValidationErrors)(nil)
+)
@@ -1,14 +1,19 @@
 package contract
 
+import "context"
+
 // ValidationContext is a concrete implementation of RuleContext
 // Provides context for a single validation rule execution.
 // ValidationContext provides context for validator operations
 type ValidationContext struct {
-	field      string
-	value      any
-	parameters []string
-	data       map[string]any
-	Attributes map[string]string // Custom attribute names
+	field         string
+	value         any
+	parameters    []string
+	data          map[string]any
+	ctx           context.Context
+	coerceStrings bool
+	required      RequiredPreset
+	Attributes    map[string]string // Custom attribute names
 }
 
 // NewValidationContext creates a new ValidationContext instance
@@ -27,6 +32,53 @@ func (ctx *ValidationContext) Value() any           { return ctx.value }
 func (ctx *ValidationContext) Parameters() []string { return ctx.parameters }
 func (ctx *ValidationContext) Data() map[string]any { return ctx.data }
 
+// Context returns the request context the rule runs in, context.Background() when none was set.
+// Rules performing I/O (database, network) should honor it.
+func (ctx *ValidationContext) Context() context.Context {
+	if ctx.ctx == nil {
+		return context.Background()
+	}
+	return ctx.ctx
+}
+
+// ContextOf returns the request context a rule runs in: the Context of rule contexts carrying
+// one, such as *ValidationContext, and context.Background() otherwise
+func ContextOf(ctx RuleContext) context.Context {
+	if carrier, ok := ctx.(interface{ Context() context.Context }); ok {
+		return carrier.Context()
+	}
+	return context.Background()
+}
+
+// WithContext sets the request context and returns ctx for chaining
+func (ctx *ValidationContext) WithContext(requestCtx context.Context) *ValidationContext {
+	ctx.ctx = requestCtx
+	return ctx
+}
+
+// CoercesStrings reports whether string rules accept encoding.TextMarshaler and
+// fmt.Stringer values through their text form
+func (ctx *ValidationContext) CoercesStrings() bool {
+	return ctx.coerceStrings
+}
+
+// WithStringCoercion enables or disables string coercion and returns ctx for chaining
+func (ctx *ValidationContext) WithStringCoercion(enabled bool) *ValidationContext {
+	ctx.coerceStrings = enabled
+	return ctx
+}
+
+// RequiredPreset reports what the required rule considers empty
+func (ctx *ValidationContext) RequiredPreset() RequiredPreset {
+	return ctx.required
+}
+
+// WithRequiredPreset sets what the required rule considers empty and returns ctx for chaining
+func (ctx *ValidationContext) WithRequiredPreset(preset RequiredPreset) *ValidationContext {
+	ctx.required = preset
+	return ctx
+}
+
 func (ctx *ValidationContext) Attribute(field string) string {
 	if attr, exists := ctx.Attributes[field]; exists {
 		return attr
@@ -1,6 +1,9 @@
 package contract
 
-import "testing"
+import (
+	"context"
+	"testing"
+)
 
 func TestValidationContext(t *testing.T) {
 	ctx := NewValidationContext("email", "a@b.com", []string{"p1"}, map[string]any{"x": 1})
@@ -18,3 +21,22 @@ func TestValidationContext(t *testing.T) {
 		t.Fatal("custom attribute not applied")
 	}
 }
+
+type ctxKey struct{}
+
+func TestValidationContext_Context(t *testing.T) {
+	ctx := NewValidationContext("email", "a@b.com", nil, nil)
+	if ctx.Context() != context.Background() {
+		t.Fatal("expected background context by default")
+	}
+	requestCtx := context.WithValue(context.Background(), ctxKey{}, "req")
+	if ctx.WithContext(requestCtx).Context().Value(ctxKey{}) != "req" {
+		t.Fatal("request context not propagated")
+	}
+	if ContextOf(ctx).Value(ctxKey{}) != "req" {
+		t.Fatal("expected ContextOf to return the request context")
+	}
+	if ContextOf(nil) != context.Background() {
+		t.Fatal("expected ContextOf to default to the background context")
+	}
+}
@@ -0,0 +1,10 @@
+package contract
+
+import "context"
+
+// DisposableEmailProvider tells whether email domains belong to throwaway email services, for the
+// email_not_disposable rule
+type DisposableEmailProvider interface {
+	// IsDisposable reports whether domain, lowercased and in its ASCII (punycode) form, is disposable
+	IsDisposable(ctx context.Context, domain string) (bool, error)
+}
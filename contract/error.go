@@ -10,9 +10,15 @@ import (
 
 // Common validator errors
 var (
-	ErrRuleNotFound = errors.New("rule not found")
-	ErrInvalidRule  = errors.New("invalid rule")
-	ErrInvalidData  = errors.New("invalid data")
+	ErrRuleNotFound    = errors.New("rule not found")
+	ErrInvalidRule     = errors.New("invalid rule")
+	ErrInvalidData     = errors.New("invalid data")
+	ErrUnauthorized    = errors.New("request is not authorized")
+	ErrRuleSetNotFound = errors.New("rule set not found")
+
+	// ErrRuleSkipped is returned (possibly wrapped) by rule middleware that did not evaluate a rule,
+	// e.g. because a rate limit was hit. The engine reports it through Result.Skipped.
+	ErrRuleSkipped = errors.New("rule skipped")
 )
 
 // IsValidationFailed checks if an error is a validator failure
@@ -24,3 +30,51 @@ func IsValidationFailed(err error) bool {
 func NewValidationError(format string, args ...interface{}) error {
 	return fmt.Errorf(format, args...)
 }
+
+// ExternalError reports that the external resource of a contract.ExternalRule could not be
+// reached (database down, DNS timeout), as opposed to the value failing the rule. Circuit
+// breakers count these errors.
+type ExternalError struct {
+	Resource string
+	Err      error
+}
+
+// Error returns the message of the underlying error
+func (e *ExternalError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *ExternalError) Unwrap() error {
+	return e.Err
+}
+
+// IsExternalError reports whether err is or wraps an *ExternalError
+func IsExternalError(err error) bool {
+	var external *ExternalError
+	return errors.As(err, &external)
+}
+
+// warningError marks a rule failure to be reported as a warning
+type warningError struct {
+	error
+}
+
+func (e warningError) Unwrap() error {
+	return e.error
+}
+
+// AsWarning wraps err so the engine reports the rule failure as a warning instead of an error,
+// e.g. from rule middleware degrading a rule whose dependency is down
+func AsWarning(err error) error {
+	if err == nil {
+		return nil
+	}
+	return warningError{err}
+}
+
+// IsWarning reports whether err was wrapped with AsWarning
+func IsWarning(err error) bool {
+	var warning warningError
+	return errors.As(err, &warning)
+}
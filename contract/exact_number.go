@@ -0,0 +1,10 @@
+package contract
+
+import "math/big"
+
+// ExactNumber is implemented by arbitrary-precision decimal types (e.g. shopspring/decimal.Decimal)
+// so numeric rules can compare them exactly instead of through float64.
+type ExactNumber interface {
+	// Rat returns the exact rational value of the decimal
+	Rat() *big.Rat
+}
@@ -0,0 +1,46 @@
+package contract
+
+import (
+	"strconv"
+	"strings"
+)
+
+// KeyStyle controls how nested field paths are rendered as error keys
+type KeyStyle int
+
+const (
+	// KeyStyleDot renders keys in dot notation, e.g. items.0.name (default)
+	KeyStyleDot KeyStyle = iota
+	// KeyStyleBracket renders list indexes in brackets, e.g. items[0].name
+	KeyStyleBracket
+	// KeyStylePointer renders keys as RFC 6901 JSON Pointers, e.g. /items/0/name
+	KeyStylePointer
+)
+
+// Format renders a dot-notation path in the key style
+func (s KeyStyle) Format(path string) string {
+	switch s {
+	case KeyStyleBracket:
+		var b strings.Builder
+		for i, segment := range strings.Split(path, ".") {
+			if _, err := strconv.Atoi(segment); err == nil && i > 0 {
+				b.WriteString("[" + segment + "]")
+				continue
+			}
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(segment)
+		}
+		return b.String()
+	case KeyStylePointer:
+		escaper := strings.NewReplacer("~", "~0", "/", "~1")
+		segments := strings.Split(path, ".")
+		for i, segment := range segments {
+			segments[i] = escaper.Replace(segment)
+		}
+		return "/" + strings.Join(segments, "/")
+	default:
+		return path
+	}
+}
@@ -0,0 +1,24 @@
+package contract
+
+import "testing"
+
+func TestKeyStyle_Format(t *testing.T) {
+	tests := []struct {
+		style KeyStyle
+		path  string
+		want  string
+	}{
+		{KeyStyleDot, "items.0.name", "items.0.name"},
+		{KeyStyleBracket, "items.0.name", "items[0].name"},
+		{KeyStyleBracket, "matrix.1.2", "matrix[1][2]"},
+		{KeyStyleBracket, "email", "email"},
+		{KeyStylePointer, "items.0.name", "/items/0/name"},
+		{KeyStylePointer, "a/b.c~d", "/a~1b/c~0d"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.style.Format(tt.path); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package contract
+
+import "context"
+
+// RateLimiter caps the rate of external rule evaluations. *rate.Limiter from
+// golang.org/x/time/rate implements it.
+type RateLimiter interface {
+	// Allow reports whether an evaluation may happen now
+	Allow() bool
+
+	// Wait blocks until an evaluation may happen or ctx is done
+	Wait(ctx context.Context) error
+}
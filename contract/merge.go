@@ -0,0 +1,125 @@
+package contract
+
+import "strings"
+
+// MergeOption configures how a result is merged into another
+type MergeOption func(*mergeConfig)
+
+type mergeConfig struct {
+	prefix string
+}
+
+// WithKeyPrefix nests the merged errors under prefix ("body" turns "email" into "body.email",
+// "/email" into "/body/email" and an empty key into "body"). The merged Validated() input is
+// nested under the same prefix.
+func WithKeyPrefix(prefix string) MergeOption {
+	return func(c *mergeConfig) {
+		c.prefix = prefix
+	}
+}
+
+// MergeResults combines the results of several validation steps (e.g. headers, body and a business
+// hook) into one. Results are merged in order, so messages of earlier steps come first.
+func MergeResults(results ...Result) *ValidationErrors {
+	merged := NewValidationErrors()
+	for _, result := range results {
+		merged.Merge(result)
+	}
+	return merged
+}
+
+// Merge adds the errors, warnings, failed and skipped rules, truncation and validated input of other to ve
+// and returns ve. The scores of results created by the engine are combined as well.
+func (ve *ValidationErrors) Merge(other Result, options ...MergeOption) *ValidationErrors {
+	if other == nil {
+		return ve
+	}
+	var config mergeConfig
+	for _, option := range options {
+		option(&config)
+	}
+
+	for field, messages := range other.Errors() {
+		key := prefixKey(config.prefix, field)
+		ve.errors[key] = append(ve.errors[key], messages...)
+	}
+	for rule, fields := range ByRuleOf(other) {
+		merged := ve.ruleFields(rule)
+		for field, messages := range fields {
+			key := prefixKey(config.prefix, field)
+			merged[key] = append(merged[key], messages...)
+		}
+	}
+	for field, messages := range WarningsOf(other) {
+		key := prefixKey(config.prefix, field)
+		ve.warnings[key] = append(ve.warnings[key], messages...)
+	}
+	for field, rules := range SkippedOf(other) {
+		key := prefixKey(config.prefix, field)
+		ve.skipped[key] = append(ve.skipped[key], rules...)
+	}
+	for field, rules := range FailedOf(other) {
+		for rule, params := range rules {
+			ve.failedRules(prefixKey(config.prefix, field))[rule] = append([]string{}, params...)
+		}
+	}
+
+	ve.truncated = ve.truncated || TruncatedOf(other)
+
+	if scored, ok := other.(*ValidationErrors); ok {
+		ve.scoreTotal += scored.scoreTotal
+		for field, weight := range scored.scoreDeducted {
+			ve.scoreDeducted[prefixKey(config.prefix, field)] += weight
+		}
+	}
+
+	if validated := ValidatedOf(other); len(validated) > 0 {
+		if ve.validated == nil {
+			ve.validated = make(map[string]any)
+		}
+		if config.prefix != "" {
+			ve.validated[config.prefix] = validated
+		} else {
+			for key, value := range validated {
+				ve.validated[key] = value
+			}
+		}
+	}
+	return ve
+}
+
+// ruleFields returns the errors by field of rule, creating them on first use
+func (ve *ValidationErrors) ruleFields(rule string) map[string][]string {
+	fields := ve.byRule[rule]
+	if fields == nil {
+		fields = make(map[string][]string)
+		ve.byRule[rule] = fields
+	}
+	return fields
+}
+
+// failedRules returns the failed rules of field, creating them on first use
+func (ve *ValidationErrors) failedRules(field string) map[string][]string {
+	rules := ve.failed[field]
+	if rules == nil {
+		rules = make(map[string][]string)
+		ve.failed[field] = rules
+	}
+	return rules
+}
+
+// prefixKey nests an error key, in any KeyStyle, under prefix
+func prefixKey(prefix, key string) string {
+	switch {
+	case prefix == "":
+		return key
+	case key == "":
+		return prefix
+	case strings.HasPrefix(key, "/"):
+		return "/" + strings.TrimPrefix(prefix, "/") + key
+	case strings.HasPrefix(key, "["):
+		return prefix + key
+	default:
+		return prefix + "." + key
+	}
+}
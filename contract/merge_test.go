@@ -0,0 +1,56 @@
+package contract
+
+import "testing"
+
+func TestMergeResults(t *testing.T) {
+	headers := NewValidationErrors()
+	headers.AddRuleError("x-request-id", ParsedRule{Name: "uuid"}, "invalid request id")
+
+	body := NewValidationErrors()
+	body.AddRuleError("email", ParsedRule{Name: "email"}, "invalid email")
+	body.SetValidated(map[string]any{"name": "Ada"})
+
+	merged := MergeResults(headers, body, nil)
+	if len(merged.Errors()) != 2 || merged.FieldError("email") != "invalid email" {
+		t.Fatalf("unexpected merged errors: %#v", merged.Errors())
+	}
+	if _, ok := merged.Failed()["x-request-id"]["uuid"]; !ok || len(merged.ByRule()["email"]) != 1 {
+		t.Fatalf("failed rules must be merged too: %#v", merged.Failed())
+	}
+	if merged.Validated()["name"] != "Ada" {
+		t.Fatalf("expected validated input to be merged, got %#v", merged.Validated())
+	}
+	if merged.Truncated() {
+		t.Fatal("expected a merge of complete results to be complete")
+	}
+
+	body.MarkTruncated()
+	if !MergeResults(headers, body).Truncated() || !TruncatedOf(body.Only("email")) {
+		t.Fatal("expected truncation to survive merges and views")
+	}
+}
+
+func TestValidationErrors_MergeWithKeyPrefix(t *testing.T) {
+	step := NewValidationErrors()
+	step.AddRuleError("items.0.sku", ParsedRule{Name: "required"}, "sku required")
+	step.AddRuleError("/email", ParsedRule{Name: "email"}, "invalid email")
+	step.AddRuleError("[0]", ParsedRule{Name: "min", Parameters: []string{"1"}}, "too short")
+	step.AddRuleError("", ParsedRule{Name: "business"}, "order rejected")
+	step.SetValidated(map[string]any{"items": []any{}})
+
+	merged := NewValidationErrors()
+	merged.AddError("body.total", "total mismatch")
+	merged.Merge(step, WithKeyPrefix("body"))
+
+	for _, key := range []string{"body.total", "body.items.0.sku", "/body/email", "body[0]", "body"} {
+		if !merged.HasFieldError(key) {
+			t.Errorf("expected an error for %q, got %#v", key, merged.Errors())
+		}
+	}
+	if got := merged.Failed()["body[0]"]["min"]; len(got) != 1 || got[0] != "1" {
+		t.Errorf("unexpected prefixed failed rules: %#v", merged.Failed())
+	}
+	if _, ok := merged.Validated()["body"].(map[string]any)["items"]; !ok {
+		t.Errorf("expected validated input nested under the prefix, got %#v", merged.Validated())
+	}
+}
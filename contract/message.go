@@ -14,3 +14,34 @@ type MessageResolver interface {
 	// Clone creates a copy of the message resolver for request isolation
 	Clone() MessageResolver
 }
+
+// RuleMessageResolver is implemented by message resolvers that resolve a message from the
+// evaluated rule context instead of only its field and parameters.
+type RuleMessageResolver interface {
+	// ResolveRule creates a message for a rule evaluated in ctx. The fallback (usually the
+	// rule's own Message()) is used when no custom or catalog message exists for the rule.
+	ResolveRule(rule string, ctx RuleContext, fallback string) string
+}
+
+// MessageFunc builds a custom message from the evaluated rule, e.g. to pick a different copy
+// depending on the value or parameters. The returned template still has its placeholders replaced.
+type MessageFunc func(ctx RuleContext) string
+
+// MessageFuncResolver is implemented by message resolvers that support MessageFunc messages.
+type MessageFuncResolver interface {
+	// SetCustomMessageFunc sets a custom message function for a rule
+	SetCustomMessageFunc(rule string, messageFunc MessageFunc)
+}
+
+// LocaleAttributeResolver is implemented by message resolvers that translate attribute names
+// per locale.
+type LocaleAttributeResolver interface {
+	// SetLocaleAttribute sets the attribute name of a field for a locale
+	SetLocaleAttribute(field, locale, attribute string)
+}
+
+// MessageProvider is implemented by rules that carry their own default message.
+type MessageProvider interface {
+	// Message returns the rule's message template
+	Message() string
+}
@@ -0,0 +1,10 @@
+package contract
+
+import "time"
+
+// RuleObserver receives the outcome of every rule evaluation, e.g. to count evaluations and
+// failures per rule and record their duration. It must be safe for concurrent use.
+type RuleObserver interface {
+	// ObserveRule records one evaluation of rule; failed reports whether it returned an error
+	ObserveRule(rule string, failed bool, duration time.Duration)
+}
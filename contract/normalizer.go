@@ -0,0 +1,9 @@
+package contract
+
+// Normalizer is implemented by rules that convert a value they accepted into a canonical
+// form (e.g. "1.234,5" into "1234.50"). After the rule passes, the engine replaces the
+// field value with the normalized one for the remaining rules and in Result.Validated().
+type Normalizer interface {
+	// Normalize returns the canonical form of the value validated in ctx
+	Normalize(ctx RuleContext) any
+}
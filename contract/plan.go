@@ -0,0 +1,55 @@
+package contract
+
+import "strings"
+
+// RulePlan describes a single rule as the engine will execute it
+type RulePlan struct {
+	Name       string
+	Params     []string
+	Negated    bool
+	Warning    bool
+	Registered bool
+	// Replacement is the rule to migrate to when Name is deprecated (see registry.Deprecate)
+	Replacement string
+	// Reason is the condition that activates a contract.ConditionalRule (e.g. "type=premium"),
+	// set by data-aware plans only
+	Reason string
+}
+
+// String renders the rule in rule-string syntax, e.g. "between:1,5", "!in:a,b" or "warn:min:3"
+func (p RulePlan) String() string {
+	name := p.Name
+	if p.Negated {
+		name = "!" + name
+	}
+	if p.Warning {
+		name = "warn:" + name
+	}
+	if len(p.Params) == 0 {
+		return name
+	}
+	return name + ":" + strings.Join(p.Params, ",")
+}
+
+// FieldPlan describes the normalized rules that will run for a field
+type FieldPlan struct {
+	Field string
+	Bail  bool
+	Rules []RulePlan
+}
+
+// String renders the plan as "field: rule|rule", marking unknown rules with a "?" suffix
+func (p FieldPlan) String() string {
+	parts := make([]string, 0, len(p.Rules)+1)
+	if p.Bail {
+		parts = append(parts, "bail")
+	}
+	for _, rule := range p.Rules {
+		if rule.Registered {
+			parts = append(parts, rule.String())
+			continue
+		}
+		parts = append(parts, rule.String()+"?")
+	}
+	return p.Field + ": " + strings.Join(parts, "|")
+}
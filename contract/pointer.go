@@ -0,0 +1,56 @@
+package contract
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// PointerError is one validation error located by an RFC 6901 JSON Pointer into the original JSON
+// body (e.g. /items/2/name), so API clients can highlight the exact element.
+type PointerError struct {
+	Pointer string `json:"pointer"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// PointerErrors lists every error of result with its JSON Pointer, ordered by field. Error keys may
+// be in any KeyStyle; an empty key points at the whole document.
+func PointerErrors(result Result) []PointerError {
+	errs := result.Errors()
+	fields := make([]string, 0, len(errs))
+	for field := range errs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var out []PointerError
+	for _, field := range fields {
+		pointer := KeyPointer(field)
+		for _, message := range errs[field] {
+			out = append(out, PointerError{Pointer: pointer, Field: field, Message: message})
+		}
+	}
+	return out
+}
+
+// MarshalPointerErrors renders result as {"errors": [{"pointer": ..., "field": ..., "message": ...}]}
+func MarshalPointerErrors(result Result) ([]byte, error) {
+	errs := PointerErrors(result)
+	if errs == nil {
+		errs = []PointerError{}
+	}
+	return json.Marshal(struct {
+		Errors []PointerError `json:"errors"`
+	}{Errors: errs})
+}
+
+// KeyPointer converts an error key in any KeyStyle ("items.2.name", "items[2].name" or
+// "/items/2/name") to a JSON Pointer
+func KeyPointer(key string) string {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return key
+	}
+	path := strings.NewReplacer("[", ".", "]", "").Replace(key)
+	return KeyStylePointer.Format(path)
+}
@@ -0,0 +1,54 @@
+package contract
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKeyPointer(t *testing.T) {
+	tests := map[string]string{
+		"items.2.name":  "/items/2/name",
+		"items[2].name": "/items/2/name",
+		"matrix[1][2]":  "/matrix/1/2",
+		"/items/2/name": "/items/2/name",
+		"a/b":           "/a~1b",
+		"":              "",
+	}
+	for key, want := range tests {
+		if got := KeyPointer(key); got != want {
+			t.Errorf("KeyPointer(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestPointerErrors(t *testing.T) {
+	ve := NewValidationErrors()
+	ve.AddError("items.2.name", "is required")
+	ve.AddError("email", "is invalid")
+	ve.AddError("email", "is taken")
+
+	got := PointerErrors(ve)
+	want := []PointerError{
+		{Pointer: "/email", Field: "email", Message: "is invalid"},
+		{Pointer: "/email", Field: "email", Message: "is taken"},
+		{Pointer: "/items/2/name", Field: "items.2.name", Message: "is required"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %#v, want %#v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("error %d: got %#v, want %#v", i, got[i], want[i])
+		}
+	}
+
+	body, err := MarshalPointerErrors(NewValidationErrors())
+	if err != nil || string(body) != `{"errors":[]}` {
+		t.Fatalf("unexpected JSON for a valid result: %s, %v", body, err)
+	}
+	body, _ = MarshalPointerErrors(ve)
+	wantJSON := `"pointer":"/items/2/name","field":"items.2.name","message":"is required"`
+	if !strings.Contains(string(body), wantJSON) {
+		t.Fatalf("unexpected JSON: %s", body)
+	}
+}
@@ -1,8 +1,41 @@
 package contract
 
+import "context"
+
 // PresenceVerifier is the interface for DB existence checks
 // Should be implemented in user code and registered by convention.
 type PresenceVerifier interface {
 	Exists(table string, field string, value any) (bool, error)
 	Unique(table string, field string, value any) (bool, error)
 }
+
+// PresenceQuery is the full constraint set of an exists/unique check, e.g.
+// "unique:users,email,except=id,where=tenant_id:tenant,where_null=deleted_at".
+type PresenceQuery struct {
+	Context context.Context // Request context of the validation, never nil
+
+	Table  string // Table to query
+	Column string // Column compared with Value
+	Value  any    // Value under validation
+
+	// ExceptColumn/ExceptValue exclude the row being updated (ExceptColumn is empty when unused)
+	ExceptColumn string
+	ExceptValue  any
+
+	Where        map[string]any // Additional column = value constraints (e.g. tenant scoping)
+	WhereNull    []string       // Columns that must be NULL (e.g. deleted_at for soft deletes)
+	WhereNotNull []string       // Columns that must not be NULL
+}
+
+// HasConstraints reports whether the query carries constraints beyond table, column and value
+func (q PresenceQuery) HasConstraints() bool {
+	return q.ExceptColumn != "" || len(q.Where) > 0 || len(q.WhereNull) > 0 || len(q.WhereNotNull) > 0
+}
+
+// ConstrainedPresenceVerifier is implemented by verifiers that honor the full constraint set.
+// exists passes when Count > 0 and unique passes when Count == 0.
+type ConstrainedPresenceVerifier interface {
+	PresenceVerifier
+	// Count returns the number of rows matching the query
+	Count(query PresenceQuery) (int64, error)
+}
@@ -0,0 +1,30 @@
+package contract
+
+import "context"
+
+// ValidatedRequest lets a request DTO carry its own validation policy,
+// similar to Laravel Form Requests.
+type ValidatedRequest interface {
+	// Rules returns the rule strings keyed by field name
+	Rules() map[string]string
+
+	// Messages returns custom messages keyed by rule or "<rule>.<field>"
+	Messages() map[string]string
+
+	// Attributes returns custom attribute names keyed by field name
+	Attributes() map[string]string
+}
+
+// AuthorizingRequest is an optional extension of ValidatedRequest.
+// When implemented, Authorize is consulted before any rule is evaluated.
+type AuthorizingRequest interface {
+	// Authorize reports whether the current caller may perform the request
+	Authorize(ctx context.Context) bool
+}
+
+// SanitizingRequest is an optional extension of ValidatedRequest.
+// When implemented, the sanitizers run over the input before any rule is evaluated.
+type SanitizingRequest interface {
+	// Sanitizers returns pipe-separated sanitizer names keyed by field, e.g. "trim|lower"
+	Sanitizers() map[string]string
+}
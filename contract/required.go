@@ -0,0 +1,13 @@
+package contract
+
+// RequiredPreset selects what the required rule considers empty
+type RequiredPreset int
+
+const (
+	// RequiredLaravel fails nil, empty strings and collections, nil pointers and zero values such
+	// as false, 0 or a pointer to 0. It is the default.
+	RequiredLaravel RequiredPreset = iota
+	// RequiredGo fails only nil, empty strings and collections and nil pointers: false, 0 and other
+	// zero values are legitimate values and pass.
+	RequiredGo
+)
@@ -0,0 +1,18 @@
+package contract
+
+import "context"
+
+// MXResolver looks up the mail exchangers of a domain, for the dns mode of the email rule
+type MXResolver interface {
+	// LookupMX returns the mail exchanger hosts of domain, in preference order. A domain
+	// without any returns none and a nil error.
+	LookupMX(ctx context.Context, domain string) ([]string, error)
+}
+
+// MXResolverFunc adapts a function to MXResolver
+type MXResolverFunc func(ctx context.Context, domain string) ([]string, error)
+
+// LookupMX calls f
+func (f MXResolverFunc) LookupMX(ctx context.Context, domain string) ([]string, error) {
+	return f(ctx, domain)
+}
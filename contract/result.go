@@ -20,23 +20,185 @@ type Result interface {
 	HasFieldError(field string) bool
 }
 
+// ValidatedResult is implemented by results that carry the input of the fields under validation
+type ValidatedResult interface {
+	// Validated returns the (sanitized) input of the fields under validation.
+	// Only rely on it when IsValid reports true.
+	Validated() map[string]any
+}
+
+// ValidatedOf returns the validated input of res, or nil when res does not carry it
+func ValidatedOf(res Result) map[string]any {
+	if validated, ok := res.(ValidatedResult); ok {
+		return validated.Validated()
+	}
+	return nil
+}
+
+// RuleErrorsResult is implemented by results that know which rule produced each error
+type RuleErrorsResult interface {
+	// ByRule returns the errors grouped by the rule that failed, then by field, e.g. to count
+	// how many requests fail unique versus email
+	ByRule() map[string]map[string][]string
+
+	// Failed returns the failed rules per field with their parameters, e.g.
+	// {"age": {"between": ["18", "65"]}}, so tests can assert on rules instead of messages
+	Failed() map[string]map[string][]string
+}
+
+// ByRuleOf returns the errors of res grouped by rule, or nil when res does not track rules
+func ByRuleOf(res Result) map[string]map[string][]string {
+	if rules, ok := res.(RuleErrorsResult); ok {
+		return rules.ByRule()
+	}
+	return nil
+}
+
+// FailedOf returns the failed rules of res per field, or nil when res does not track rules
+func FailedOf(res Result) map[string]map[string][]string {
+	if rules, ok := res.(RuleErrorsResult); ok {
+		return rules.Failed()
+	}
+	return nil
+}
+
+// WarningsResult is implemented by results that carry the failures of warning rules
+type WarningsResult interface {
+	// Warnings returns the failures of warning rules ("warn:min:3") grouped by field.
+	// Warnings do not make the result invalid.
+	Warnings() map[string][]string
+}
+
+// WarningsOf returns the warnings of res, or nil when res does not carry them
+func WarningsOf(res Result) map[string][]string {
+	if warnings, ok := res.(WarningsResult); ok {
+		return warnings.Warnings()
+	}
+	return nil
+}
+
+// SkippedResult is implemented by results that record rules left unevaluated
+type SkippedResult interface {
+	// Skipped returns the rules per field that were not evaluated (e.g. rate limited external
+	// rules). Skipped rules neither pass nor fail, so check it before trusting such a field.
+	Skipped() map[string][]string
+}
+
+// SkippedOf returns the skipped rules of res, or nil when res does not record them
+func SkippedOf(res Result) map[string][]string {
+	if skipped, ok := res.(SkippedResult); ok {
+		return skipped.Skipped()
+	}
+	return nil
+}
+
+// TruncatedResult is implemented by results that can be cut short by an error budget
+type TruncatedResult interface {
+	// Truncated reports whether errors are missing because the engine's error budget
+	// (engine.WithMaxErrorsPerField, engine.WithMaxTotalErrors) ran out: errors beyond it were
+	// dropped, or fields were left unvalidated once the total was reached
+	Truncated() bool
+}
+
+// TruncatedOf reports whether res is truncated, false when res does not support truncation
+func TruncatedOf(res Result) bool {
+	if truncated, ok := res.(TruncatedResult); ok {
+		return truncated.Truncated()
+	}
+	return false
+}
+
 // ValidationErrors is a concrete implementation of Result
 type ValidationErrors struct {
-	errors map[string][]string
+	errors    map[string][]string
+	byRule    map[string]map[string][]string
+	failed    map[string]map[string][]string
+	warnings  map[string][]string
+	skipped   map[string][]string
+	validated map[string]any
+	truncated bool
+
+	scoreTotal    float64
+	scoreDeducted map[string]float64
 }
 
 // NewValidationErrors creates a new ValidationErrors instance
 func NewValidationErrors() *ValidationErrors {
 	return &ValidationErrors{
-		errors: make(map[string][]string),
+		errors:    make(map[string][]string),
+		byRule:    make(map[string]map[string][]string),
+		failed:    make(map[string]map[string][]string),
+		warnings:  make(map[string][]string),
+		skipped:   make(map[string][]string),
+		validated: make(map[string]any),
+
+		scoreDeducted: make(map[string]float64),
 	}
 }
 
+// SetValidated replaces the validated input carried by the result
+func (ve *ValidationErrors) SetValidated(data map[string]any) {
+	ve.validated = data
+}
+
+// Validated returns the (sanitized) input of the fields under validation
+func (ve *ValidationErrors) Validated() map[string]any {
+	return ve.validated
+}
+
 // AddError adds an error for a specific field
 func (ve *ValidationErrors) AddError(field, message string) {
 	ve.errors[field] = append(ve.errors[field], message)
 }
 
+// AddRuleError adds the error of a failed rule for a specific field
+func (ve *ValidationErrors) AddRuleError(field string, rule ParsedRule, message string) {
+	ve.AddError(field, message)
+	fields := ve.ruleFields(rule.Name)
+	fields[field] = append(fields[field], message)
+	ve.failedRules(field)[rule.Name] = append([]string{}, rule.Parameters...)
+}
+
+// ByRule returns the errors added with AddRuleError grouped by rule name, then by field
+func (ve *ValidationErrors) ByRule() map[string]map[string][]string {
+	return ve.byRule
+}
+
+// AddWarning adds the failure of a warning rule for a specific field
+func (ve *ValidationErrors) AddWarning(field, message string) {
+	ve.warnings[field] = append(ve.warnings[field], message)
+}
+
+// Warnings returns the failures of warning rules grouped by field
+func (ve *ValidationErrors) Warnings() map[string][]string {
+	return ve.warnings
+}
+
+// AddSkipped records that rule was not evaluated for a specific field
+func (ve *ValidationErrors) AddSkipped(field, rule string) {
+	ve.skipped[field] = append(ve.skipped[field], rule)
+}
+
+// Skipped returns the rules added with AddSkipped grouped by field
+func (ve *ValidationErrors) Skipped() map[string][]string {
+	return ve.skipped
+}
+
+// MarkTruncated records that errors were left out of the result
+func (ve *ValidationErrors) MarkTruncated() {
+	ve.truncated = true
+}
+
+// Truncated reports whether MarkTruncated was called
+func (ve *ValidationErrors) Truncated() bool {
+	return ve.truncated
+}
+
+// Failed returns the rules added with AddRuleError by field, with the parameters they failed with
+func (ve *ValidationErrors) Failed() map[string]map[string][]string {
+	return ve.failed
+}
+
 // IsValid reports whether validator passed without errors
 func (ve *ValidationErrors) IsValid() bool {
 	return len(ve.errors) == 0
@@ -28,3 +28,76 @@ func TestValidationErrorsBasic(t *testing.T) {
 		t.Fatal("expected age to be present in map")
 	}
 }
+
+func TestValidationErrors_ByRule(t *testing.T) {
+	ve := NewValidationErrors()
+	ve.AddRuleError("email", ParsedRule{Name: "email"}, "invalid email")
+	ve.AddRuleError("email", ParsedRule{Name: "unique", Parameters: []string{"users"}}, "taken")
+	ve.AddRuleError("backup_email", ParsedRule{Name: "email"}, "invalid backup email")
+
+	byRule := ve.ByRule()
+	if len(byRule) != 2 || len(byRule["email"]) != 2 || byRule["unique"]["email"][0] != "taken" {
+		t.Fatalf("unexpected grouping: %#v", byRule)
+	}
+	if got := ve.Errors()["email"]; len(got) != 2 || got[0] != "invalid email" {
+		t.Fatalf("rule errors must also be field errors, got %#v", got)
+	}
+}
+
+func TestValidationErrors_Failed(t *testing.T) {
+	ve := NewValidationErrors()
+	ve.AddRuleError("age", ParsedRule{Name: "between", Parameters: []string{"18", "65"}}, "out of range")
+	ve.AddRuleError("age", ParsedRule{Name: "integer"}, "not an integer")
+
+	failed := ve.Failed()
+	if got := failed["age"]["between"]; len(got) != 2 || got[0] != "18" || got[1] != "65" {
+		t.Fatalf("unexpected between parameters: %#v", got)
+	}
+	if got, ok := failed["age"]["integer"]; !ok || got == nil || len(got) != 0 {
+		t.Fatalf("expected empty parameters for integer, got %#v", got)
+	}
+}
+
+// plainResult hides every method of the wrapped result beyond Result
+type plainResult struct{ Result }
+
+func TestValidatedOf(t *testing.T) {
+	ve := NewValidationErrors()
+	ve.SetValidated(map[string]any{"name": "Ada"})
+
+	if got := ValidatedOf(ve); got["name"] != "Ada" {
+		t.Fatalf("expected validated input, got %#v", got)
+	}
+	if got := ValidatedOf(plainResult{ve}); got != nil {
+		t.Fatalf("expected nil for a result without validated input, got %#v", got)
+	}
+	if merged := MergeResults(plainResult{ve}); len(merged.Validated()) != 0 {
+		t.Fatalf("expected nothing to merge, got %#v", merged.Validated())
+	}
+}
+
+func TestOptionalResultHelpers(t *testing.T) {
+	ve := NewValidationErrors()
+	ve.AddRuleError("email", ParsedRule{Name: "email"}, "invalid")
+	ve.AddRuleError("address.city", ParsedRule{Name: "required"}, "required")
+	ve.AddWarning("bio", "short")
+	ve.AddSkipped("domain", "dns")
+	ve.MarkTruncated()
+
+	if len(ByRuleOf(ve)["email"]) != 1 || FailedOf(ve)["email"] == nil || len(WarningsOf(ve)["bio"]) != 1 ||
+		len(SkippedOf(ve)["domain"]) != 1 || !TruncatedOf(ve) || ScoreOf(ve) != 100 {
+		t.Fatal("expected the helpers to read the engine's result")
+	}
+	if only := OnlyOf(ve, "address"); len(only.Errors()) != 1 || !only.HasFieldError("address.city") {
+		t.Fatalf("unexpected view %#v", only.Errors())
+	}
+
+	plain := plainResult{ve}
+	if ByRuleOf(plain) != nil || FailedOf(plain) != nil || WarningsOf(plain) != nil || SkippedOf(plain) != nil ||
+		TruncatedOf(plain) || ScoreOf(plain) != 100 || DeductionsOf(plain) != nil {
+		t.Fatal("expected empty values for a result without the optional interfaces")
+	}
+	if except := ExceptOf(plain, "address"); len(except.Errors()) != 1 || !except.HasFieldError("email") {
+		t.Fatalf("expected a plain result to be filtered by its errors, got %#v", except.Errors())
+	}
+}
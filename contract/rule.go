@@ -47,6 +47,7 @@ var (
 	Size       = ValidationRule{Name: "size", Message: "The :attribute must be :param0"}
 	Same       = ValidationRule{Name: "same", Message: "The :attribute and :param0 must match"}
 	Different  = ValidationRule{Name: "different", Message: "The :attribute and :param0 must be different"}
+	Expr       = ValidationRule{Name: "expr", Message: "The :attribute must satisfy :param0"}
 	StartsWith = ValidationRule{
 		Name:    "starts_with",
 		Message: "The :attribute must start with one of the following: :param0",
@@ -78,6 +79,10 @@ var (
 		Name:    "required_without_all",
 		Message: "The :attribute field is required when none of :param0 are present",
 	}
+	RequiredTogether = ValidationRule{
+		Name:    "required_together",
+		Message: "The :attribute field must be provided together with :param0",
+	}
 	// Control rules
 	Filled    = ValidationRule{Name: "filled", Message: "The :attribute field must be filled"}
 	Nullable  = ValidationRule{Name: "nullable", Message: "The :attribute field is nullable"}
@@ -110,21 +115,30 @@ var (
 	Integer    = ValidationRule{Name: "integer", Message: "The :attribute must be an integer"}
 	Decimal    = ValidationRule{Name: "decimal", Message: "The :attribute must have :param0 decimal places"}
 	MultipleOf = ValidationRule{Name: "multiple_of", Message: "The :attribute must be a multiple of :param0"}
+	Money      = ValidationRule{Name: "money", Message: "The :attribute must be a valid amount"}
 	// File rules
-	File  = ValidationRule{Name: "file", Message: "The :attribute must be a file"}
-	Image = ValidationRule{Name: "image", Message: "The :attribute must be an image"}
-	Mimes = ValidationRule{Name: "mimes", Message: "The :attribute must be a file of type: :param0"}
+	File       = ValidationRule{Name: "file", Message: "The :attribute must be a file"}
+	Image      = ValidationRule{Name: "image", Message: "The :attribute must be an image"}
+	Mimes      = ValidationRule{Name: "mimes", Message: "The :attribute must be a file of type: :param0"}
+	Extensions = ValidationRule{
+		Name:    "extensions",
+		Message: "The :attribute must have one of the following extensions: :values",
+	}
+	Filename = ValidationRule{Name: "filename", Message: "The :attribute must be a safe filename"}
 	// special rules
-	ActiveURL = ValidationRule{Name: "active_url", Message: "The :attribute must be a valid URL"}
-	Confirmed = ValidationRule{Name: "confirmed", Message: "The :attribute confirmation does not match"}
-	In        = ValidationRule{Name: "in", Message: "The selected :attribute is invalid"}
-	IP        = ValidationRule{Name: "ip", Message: "The :attribute must be a valid IP address"}
-	JSON      = ValidationRule{Name: "json", Message: "The :attribute must be a valid JSON string"}
-	List      = ValidationRule{Name: "list", Message: "The :attribute must be a list of values"}
-	Map       = ValidationRule{Name: "map", Message: "The :attribute must be a map"}
-	NotIn     = ValidationRule{Name: "not_in", Message: "The selected :attribute is invalid"}
-	Password  = ValidationRule{Name: "password", Message: "The :attribute must be at least :param0 characters long"}
-	Regex     = ValidationRule{Name: "regex", Message: "The :attribute format is invalid"}
+	ActiveURL   = ValidationRule{Name: "active_url", Message: "The :attribute must be a valid URL"}
+	Confirmed   = ValidationRule{Name: "confirmed", Message: "The :attribute confirmation does not match"}
+	ConfirmedBy = ValidationRule{Name: "confirmed_by", Message: "The :attribute confirmation does not match"}
+	In          = ValidationRule{Name: "in", Message: "The selected :attribute is invalid"}
+	IP          = ValidationRule{Name: "ip", Message: "The :attribute must be a valid IP address"}
+	JSON        = ValidationRule{Name: "json", Message: "The :attribute must be a valid JSON string"}
+	List        = ValidationRule{Name: "list", Message: "The :attribute must be a list of values"}
+	Map         = ValidationRule{Name: "map", Message: "The :attribute must be a map"}
+	NotIn       = ValidationRule{Name: "not_in", Message: "The selected :attribute is invalid"}
+	Distinct    = ValidationRule{Name: "distinct", Message: "The :attribute field has a duplicate value"}
+	Password    = ValidationRule{Name: "password", Message: "The :attribute must be at least :param0 characters long"}
+	Regex       = ValidationRule{Name: "regex", Message: "The :attribute format is invalid"}
+	NotRegex    = ValidationRule{Name: "not_regex", Message: "The :attribute format is invalid"}
 	// String rules
 	Alpha     = ValidationRule{Name: "alpha", Message: "The :attribute may only contain letters"}
 	Alphanum  = ValidationRule{Name: "alphanum", Message: "The :attribute may only contain letters and numbers"}
@@ -157,6 +171,10 @@ var (
 		Name:    "prohibits",
 		Message: "The :attribute field prohibits :other from being present",
 	}
+	ProhibitedKeys = ValidationRule{
+		Name:    "prohibited_keys",
+		Message: "The :attribute field must not contain the keys :values",
+	}
 	// Additional string rules
 	DoesntStartWith = ValidationRule{
 		Name:    "doesnt_start_with",
@@ -166,6 +184,50 @@ var (
 		Name:    "doesnt_end_with",
 		Message: "The :attribute must not end with one of the following: :values",
 	}
+	NotInWordlist = ValidationRule{Name: "not_in_wordlist", Message: "The :attribute contains a disallowed word"}
+	// Product code rules
+	UPCA   = ValidationRule{Name: "upc_a", Message: "The :attribute must be a valid UPC-A code"}
+	GTIN14 = ValidationRule{Name: "gtin14", Message: "The :attribute must be a valid GTIN-14 code"}
+	// Color rules
+	Color = ValidationRule{Name: "color", Message: "The :attribute must be a valid color"}
+	// Hash rules
+	MD5    = ValidationRule{Name: "md5", Message: "The :attribute must be a valid MD5 hash"}
+	SHA1   = ValidationRule{Name: "sha1", Message: "The :attribute must be a valid SHA-1 hash"}
+	SHA256 = ValidationRule{Name: "sha256", Message: "The :attribute must be a valid SHA-256 hash"}
+	SHA512 = ValidationRule{Name: "sha512", Message: "The :attribute must be a valid SHA-512 hash"}
+	Hash   = ValidationRule{Name: "hash", Message: "The :attribute must be a valid :param0 hash"}
+	// Git rules
+	GitSHA = ValidationRule{Name: "git_sha", Message: "The :attribute must be a git commit SHA"}
+	GitRef = ValidationRule{Name: "git_ref", Message: "The :attribute must be a valid git branch or tag name"}
+	// Kubernetes name rules
+	DNS1123Label = ValidationRule{
+		Name:    "dns1123_label",
+		Message: "The :attribute must be a lowercase DNS label, at most 63 characters",
+	}
+	DNS1123Subdomain = ValidationRule{
+		Name:    "dns1123_subdomain",
+		Message: "The :attribute must be a lowercase DNS subdomain, at most 253 characters",
+	}
+	K8sQualifiedName = ValidationRule{
+		Name:    "k8s_qualified_name",
+		Message: "The :attribute must be a qualified name such as example.com/my-name",
+	}
+	// Cloud resource rules
+	ARN      = ValidationRule{Name: "arn", Message: "The :attribute must be a valid ARN"}
+	S3Bucket = ValidationRule{Name: "s3_bucket", Message: "The :attribute must be a valid S3 bucket name"}
+	// Localization rules
+	Locale = ValidationRule{Name: "locale", Message: "The :attribute must be a valid locale"}
+	// HTTP rules
+	MimeType    = ValidationRule{Name: "mime_type", Message: "The :attribute must be a valid MIME type"}
+	HeaderName  = ValidationRule{Name: "header_name", Message: "The :attribute must be a valid HTTP header name"}
+	HeaderValue = ValidationRule{Name: "header_value", Message: "The :attribute must be a valid HTTP header value"}
+	CookieName  = ValidationRule{Name: "cookie_name", Message: "The :attribute must be a valid cookie name"}
+	CookieValue = ValidationRule{Name: "cookie_value", Message: "The :attribute must be a valid cookie value"}
+	// Email rules
+	EmailNotDisposable = ValidationRule{
+		Name:    "email_not_disposable",
+		Message: "The :attribute must not be a disposable email address",
+	}
 	// Additional numeric rules
 	Gte = ValidationRule{Name: "gte", Message: "The :attribute must be greater than or equal to :value"}
 	Lte = ValidationRule{Name: "lte", Message: "The :attribute must be less than or equal to :value"}
@@ -186,6 +248,51 @@ type Rule interface {
 	Validate(ctx RuleContext) error
 }
 
+// AsyncRule is implemented by I/O-bound rules (database, network) that the engine may run
+// concurrently with other async rules of the same validation, on a bounded worker pool.
+// Their Validate method must be safe for concurrent use.
+type AsyncRule interface {
+	Rule
+
+	// Async reports whether this rule instance may run concurrently
+	Async() bool
+}
+
+// External resources of built-in rules
+const (
+	ResourceDatabase = "database"
+	ResourceDNS      = "dns"
+)
+
+// ExternalRule is implemented by rules that depend on an external resource (database, DNS,
+// remote API), so validation-driven traffic to it can be rate limited per resource.
+type ExternalRule interface {
+	Rule
+
+	// Resource names the external resource the rule queries (e.g. ResourceDatabase)
+	Resource() string
+}
+
+// ConditionalRule is implemented by rules that only constrain a field while a condition on
+// the other input holds (required_if, required_with, prohibited_unless, ...).
+type ConditionalRule interface {
+	Rule
+
+	// Applies reports whether the condition holds for ctx and describes it (e.g. "type=premium")
+	Applies(ctx RuleContext) (bool, string)
+}
+
+// MemoizedRule is implemented by expensive, idempotent rules whose outcome can be reused
+// within one validation for identical inputs (e.g. "emails.*": "unique:users,email" with
+// repeated addresses queries the database once per distinct address).
+type MemoizedRule interface {
+	Rule
+
+	// MemoKey identifies the outcome for ctx (parameters, value and any data the rule reads),
+	// or returns false when the outcome must not be reused
+	MemoKey(ctx RuleContext) (string, bool)
+}
+
 // RuleContext provides validator context data for rules.
 type RuleContext interface {
 	// Field returns the field name being validated
@@ -0,0 +1,89 @@
+package contract
+
+import "sort"
+
+// RuleSet is a reusable validation schema: rule strings, custom messages and
+// attribute names, keyed the same way as the validator's setters.
+type RuleSet struct {
+	// Rules holds pipe-separated rule strings keyed by field
+	Rules map[string]string
+
+	// Messages holds custom messages keyed by rule or "<rule>.<field>"
+	Messages map[string]string
+
+	// Attributes holds custom attribute names keyed by field
+	Attributes map[string]string
+
+	// Profiles holds per-profile (e.g. "staging", "prod") rule overrides keyed by field.
+	// An override replaces the field's rule string; an empty override drops the field.
+	Profiles map[string]map[string]string
+}
+
+// NewRuleSet creates an empty RuleSet
+func NewRuleSet() *RuleSet {
+	return &RuleSet{
+		Rules:      make(map[string]string),
+		Messages:   make(map[string]string),
+		Attributes: make(map[string]string),
+		Profiles:   make(map[string]map[string]string),
+	}
+}
+
+// RulesFor returns the rules with the overrides of profile applied.
+// Unknown or empty profiles return the base rules.
+func (rs *RuleSet) RulesFor(profile string) map[string]string {
+	overrides, ok := rs.Profiles[profile]
+	if !ok || profile == "" {
+		return rs.Rules
+	}
+
+	rules := make(map[string]string, len(rs.Rules))
+	for field, rule := range rs.Rules {
+		rules[field] = rule
+	}
+	for field, rule := range overrides {
+		if rule == "" {
+			delete(rules, field)
+			continue
+		}
+		rules[field] = rule
+	}
+	return rules
+}
+
+// RuleChange is a difference between the rules of two rule sets.
+// From is empty for added fields, To is empty for removed fields.
+type RuleChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// String renders the change as "+ field: rule", "- field: rule" or "~ field: from -> to"
+func (c RuleChange) String() string {
+	switch {
+	case c.From == "":
+		return "+ " + c.Field + ": " + c.To
+	case c.To == "":
+		return "- " + c.Field + ": " + c.From
+	}
+	return "~ " + c.Field + ": " + c.From + " -> " + c.To
+}
+
+// Diff returns the rule changes needed to turn rs into other, sorted by field.
+// Messages, attributes and profiles are not compared.
+func (rs *RuleSet) Diff(other *RuleSet) []RuleChange {
+	var changes []RuleChange
+	for field, rule := range rs.Rules {
+		if next := other.Rules[field]; next != rule {
+			changes = append(changes, RuleChange{Field: field, From: rule, To: next})
+		}
+	}
+	for field, rule := range other.Rules {
+		if _, exists := rs.Rules[field]; !exists {
+			changes = append(changes, RuleChange{Field: field, To: rule})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
@@ -0,0 +1,52 @@
+package contract
+
+import "testing"
+
+func TestRuleSet_RulesFor(t *testing.T) {
+	set := NewRuleSet()
+	set.Rules["website"] = "required|active_url"
+	set.Rules["debug"] = "boolean"
+	set.Profiles["staging"] = map[string]string{"website": "nullable|url", "debug": ""}
+
+	base := set.RulesFor("prod")
+	if base["website"] != "required|active_url" || base["debug"] != "boolean" {
+		t.Fatalf("unknown profile must return base rules: %#v", base)
+	}
+
+	staging := set.RulesFor("staging")
+	if staging["website"] != "nullable|url" {
+		t.Fatalf("expected staging override, got %#v", staging)
+	}
+	if _, ok := staging["debug"]; ok {
+		t.Fatalf("empty override must drop the field: %#v", staging)
+	}
+	if set.Rules["website"] != "required|active_url" {
+		t.Fatal("overrides must not modify the base rules")
+	}
+}
+
+func TestRuleSet_Diff(t *testing.T) {
+	v1 := NewRuleSet()
+	v1.Rules["email"] = "required|email"
+	v1.Rules["name"] = "required"
+	v1.Rules["age"] = "integer"
+
+	v2 := NewRuleSet()
+	v2.Rules["email"] = "required|email"
+	v2.Rules["name"] = "required|max:50"
+	v2.Rules["phone"] = "nullable"
+
+	var got []string
+	for _, change := range v1.Diff(v2) {
+		got = append(got, change.String())
+	}
+	want := []string{"- age: integer", "~ name: required -> required|max:50", "+ phone: nullable"}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected diff: %v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("unexpected diff: %v", got)
+		}
+	}
+}
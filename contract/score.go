@@ -0,0 +1,64 @@
+package contract
+
+// ScoredResult is implemented by results that carry a data quality score
+type ScoredResult interface {
+	// Score returns the data quality score from 0 to 100 when the engine scores rules by weight
+	// (engine.WithScoring), and 100 otherwise
+	Score() float64
+
+	// Deductions returns the points each field lost from a score of 100
+	Deductions() map[string]float64
+}
+
+// ScoreOf returns the score of res, 100 when res is not scored
+func ScoreOf(res Result) float64 {
+	if scored, ok := res.(ScoredResult); ok {
+		return scored.Score()
+	}
+	return 100
+}
+
+// DeductionsOf returns the points each field of res lost, or nil when res is not scored
+func DeductionsOf(res Result) map[string]float64 {
+	if scored, ok := res.(ScoredResult); ok {
+		return scored.Deductions()
+	}
+	return nil
+}
+
+// AddScore records a rule evaluated in scoring mode: its weight counts towards the total and,
+// when the rule failed, is deducted from field
+func (ve *ValidationErrors) AddScore(field string, weight float64, failed bool) {
+	if weight <= 0 {
+		return
+	}
+	ve.scoreTotal += weight
+	if failed {
+		ve.scoreDeducted[field] += weight
+	}
+}
+
+// Score returns the data quality score from 0 to 100: the share of the weight of evaluated rules
+// that passed. It is 100 when no rule was scored.
+func (ve *ValidationErrors) Score() float64 {
+	if ve.scoreTotal <= 0 {
+		return 100
+	}
+	deducted := 0.0
+	for _, weight := range ve.scoreDeducted {
+		deducted += weight
+	}
+	return 100 * (ve.scoreTotal - deducted) / ve.scoreTotal
+}
+
+// Deductions returns the points each field lost from a score of 100
+func (ve *ValidationErrors) Deductions() map[string]float64 {
+	deductions := make(map[string]float64, len(ve.scoreDeducted))
+	if ve.scoreTotal <= 0 {
+		return deductions
+	}
+	for field, weight := range ve.scoreDeducted {
+		deductions[field] = 100 * weight / ve.scoreTotal
+	}
+	return deductions
+}
@@ -0,0 +1,8 @@
+package contract
+
+// Sizer lets custom types participate in size-based rules (min, max, size, between, gt, gte, lt, lte)
+// without being converted to strings or numbers first.
+type Sizer interface {
+	// ValidationSize returns the value's size, compared as is against the rule's bounds
+	ValidationSize() float64
+}
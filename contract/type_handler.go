@@ -0,0 +1,15 @@
+package contract
+
+// TypeHandler tells rules how to read values of a custom type (IDs, value objects) they
+// would otherwise reject, without converting the data first. Nil functions leave that
+// aspect to the default handling.
+type TypeHandler struct {
+	// String returns the string form used by string rules (email, url, regex, alpha, ...)
+	String func(value any) (string, bool)
+	// Number returns the numeric value used by size and comparison rules (min, max, gt, ...)
+	Number func(value any) (float64, bool)
+	// Empty reports whether the value counts as missing for required and filled
+	Empty func(value any) bool
+	// Length returns the length used by size rules when the type has no numeric value
+	Length func(value any) (int, bool)
+}
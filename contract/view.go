@@ -0,0 +1,107 @@
+package contract
+
+import "strings"
+
+// FilterableResult is implemented by results that can be narrowed down to some fields
+type FilterableResult interface {
+	// Only returns a view holding only the errors of the given fields and their nested fields
+	Only(fields ...string) Result
+
+	// Except returns a view without the errors of the given fields and their nested fields
+	Except(fields ...string) Result
+}
+
+// OnlyOf returns a view of res holding only the errors of fields and their nested fields. Results
+// that are not a FilterableResult are narrowed down by their Errors().
+func OnlyOf(res Result, fields ...string) Result {
+	if filterable, ok := res.(FilterableResult); ok {
+		return filterable.Only(fields...)
+	}
+	return errorsOf(res).Only(fields...)
+}
+
+// ExceptOf returns a view of res without the errors of fields and their nested fields
+func ExceptOf(res Result, fields ...string) Result {
+	if filterable, ok := res.(FilterableResult); ok {
+		return filterable.Except(fields...)
+	}
+	return errorsOf(res).Except(fields...)
+}
+
+// errorsOf copies the errors of res into a ValidationErrors
+func errorsOf(res Result) *ValidationErrors {
+	ve := NewValidationErrors()
+	for field, messages := range res.Errors() {
+		ve.errors[field] = append([]string{}, messages...)
+	}
+	return ve
+}
+
+// Only returns a view of ve holding only the errors of fields and their nested fields
+// (Only("address") keeps "address.city"), e.g. for the visible step of a multi-step form
+func (ve *ValidationErrors) Only(fields ...string) Result {
+	return ve.filter(func(key string) bool { return matchesAny(key, fields) })
+}
+
+// Except returns a view of ve without the errors of fields and their nested fields
+func (ve *ValidationErrors) Except(fields ...string) Result {
+	return ve.filter(func(key string) bool { return !matchesAny(key, fields) })
+}
+
+// filter copies the errors, warnings, failed and skipped rules and score deductions of the fields
+// keep accepts; the validated input and the scored total are shared with ve
+func (ve *ValidationErrors) filter(keep func(key string) bool) *ValidationErrors {
+	view := NewValidationErrors()
+	view.validated = ve.validated
+	view.truncated = ve.truncated
+	for field, messages := range ve.errors {
+		if keep(field) {
+			view.errors[field] = append([]string{}, messages...)
+		}
+	}
+	for field, messages := range ve.warnings {
+		if keep(field) {
+			view.warnings[field] = append([]string{}, messages...)
+		}
+	}
+	for field, rules := range ve.skipped {
+		if keep(field) {
+			view.skipped[field] = append([]string{}, rules...)
+		}
+	}
+	for rule, fields := range ve.byRule {
+		for field, messages := range fields {
+			if keep(field) {
+				view.ruleFields(rule)[field] = append([]string{}, messages...)
+			}
+		}
+	}
+	view.scoreTotal = ve.scoreTotal
+	for field, weight := range ve.scoreDeducted {
+		if keep(field) {
+			view.scoreDeducted[field] = weight
+		}
+	}
+	for field, rules := range ve.failed {
+		if !keep(field) {
+			continue
+		}
+		for rule, params := range rules {
+			view.failedRules(field)[rule] = params
+		}
+	}
+	return view
+}
+
+// matchesAny reports whether key is one of fields or nested under one of them. Keys and fields
+// are compared as JSON Pointers, so any KeyStyle matches.
+func matchesAny(key string, fields []string) bool {
+	pointer := KeyPointer(key)
+	for _, field := range fields {
+		fieldPointer := KeyPointer(field)
+		if pointer == fieldPointer || strings.HasPrefix(pointer, fieldPointer+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package contract
+
+import "testing"
+
+func TestValidationErrors_OnlyAndExcept(t *testing.T) {
+	ve := NewValidationErrors()
+	ve.AddRuleError("email", ParsedRule{Name: "email"}, "invalid email")
+	ve.AddRuleError("emails.0", ParsedRule{Name: "email"}, "invalid first email")
+	ve.AddRuleError("password", ParsedRule{Name: "min", Parameters: []string{"8"}}, "too short")
+	ve.AddRuleError("address.city", ParsedRule{Name: "required"}, "city required")
+	ve.AddRuleError("address[0]", ParsedRule{Name: "required"}, "line required")
+	ve.AddRuleError("/address/zip", ParsedRule{Name: "required"}, "zip required")
+
+	only := ve.Only("email", "password")
+	if len(only.Errors()) != 2 || !only.HasFieldError("email") || !only.HasFieldError("password") {
+		t.Fatalf("unexpected Only view: %#v", only.Errors())
+	}
+	if len(ByRuleOf(only)["email"]) != 1 || FailedOf(only)["password"]["min"][0] != "8" {
+		t.Fatalf("views must filter failed rules too: %#v %#v", ByRuleOf(only), FailedOf(only))
+	}
+
+	if address := ve.Only("address"); len(address.Errors()) != 3 {
+		t.Fatalf("expected nested keys in every style, got %#v", address.Errors())
+	}
+
+	except := ve.Except("/address", "emails")
+	if len(except.Errors()) != 2 || except.HasFieldError("address.city") || except.HasFieldError("emails.0") {
+		t.Fatalf("unexpected Except view: %#v", except.Errors())
+	}
+	if len(ve.Errors()) != 6 {
+		t.Fatalf("views must not modify the result, got %#v", ve.Errors())
+	}
+}
@@ -0,0 +1,228 @@
+package csvvalidate
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/utils"
+	"github.com/next-trace/scg-validator/validator"
+)
+
+// ErrorsColumn is the header of the column the annotated output appends to failing rows
+const ErrorsColumn = "errors"
+
+// errMissingHeader is returned for input without a header row
+var errMissingHeader = errors.New("csvvalidate: missing header row")
+
+// CellError is a validation error located in the CSV. Row is the 1-based line the record starts
+// on (the header is row 1) and Column the 1-based column of its field, 0 when the field has no
+// column. Records that are not valid CSV are reported with their parse error and no Field.
+type CellError struct {
+	Row     int
+	Column  int
+	Header  string
+	Field   string
+	Message string
+}
+
+// String renders the error as "row 3, column 2 (email): message"
+func (e CellError) String() string {
+	if e.Column == 0 && e.Field == "" {
+		return fmt.Sprintf("row %d: %s", e.Row, e.Message)
+	}
+	if e.Column == 0 {
+		return fmt.Sprintf("row %d (%s): %s", e.Row, e.Field, e.Message)
+	}
+	return fmt.Sprintf("row %d, column %d (%s): %s", e.Row, e.Column, e.Header, e.Message)
+}
+
+// Report summarizes the validation of a CSV
+type Report struct {
+	Rows    int
+	Valid   int
+	Invalid int
+	Errors  []CellError
+}
+
+// IsValid reports whether every row passed validation
+func (r *Report) IsValid() bool {
+	return r.Invalid == 0
+}
+
+// Option configures Validate
+type Option func(*config)
+
+type config struct {
+	columns   map[string]string
+	comma     rune
+	annotated io.Writer
+}
+
+// WithColumnMap maps header names to rule fields ("E-Mail" to "email", "City" to "address.city").
+// Unmapped headers are used as field names; map a header to "" to ignore its column.
+func WithColumnMap(columns map[string]string) Option {
+	return func(c *config) {
+		c.columns = columns
+	}
+}
+
+// WithComma sets the field delimiter (',' by default), e.g. ';' or '\t'
+func WithComma(comma rune) Option {
+	return func(c *config) {
+		c.comma = comma
+	}
+}
+
+// WithAnnotatedOutput writes the header and every failing row to w as CSV, with an ErrorsColumn
+// listing the row's messages
+func WithAnnotatedOutput(w io.Writer) Option {
+	return func(c *config) {
+		c.annotated = w
+	}
+}
+
+// Validate reads a CSV with a header row from r and validates every row against rules with v.
+// Cells are validated as strings; malformed records (e.g. a bare quote) count as invalid rows and
+// the rows after them are still validated. It returns an error only when the input cannot be read,
+// the header is not valid CSV, or the annotated output cannot be written.
+func Validate(v *validator.Validator, r io.Reader, rules map[string]string, options ...Option) (*Report, error) {
+	cfg := config{comma: ','}
+	for _, option := range options {
+		option(&cfg)
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma = cfg.comma
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errMissingHeader
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, len(header))
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		field, mapped := cfg.columns[name]
+		if !mapped {
+			field = name
+		}
+		fields[i] = field
+		if field != "" {
+			columns[contract.KeyPointer(field)] = i + 1
+		}
+	}
+
+	var annotated *csv.Writer
+	if cfg.annotated != nil {
+		annotated = csv.NewWriter(cfg.annotated)
+		annotated.Comma = cfg.comma
+		if err := annotated.Write(append(append([]string{}, header...), ErrorsColumn)); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &Report{}
+	for {
+		cells, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			report.Rows++
+			report.Invalid++
+			rowError := CellError{Row: parseErr.StartLine, Message: parseErr.Err.Error()}
+			report.Errors = append(report.Errors, rowError)
+			if annotated != nil {
+				if err := annotated.Write(append(make([]string, len(header)), annotation([]CellError{rowError}))); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		row, _ := reader.FieldPos(0)
+
+		record := make(map[string]any, len(cells))
+		for i, cell := range cells {
+			if i < len(fields) && fields[i] != "" {
+				utils.SetPath(record, fields[i], cell)
+			}
+		}
+
+		report.Rows++
+		result := v.ValidateWithResult(record, rules)
+		if result.IsValid() {
+			report.Valid++
+			continue
+		}
+		report.Invalid++
+		rowErrors := cellErrors(row, result, header, columns)
+		report.Errors = append(report.Errors, rowErrors...)
+
+		if annotated != nil {
+			if err := annotated.Write(append(append([]string{}, cells...), annotation(rowErrors))); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if annotated != nil {
+		annotated.Flush()
+		if err := annotated.Error(); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// cellErrors locates the errors of one row, ordered by column then field
+func cellErrors(row int, result contract.Result, header []string, columns map[string]int) []CellError {
+	var rowErrors []CellError
+	for field, messages := range result.Errors() {
+		column := columns[contract.KeyPointer(field)]
+		name := ""
+		if column > 0 {
+			name = header[column-1]
+		}
+		for _, message := range messages {
+			rowErrors = append(rowErrors, CellError{
+				Row: row, Column: column, Header: name, Field: field, Message: message,
+			})
+		}
+	}
+	sort.SliceStable(rowErrors, func(i, j int) bool {
+		if rowErrors[i].Column != rowErrors[j].Column {
+			return rowErrors[i].Column < rowErrors[j].Column
+		}
+		return rowErrors[i].Field < rowErrors[j].Field
+	})
+	return rowErrors
+}
+
+// annotation joins the messages of a row for the ErrorsColumn ("email: ...; name: ...")
+func annotation(rowErrors []CellError) string {
+	parts := make([]string, len(rowErrors))
+	for i, cellError := range rowErrors {
+		label := cellError.Header
+		if label == "" {
+			label = cellError.Field
+		}
+		if label == "" {
+			parts[i] = cellError.Message
+			continue
+		}
+		parts[i] = label + ": " + cellError.Message
+	}
+	return strings.Join(parts, "; ")
+}
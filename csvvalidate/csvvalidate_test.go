@@ -0,0 +1,85 @@
+package csvvalidate_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/next-trace/scg-validator/csvvalidate"
+	"github.com/next-trace/scg-validator/validator"
+)
+
+func TestValidate_ReportsRowAndColumn(t *testing.T) {
+	input := "Name;E-Mail;City\nJane;jane@example.com;Berlin\n;nope;Paris\nJoe;joe@example.com;\n"
+	rules := map[string]string{
+		"name":         "required",
+		"email":        "required|email",
+		"address.city": "required",
+		"age":          "required",
+	}
+
+	var out strings.Builder
+	report, err := csvvalidate.Validate(validator.New(), strings.NewReader(input), rules,
+		csvvalidate.WithComma(';'),
+		csvvalidate.WithColumnMap(map[string]string{"Name": "name", "E-Mail": "email", "City": "address.city"}),
+		csvvalidate.WithAnnotatedOutput(&out),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Rows != 3 || report.Valid != 0 || report.Invalid != 3 || report.IsValid() {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	var row3 []csvvalidate.CellError
+	for _, cellError := range report.Errors {
+		if cellError.Row == 3 {
+			row3 = append(row3, cellError)
+		}
+	}
+	if len(row3) != 3 || row3[0].Column != 0 || row3[0].Field != "age" ||
+		row3[1].Column != 1 || row3[1].Header != "Name" || row3[2].Column != 2 || row3[2].Field != "email" {
+		t.Fatalf("unexpected errors of row 3: %+v", row3)
+	}
+	if got := row3[1].String(); !strings.HasPrefix(got, "row 3, column 1 (Name): ") {
+		t.Fatalf("unexpected rendering: %q", got)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 || lines[0] != "Name;E-Mail;City;errors" || !strings.HasPrefix(lines[2], ";nope;Paris;") {
+		t.Fatalf("unexpected annotated output:\n%s", out.String())
+	}
+	if !strings.Contains(lines[3], "City: ") {
+		t.Fatalf("expected the missing city to be annotated: %q", lines[3])
+	}
+}
+
+func TestValidate_MissingHeader(t *testing.T) {
+	if _, err := csvvalidate.Validate(validator.New(), strings.NewReader(""), nil); err == nil {
+		t.Fatal("expected an error for empty input")
+	}
+}
+
+func TestValidate_KeepsGoingAfterMalformedRecords(t *testing.T) {
+	input := "name,bio\nJane,\"spans\ntwo lines\"\nJo\"e,ok\n,third\n"
+	rules := map[string]string{"name": "required"}
+
+	var out strings.Builder
+	report, err := csvvalidate.Validate(validator.New(), strings.NewReader(input), rules,
+		csvvalidate.WithAnnotatedOutput(&out))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Rows != 3 || report.Valid != 1 || report.Invalid != 2 || len(report.Errors) != 2 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if malformed := report.Errors[0]; malformed.Row != 4 || malformed.Field != "" ||
+		!strings.HasPrefix(malformed.String(), "row 4: ") {
+		t.Fatalf("expected the bare quote on line 4 to be reported, got %+v", malformed)
+	}
+	if missing := report.Errors[1]; missing.Row != 5 || missing.Header != "name" {
+		t.Fatalf("expected the row after the multi-line cell to report line 5, got %+v", missing)
+	}
+	if !strings.Contains(out.String(), "bare") {
+		t.Fatalf("expected the parse error to be annotated:\n%s", out.String())
+	}
+}
@@ -0,0 +1,8 @@
+// Package csvvalidate validates the rows of a CSV file with a header row against one set of
+// rules and reports each error with its row and column, optionally writing the failing rows
+// to an annotated error CSV.
+//
+//	report, err := csvvalidate.Validate(v, file, map[string]string{"email": "required|email"},
+//		csvvalidate.WithColumnMap(map[string]string{"E-Mail": "email"}),
+//		csvvalidate.WithAnnotatedOutput(errorsFile))
+package csvvalidate
@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"sync"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/parser"
+)
+
+// DefaultConcurrency bounds how many async rules run at once when WithConcurrency is not used
+const DefaultConcurrency = 8
+
+// asyncTask is an async rule scheduled during Execute, with its outcome once done
+type asyncTask struct {
+	field      string
+	parsedRule parser.ParsedRule
+	rule       contract.Rule
+	ctx        contract.RuleContext
+	err        error
+}
+
+// asyncBatch runs the async rules of one Execute on a bounded number of goroutines
+type asyncBatch struct {
+	slots chan struct{}
+	wg    sync.WaitGroup
+	tasks []*asyncTask
+}
+
+// newAsyncBatch creates a batch running at most limit rules concurrently
+func newAsyncBatch(limit int) *asyncBatch {
+	return &asyncBatch{slots: make(chan struct{}, limit)}
+}
+
+// schedule starts validating rule as soon as a worker slot is free. It blocks until then, so
+// at most limit goroutines exist however many async rules a run schedules.
+func (b *asyncBatch) schedule(
+	field string,
+	parsedRule parser.ParsedRule,
+	rule contract.Rule,
+	ctx contract.RuleContext,
+	evaluate RuleFunc,
+) {
+	task := &asyncTask{field: field, parsedRule: parsedRule, rule: rule, ctx: ctx}
+	b.tasks = append(b.tasks, task)
+
+	b.slots <- struct{}{}
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		defer func() { <-b.slots }()
+		task.err = evaluate(parsedRule.Name, rule, ctx)
+	}()
+}
+
+// wait blocks until every scheduled rule finished and returns them in scheduling order
+func (b *asyncBatch) wait() []*asyncTask {
+	b.wg.Wait()
+	return b.tasks
+}
+
+// execution holds the state shared by every field of one Execute
+type execution struct {
+	async    *asyncBatch
+	evaluate RuleFunc
+}
+
+// newExecution creates the state of one Execute, running at most the engine's concurrency limit of
+// async rules at once and evaluating rules through its middleware, behind the run's memo
+func (e *Engine) newExecution() *execution {
+	return &execution{
+		async:    newAsyncBatch(e.concurrency()),
+		evaluate: newRuleMemo().wrap(e.ruleChain(evaluateRule)),
+	}
+}
+
+// inline returns a view of the run that validates async rules synchronously
+func (r *execution) inline() *execution {
+	return &execution{evaluate: r.evaluate}
+}
+
+// concurrency returns the async worker limit of the engine
+func (e *Engine) concurrency() int {
+	if e.Concurrency > 0 {
+		return e.Concurrency
+	}
+	return DefaultConcurrency
+}
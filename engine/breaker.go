@@ -0,0 +1,137 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// ErrCircuitOpen is the error of rules rejected by an open circuit breaker
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// BreakerPolicy selects what happens to an external rule while its circuit breaker is open
+type BreakerPolicy int
+
+const (
+	// BreakerFailClosed rejects the rule, reporting its usual error message
+	BreakerFailClosed BreakerPolicy = iota
+	// BreakerFailOpen lets the rule pass without evaluating it
+	BreakerFailOpen
+	// BreakerWarn reports the rule's failure as a warning, leaving the result valid
+	BreakerWarn
+)
+
+// BreakerState is the state of a CircuitBreaker
+type BreakerState int
+
+const (
+	// BreakerClosed evaluates rules normally
+	BreakerClosed BreakerState = iota
+	// BreakerOpen short-circuits rules until the cooldown elapsed
+	BreakerOpen
+	// BreakerHalfOpen lets a single trial evaluation through after the cooldown
+	BreakerHalfOpen
+)
+
+// CircuitBreaker opens after threshold consecutive contract.ExternalError failures and lets a
+// trial evaluation through once cooldown elapsed; the trial closes it again on success.
+// It is safe for concurrent use.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// NewCircuitBreaker creates a closed breaker
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &CircuitBreaker{threshold: threshold, cooldown: cooldown}
+}
+
+// State returns the current state, moving an open breaker to half-open once the cooldown elapsed
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance()
+	return b.state
+}
+
+// Allow reports whether an evaluation may run: always while closed, once while half-open
+func (b *CircuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance()
+	switch b.state {
+	case BreakerClosed:
+		return true
+	case BreakerHalfOpen:
+		if b.trial {
+			return false
+		}
+		b.trial = true
+		return true
+	default:
+		return false
+	}
+}
+
+// Record reports the outcome of an allowed evaluation: external errors count as failures,
+// anything else (including the value failing the rule) as a success
+func (b *CircuitBreaker) Record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !contract.IsExternalError(err) {
+		b.state, b.failures, b.trial = BreakerClosed, 0, false
+		return
+	}
+	b.failures++
+	if b.state == BreakerHalfOpen || b.failures >= b.threshold {
+		b.state, b.openedAt, b.trial = BreakerOpen, time.Now(), false
+	}
+}
+
+// advance moves an open breaker to half-open once its cooldown elapsed
+func (b *CircuitBreaker) advance() {
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.cooldown {
+		b.state, b.trial = BreakerHalfOpen, false
+	}
+}
+
+// CircuitBreakerMiddleware guards the contract.ExternalRule rules of resource (every external
+// rule when resource is empty) with breaker, applying policy while it is open
+func CircuitBreakerMiddleware(resource string, breaker *CircuitBreaker, policy BreakerPolicy) RuleMiddleware {
+	return func(next RuleFunc) RuleFunc {
+		return func(name string, rule contract.Rule, ctx contract.RuleContext) error {
+			external, ok := rule.(contract.ExternalRule)
+			if !ok || (resource != "" && external.Resource() != resource) {
+				return next(name, rule, ctx)
+			}
+			if breaker.Allow() {
+				err := next(name, rule, ctx)
+				breaker.Record(err)
+				return err
+			}
+
+			err := fmt.Errorf("%w for %s", ErrCircuitOpen, external.Resource())
+			switch policy {
+			case BreakerFailOpen:
+				return nil
+			case BreakerWarn:
+				return contract.AsWarning(err)
+			default:
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// errorBudget records the errors of a run up to the limits of WithMaxErrorsPerField and
+// WithMaxTotalErrors. Errors beyond them are dropped and the result is marked truncated.
+type errorBudget struct {
+	contract.ResultAccumulator
+	perField int
+	total    int
+	counts   map[string]int
+	count    int
+}
+
+// withErrorBudget wraps the accumulator of a run in an errorBudget when a limit is set
+func (e *Engine) withErrorBudget(result contract.ResultAccumulator) contract.ResultAccumulator {
+	if e.MaxErrorsPerField <= 0 && e.MaxTotalErrors <= 0 {
+		return result
+	}
+	return &errorBudget{
+		ResultAccumulator: result,
+		perField:          e.MaxErrorsPerField,
+		total:             e.MaxTotalErrors,
+		counts:            make(map[string]int),
+	}
+}
+
+// AddRuleError records the error unless field or the run has used up its budget
+func (b *errorBudget) AddRuleError(field string, rule contract.ParsedRule, message string) {
+	if b.spent() || b.perField > 0 && b.counts[field] >= b.perField {
+		b.MarkTruncated()
+		return
+	}
+	b.counts[field]++
+	b.count++
+	b.ResultAccumulator.AddRuleError(field, rule, message)
+}
+
+// spent reports whether the total budget is used up, so the remaining fields need not be validated
+func (b *errorBudget) spent() bool {
+	return b != nil && b.total > 0 && b.count >= b.total
+}
+
+// fieldOrder returns the fields to validate, sorted when a total budget is set so the same
+// errors are kept from one run to the next
+func (e *Engine) fieldOrder(fields map[string]string) []string {
+	order := make([]string, 0, len(fields))
+	for field := range fields {
+		order = append(order, field)
+	}
+	if e.MaxTotalErrors > 0 {
+		sort.Strings(order)
+	}
+	return order
+}
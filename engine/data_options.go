@@ -0,0 +1,50 @@
+package engine
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// DataOption configures how a DataProvider holds its data
+type DataOption func(*DataProvider)
+
+// TrimStrings makes the provider hold a copy of its data with surrounding whitespace trimmed from
+// every string, nested values included, except the listed fields (dot paths such as "password" or
+// "users.0.password"). Rules and Result.Validated() both see the trimmed values; the input is not
+// modified.
+func TrimStrings(except ...string) DataOption {
+	excluded := make(map[string]struct{}, len(except))
+	for _, field := range except {
+		excluded[field] = struct{}{}
+	}
+
+	return func(d *DataProvider) {
+		trimmed := utils.CloneData(d.data)
+		for field, value := range trimmed {
+			trimmed[field] = trimValue(field, value, excluded)
+		}
+		d.data = trimmed
+	}
+}
+
+// trimValue trims the strings held by value at field, descending into containers
+func trimValue(field string, value any, excluded map[string]struct{}) any {
+	if _, skip := excluded[field]; skip {
+		return value
+	}
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case map[string]any:
+		for key, item := range v {
+			v[key] = trimValue(field+"."+key, item, excluded)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = trimValue(field+"."+strconv.Itoa(i), item, excluded)
+		}
+	}
+	return value
+}
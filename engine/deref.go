@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"reflect"
+
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// DerefAll dereferences pointers and interfaces at any depth (see WithDereference)
+const DerefAll = -1
+
+// NilPointerPolicy decides how a typed nil pointer in the input is seen by the rules
+type NilPointerPolicy int
+
+const (
+	// NilPointerKeep hands typed nil pointers to the rules as they are (the default): the field is
+	// present, required fails and nullable does not apply, since the value is not a plain nil.
+	NilPointerKeep NilPointerPolicy = iota
+	// NilPointerEmpty treats a typed nil pointer as present but empty: rules see nil, so nullable
+	// applies and the field still counts as present.
+	NilPointerEmpty
+	// NilPointerMissing treats a field holding a typed nil pointer as absent from the input, for
+	// the field's own rules, presence rules of other fields and partial validation alike.
+	NilPointerMissing
+)
+
+// dereferences reports whether the engine rewrites pointers of the input before validation
+func (e *Engine) dereferences() bool {
+	return e.DerefDepth != 0 || e.NilPointers != NilPointerKeep
+}
+
+// dereference applies the engine's dereference policy to a copy of data
+func (e *Engine) dereference(data map[string]any) map[string]any {
+	dereferenced, _ := e.dereferenceValue(utils.CloneData(data))
+	return dereferenced.(map[string]any)
+}
+
+// dereferenceValue dereferences value, descending into containers. It reports false when a
+// typed nil pointer makes the value missing.
+func (e *Engine) dereferenceValue(value any) (any, bool) {
+	value, isNil := e.indirectValue(value)
+	if isNil {
+		switch e.NilPointers {
+		case NilPointerEmpty:
+			return nil, true
+		case NilPointerMissing:
+			return nil, false
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if dereferenced, present := e.dereferenceValue(item); present {
+				v[key] = dereferenced
+			} else {
+				delete(v, key)
+			}
+		}
+		return v, true
+	case []any:
+		for i, item := range v {
+			// List elements keep their index, a missing one becomes nil
+			v[i], _ = e.dereferenceValue(item)
+		}
+		return v, true
+	}
+	return value, true
+}
+
+// indirectValue strips up to DerefDepth levels of pointers and interfaces from value and reports
+// whether it stopped at a typed nil pointer
+func (e *Engine) indirectValue(value any) (any, bool) {
+	val := reflect.ValueOf(value)
+	for depth := 0; val.IsValid() && (val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface); depth++ {
+		if val.IsNil() {
+			return value, val.Kind() == reflect.Ptr
+		}
+		if e.DerefDepth != DerefAll && depth >= e.DerefDepth {
+			break
+		}
+		val = val.Elem()
+		value = val.Interface()
+	}
+	return value, false
+}
@@ -1,10 +1,16 @@
 package engine
 
 import (
+	"context"
+	"errors"
+	"sort"
+
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/message"
 	"github.com/next-trace/scg-validator/parser"
+	"github.com/next-trace/scg-validator/registry"
 	"github.com/next-trace/scg-validator/rules"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 // Define constants to avoid magic strings and magic numbers
@@ -12,118 +18,476 @@ const (
 	BailRuleName         = "bail"
 	UnknownRuleErrorMsg  = "Unknown rule: "
 	RuleCreationErrorMsg = "Rule creation error: "
+	DefaultScoreWeight   = 1.0
+
+	negatedRuleErrorMsg = "the :attribute must not satisfy the negated rule"
 )
 
+// errDisabledRule is the error of rules disabled with registry.DisabledFail or DisabledWarn
+var errDisabledRule = errors.New("rule is disabled")
+
 // Engine implements the ValidationEngine interface
 type Engine struct {
 	Registry        contract.Registry
 	MessageResolver contract.MessageResolver
+	KeyStyle        contract.KeyStyle
+	Preprocessors   []Preprocessor
+	DataOptions     []DataOption
+	Profile         string
+	Context         context.Context
+	Concurrency     int
+	CoerceStrings   bool
+	Partial         bool
+	ScoreWeights    map[string]float64
+	ResultFactory   contract.ResultFactory
+	Middleware      []RuleMiddleware
+	Tenant          string
+	RejectUnknown   bool
+	AllowedFields   []string
+	Projection      bool
+	Embedding       EmbedMode
+	DerefDepth      int
+	NilPointers     NilPointerPolicy
+	RequiredPreset  contract.RequiredPreset
+	// MaxErrorsPerField and MaxTotalErrors bound the errors of a run, 0 for no limit
+	MaxErrorsPerField int
+	MaxTotalErrors    int
+	// ProjectionReport receives the input fields dropped by projection, if set
+	ProjectionReport func(dropped []string)
 }
 
 // Ensure Engine implements contract.ValidationEngine
 var _ contract.ValidationEngine = (*Engine)(nil)
 
 // NewEngine creates a new validator engine
-func NewEngine() *Engine {
+func NewEngine(options ...Option) *Engine {
 	// Create a new registry with all default rules using options pattern
-	reg := rules.NewRuleRegistry()
+	return newEngine(rules.NewRuleRegistry(), options)
+}
 
-	return &Engine{
+// NewModularEngine creates a validator engine with only the built-in rules of modules, e.g.
+// rules.Core() and rules.Strings(). Programs that do not call NewEngine leave the other rules
+// out of their binary.
+func NewModularEngine(modules []rules.Module, options ...Option) *Engine {
+	return newEngine(rules.NewModuleRegistry(modules), options)
+}
+
+func newEngine(reg contract.Registry, options []Option) *Engine {
+	e := &Engine{
 		Registry:        reg,
 		MessageResolver: message.NewRequestScopedResolver(),
 	}
+	for _, option := range options {
+		option(e)
+	}
+	return e
 }
 
 // Execute validates data against the provided rules
 func (e *Engine) Execute(data contract.DataProvider, rulesMap map[string]string) contract.Result {
-	validationErrors := contract.NewValidationErrors()
+	if e.Tenant != "" {
+		return e.tenantScoped(false).Execute(data, rulesMap)
+	}
+	result := e.newResult()
+	validationErrors := e.withErrorBudget(result)
+	budget, _ := validationErrors.(*errorBudget)
+
+	data = e.prepareData(data)
 
 	// Iterate over each field and corresponding rules
+	validated := make(map[string]any)
+	run := e.newExecution()
+	fields := e.expandRules(data, rulesMap)
+	if e.RejectUnknown {
+		e.rejectUnknownFields(data, rulesMap, fields, validationErrors)
+	}
+	for _, field := range e.fieldOrder(fields) {
+		if budget.spent() {
+			// The remaining fields could only add errors that would be dropped
+			validationErrors.MarkTruncated()
+			break
+		}
+		normalized, isNormalized := e.validateField(field, fields[field], data, validationErrors, run)
+		if isNormalized {
+			utils.SetPath(validated, field, normalized)
+		} else if value, exists := data.Get(field); exists {
+			utils.SetPath(validated, field, value)
+		}
+	}
+	if e.Projection {
+		validated = e.project(data, fields, validated)
+	}
+	validationErrors.SetValidated(validated)
+
+	// Merge async results in scheduling order, after the synchronous ones of each field
+	for _, task := range run.async.wait() {
+		e.recordResult(task.field, task.parsedRule, task.rule, task.ctx, task.err, validationErrors)
+	}
+
+	return result
+}
+
+// prepareData returns the data rules run against: custom providers unwrap their database values,
+// then the dereference policy, the data options and the preprocessors apply in that order
+func (e *Engine) prepareData(data contract.DataProvider) contract.DataProvider {
+	if e.dereferences() {
+		data = NewDataProvider(e.dereference(data.All()))
+	} else if _, builtin := data.(*DataProvider); !builtin {
+		data = unwrappingProvider{data}
+	}
+	if len(e.DataOptions) > 0 {
+		data = NewDataProvider(data.All(), e.DataOptions...)
+	}
+	if len(e.Preprocessors) > 0 {
+		data = NewDataProvider(e.preprocess(data.All()))
+	}
+	return data
+}
+
+// newResult creates the accumulator of a run, from ResultFactory when one is set
+func (e *Engine) newResult() contract.ResultAccumulator {
+	if e.ResultFactory != nil {
+		return e.ResultFactory()
+	}
+	return contract.NewValidationErrors()
+}
+
+// expandRules resolves wildcard and index range keys (items.*.sku, items.0-4.*) against data.
+// Rules reaching the same concrete field from several keys are joined, literal keys last.
+func (e *Engine) expandRules(data contract.DataProvider, rulesMap map[string]string) map[string]string {
+	patterns := make([]string, 0, len(rulesMap))
+	for field := range rulesMap {
+		if utils.HasPathPattern(field) {
+			patterns = append(patterns, field)
+		}
+	}
+	if len(patterns) == 0 {
+		return rulesMap
+	}
+	sort.Strings(patterns)
+
+	expanded := make(map[string]string, len(rulesMap))
+	allData := data.All()
+	for _, pattern := range patterns {
+		for _, field := range utils.ExpandPath(allData, pattern) {
+			expanded[field] = joinRuleStrings(expanded[field], rulesMap[pattern])
+		}
+	}
 	for field, ruleString := range rulesMap {
-		e.validateField(field, ruleString, data, validationErrors)
+		if !utils.HasPathPattern(field) {
+			expanded[field] = joinRuleStrings(expanded[field], ruleString)
+		}
 	}
+	return expanded
+}
 
-	return validationErrors
+// joinRuleStrings concatenates two pipe-separated rule strings
+func joinRuleStrings(existing, addition string) string {
+	if existing == "" {
+		return addition
+	}
+	if addition == "" {
+		return existing
+	}
+	return existing + "|" + addition
 }
 
-// validateField validates a single field against its rules
+// validateField validates a single field against its rules.
+// It returns the field value normalized by contract.Normalizer rules, if any of them passed.
 func (e *Engine) validateField(
 	field, ruleString string,
 	data contract.DataProvider,
-	validationErrors *contract.ValidationErrors,
-) {
+	validationErrors contract.ResultAccumulator,
+	run *execution,
+) (any, bool) {
+	if e.skipsAbsent(field, data) {
+		return nil, false
+	}
+
 	parsedRules := parser.ParseRules(ruleString)
 	value, _ := data.Get(field)
 	allData := data.All()
 
 	stopOnFailure := e.shouldStopOnFailure(parsedRules)
+	if stopOnFailure {
+		// bail needs each outcome before running the next rule
+		run = run.inline()
+	}
+	skipNonImplicit := value == nil && hasRule(parsedRules, NullableRuleName)
+	normalized := false
 
 	for _, parsedRule := range parsedRules {
 		if parsedRule.Name == BailRuleName {
 			continue
 		}
+		if skipNonImplicit && !isImplicitRule(parsedRule.Name) {
+			continue
+		}
 
-		if e.validateSingleRule(field, value, parsedRule, allData, validationErrors) && stopOnFailure {
+		failed, next, isNormalized := e.validateSingleRule(field, value, parsedRule, allData, validationErrors, run)
+		if isNormalized {
+			// Later rules see the canonical value
+			value, normalized = next, true
+		}
+		if failed && stopOnFailure {
 			break
 		}
 	}
+
+	return value, normalized
+}
+
+// skipsAbsent reports whether partial validation (WithPartial) skips field because it is absent from data
+func (e *Engine) skipsAbsent(field string, data contract.DataProvider) bool {
+	return e.Partial && !data.Has(field)
 }
 
 // shouldStopOnFailure checks if the bail rule is present in the parsed rules
 func (e *Engine) shouldStopOnFailure(parsedRules []parser.ParsedRule) bool {
+	return hasRule(parsedRules, BailRuleName)
+}
+
+// hasRule checks if a rule with the given name is present in the parsed rules
+func hasRule(parsedRules []parser.ParsedRule, name string) bool {
 	for _, rule := range parsedRules {
-		if rule.Name == BailRuleName {
+		if rule.Name == name {
 			return true
 		}
 	}
 	return false
 }
 
-// validateSingleRule validates a single rule and returns true if validation failed
+// validateSingleRule validates a single rule and returns true if validation failed.
+// When a contract.Normalizer rule passes, its normalized value is returned as well.
+// Async rules are handed to the run's worker pool (unless it is inline) and reported as passing for now;
+// memoized rules reuse the outcome of an identical earlier evaluation in the same run.
 func (e *Engine) validateSingleRule(
 	field string,
 	value interface{},
 	parsedRule parser.ParsedRule,
 	allData map[string]interface{},
-	validationErrors *contract.ValidationErrors,
-) bool {
+	validationErrors contract.ResultAccumulator,
+	run *execution,
+) (failed bool, normalized any, isNormalized bool) {
+	parsedRule.Name = e.migrateRuleName(parsedRule.Name, true)
 	ruleName := parsedRule.Name
 
 	// Fetch the rule creator from the registry
 	ruleCreator, exists := e.Registry.Get(ruleName)
 	if !exists {
-		validationErrors.AddError(field, UnknownRuleErrorMsg+ruleName)
-		return true
+		validationErrors.AddRuleError(e.KeyStyle.Format(field), failedRule(parsedRule), UnknownRuleErrorMsg+ruleName)
+		return true, nil, false
 	}
 
 	// Create the rule and handle any errors during creation
 	rule, err := ruleCreator(parsedRule.Params)
 	if err != nil {
-		validationErrors.AddError(field, RuleCreationErrorMsg+err.Error())
-		return true
+		validationErrors.AddRuleError(e.KeyStyle.Format(field), failedRule(parsedRule), RuleCreationErrorMsg+err.Error())
+		return true, nil, false
 	}
 
 	// Create validation context and perform the validation
-	ctx := contract.NewValidationContext(field, value, parsedRule.Params, allData)
+	ctx := contract.NewValidationContext(field, value, parsedRule.Params, allData).
+		WithContext(e.Context).
+		WithStringCoercion(e.CoerceStrings).
+		WithRequiredPreset(e.RequiredPreset)
+
+	if behavior, disabled := registry.FindDisabled(ruleName); disabled {
+		return e.recordDisabled(field, parsedRule, rule, ctx, behavior, validationErrors), nil, false
+	}
+
+	if async, ok := rule.(contract.AsyncRule); ok && run.async != nil && async.Async() {
+		run.async.schedule(field, parsedRule, rule, ctx, run.evaluate)
+		return false, nil, false
+	}
 
 	// Validate and handle error if validation fails
-	if err := rule.Validate(ctx); err != nil {
-		errorMessage := e.resolveErrorMessage(ruleName, field, parsedRule.Params, err)
-		validationErrors.AddError(field, errorMessage)
-		return true
+	err = run.evaluate(ruleName, rule, ctx)
+	if e.recordResult(field, parsedRule, rule, ctx, err, validationErrors) {
+		return true, nil, false
+	}
+	warning := parsedRule.Warning || contract.IsWarning(err)
+	if errors.Is(err, contract.ErrRuleSkipped) || (warning && (err != nil) != parsedRule.Negated) {
+		// A skipped rule or failed warning does not stop validation, but must not normalize the value
+		return false, nil, false
 	}
 
+	if normalizer, ok := rule.(contract.Normalizer); ok && value != nil {
+		return false, normalizer.Normalize(ctx), true
+	}
+	return false, nil, false
+}
+
+// recordResult adds the error message of a rule outcome, honoring negation, or records a skipped
+// rule, and returns true if the rule failed
+func (e *Engine) recordResult(
+	field string,
+	parsedRule parser.ParsedRule,
+	rule contract.Rule,
+	ctx contract.RuleContext,
+	err error,
+	validationErrors contract.ResultAccumulator,
+) bool {
+	if errors.Is(err, contract.ErrRuleSkipped) {
+		validationErrors.AddSkipped(e.KeyStyle.Format(field), failedRule(parsedRule).Name)
+		return false
+	}
+	if contract.IsWarning(err) {
+		// Middleware degraded the failure to a warning
+		parsedRule.Warning = true
+	}
+	e.recordScore(field, parsedRule, (err != nil) != parsedRule.Negated, validationErrors)
+	if parsedRule.Negated {
+		if err != nil {
+			return false
+		}
+		// The positive message would be misleading, resolve the negated one instead
+		errorMessage := e.resolveErrorMessage(
+			parser.NegationPrefix+parsedRule.Name, nil, ctx, errors.New(negatedRuleErrorMsg),
+		)
+		return e.addFailure(field, parsedRule, errorMessage, validationErrors)
+	}
+	if err != nil {
+		errorMessage := e.resolveErrorMessage(parsedRule.Name, rule, ctx, err)
+		return e.addFailure(field, parsedRule, errorMessage, validationErrors)
+	}
 	return false
 }
 
-// resolveErrorMessage resolves the error message using the message resolver
-func (e *Engine) resolveErrorMessage(ruleName, field string, params []string, originalError error) string {
+// migrateRuleName returns the replacement of a deprecated rule name (see registry.Deprecate) when
+// it is registered, reporting the use when report is set, or name itself
+func (e *Engine) migrateRuleName(name string, report bool) string {
+	replacement, deprecated := registry.FindDeprecation(name)
+	if !deprecated || !e.Registry.Has(replacement) {
+		return name
+	}
+	if report {
+		registry.ReportDeprecated(name)
+	}
+	return replacement
+}
+
+// recordDisabled records the outcome of a rule disabled with registry.Disable, without evaluating
+// it, and returns true if it counts as a validation failure
+func (e *Engine) recordDisabled(
+	field string,
+	parsedRule parser.ParsedRule,
+	rule contract.Rule,
+	ctx contract.RuleContext,
+	behavior registry.DisabledBehavior,
+	validationErrors contract.ResultAccumulator,
+) bool {
+	if behavior == registry.DisabledPass {
+		return false
+	}
+	parsedRule.Warning = parsedRule.Warning || behavior == registry.DisabledWarn
+	// The outcome is a failure either way, so a negated rule must see a passing evaluation
+	err := errDisabledRule
+	if parsedRule.Negated {
+		err = nil
+	}
+	return e.recordResult(field, parsedRule, rule, ctx, err, validationErrors)
+}
+
+// recordScore adds the weight of a rule outcome when scoring is enabled (WithScoring)
+func (e *Engine) recordScore(
+	field string,
+	parsedRule parser.ParsedRule,
+	failed bool,
+	validationErrors contract.ResultAccumulator,
+) {
+	if e.ScoreWeights == nil {
+		return
+	}
+	validationErrors.AddScore(e.KeyStyle.Format(field), e.scoreWeight(field, parsedRule.Name), failed)
+}
+
+// scoreWeight returns the weight of rule on field: the "<rule>.<field>" weight, then the rule's
+// weight, then DefaultScoreWeight. nullable only marks a field and weighs nothing by default.
+func (e *Engine) scoreWeight(field, rule string) float64 {
+	if weight, ok := e.ScoreWeights[rule+"."+field]; ok {
+		return weight
+	}
+	if weight, ok := e.ScoreWeights[rule]; ok {
+		return weight
+	}
+	if rule == NullableRuleName {
+		return 0
+	}
+	return DefaultScoreWeight
+}
+
+// addFailure records a failed rule as an error, or as a warning for "warn:" rules, and returns
+// true if it counts as a validation failure
+func (e *Engine) addFailure(
+	field string,
+	parsedRule parser.ParsedRule,
+	message string,
+	validationErrors contract.ResultAccumulator,
+) bool {
+	if parsedRule.Warning {
+		validationErrors.AddWarning(e.KeyStyle.Format(field), message)
+		return false
+	}
+	validationErrors.AddRuleError(e.KeyStyle.Format(field), failedRule(parsedRule), message)
+	return true
+}
+
+// failedRule describes a failed rule for the result, keeping the "!" of negated rules
+func failedRule(parsedRule parser.ParsedRule) contract.ParsedRule {
+	name := parsedRule.Name
+	if parsedRule.Negated {
+		name = parser.NegationPrefix + name
+	}
+	return contract.ParsedRule{Name: name, Parameters: parsedRule.Params}
+}
+
+// resolveErrorMessage resolves the error message using the message resolver.
+// A rule implementing contract.MessageProvider supplies its own message as fallback.
+func (e *Engine) resolveErrorMessage(
+	ruleName string,
+	rule contract.Rule,
+	ctx contract.RuleContext,
+	originalError error,
+) string {
 	if e.MessageResolver != nil {
-		return e.MessageResolver.Resolve(ruleName, field, params)
+		fallback := ""
+		if provider, ok := rule.(contract.MessageProvider); ok {
+			fallback = provider.Message()
+		}
+		return e.resolveRuleMessage(ruleName, ctx, fallback)
 	}
 	return originalError.Error()
 }
 
+// resolveRuleMessage resolves the message of a rule through the MessageResolver, using the rule
+// context when the resolver supports it
+func (e *Engine) resolveRuleMessage(ruleName string, ctx contract.RuleContext, fallback string) string {
+	if resolver, ok := e.MessageResolver.(contract.RuleMessageResolver); ok {
+		return resolver.ResolveRule(ruleName, ctx, fallback)
+	}
+	return e.MessageResolver.Resolve(ruleName, ctx.Field(), ctx.Parameters())
+}
+
+// StructEmbedding returns how the fields of embedded structs are keyed (WithEmbeddedStructs)
+func (e *Engine) StructEmbedding() EmbedMode {
+	if e.Embedding == "" {
+		return EmbedPrefixed
+	}
+	return e.Embedding
+}
+
+// ActiveProfile returns the rule set profile selected with WithProfile
+func (e *Engine) ActiveProfile() string {
+	return e.Profile
+}
+
+// SetContext sets the request context handed to rules through their RuleContext.
+// Use it on request-scoped engines only.
+func (e *Engine) SetContext(ctx context.Context) {
+	e.Context = ctx
+}
+
 // RegisterRule registers a new rule with the engine
 func (e *Engine) RegisterRule(name string, creator contract.RuleCreator) error {
 	return e.Registry.Register(name, creator)
@@ -141,6 +505,14 @@ func (e *Engine) SetCustomMessage(rule string, message string) {
 	}
 }
 
+// SetCustomMessageFunc sets a custom message function for a rule. It is ignored when the
+// message resolver does not support message functions.
+func (e *Engine) SetCustomMessageFunc(rule string, messageFunc contract.MessageFunc) {
+	if resolver, ok := e.MessageResolver.(contract.MessageFuncResolver); ok {
+		resolver.SetCustomMessageFunc(rule, messageFunc)
+	}
+}
+
 // SetCustomAttribute sets a custom attribute name for a field
 func (e *Engine) SetCustomAttribute(field string, attribute string) {
 	if e.MessageResolver != nil {
@@ -160,10 +532,37 @@ func (e *Engine) GetMessageResolver() contract.MessageResolver {
 
 // CloneWithResolver creates a new Engine that shares the same registry but uses the provided resolver
 func (e *Engine) CloneWithResolver(resolver contract.MessageResolver) contract.ValidationEngine {
-	return &Engine{
-		Registry:        e.Registry,
-		MessageResolver: resolver,
+	clone := &Engine{
+		Registry:          e.Registry,
+		MessageResolver:   resolver,
+		KeyStyle:          e.KeyStyle,
+		Preprocessors:     e.Preprocessors,
+		DataOptions:       e.DataOptions,
+		Profile:           e.Profile,
+		Context:           e.Context,
+		Concurrency:       e.Concurrency,
+		CoerceStrings:     e.CoerceStrings,
+		Partial:           e.Partial,
+		ScoreWeights:      e.ScoreWeights,
+		ResultFactory:     e.ResultFactory,
+		Middleware:        e.Middleware,
+		Tenant:            e.Tenant,
+		RejectUnknown:     e.RejectUnknown,
+		AllowedFields:     e.AllowedFields,
+		Projection:        e.Projection,
+		Embedding:         e.Embedding,
+		DerefDepth:        e.DerefDepth,
+		NilPointers:       e.NilPointers,
+		RequiredPreset:    e.RequiredPreset,
+		MaxErrorsPerField: e.MaxErrorsPerField,
+		MaxTotalErrors:    e.MaxTotalErrors,
+		ProjectionReport:  e.ProjectionReport,
+	}
+	if clone.Tenant != "" {
+		// Tenant messages go below the request's own custom messages, set after cloning
+		return clone.tenantScoped(true)
 	}
+	return clone
 }
 
 // DataProvider implementation for map[strings]interface{}
@@ -171,26 +570,25 @@ type DataProvider struct {
 	data map[string]interface{}
 }
 
-// NewDataProvider creates a new data provider from a map
-func NewDataProvider(data map[string]interface{}) *DataProvider {
-	return &DataProvider{data: data}
+// NewDataProvider creates a new data provider from a map, applying options such as TrimStrings.
+// Database values (sql.Null*, other driver.Valuer implementations) are unwrapped at any depth, so
+// rules, the data other fields are compared with and Result.Validated() all see plain values.
+func NewDataProvider(data map[string]interface{}, options ...DataOption) *DataProvider {
+	provider := &DataProvider{data: utils.UnwrapData(data)}
+	for _, option := range options {
+		option(provider)
+	}
+	return provider
 }
 
-// Get retrieves a value by key
+// Get retrieves a value by key, resolving dot-notation paths into nested data
 func (d *DataProvider) Get(key string) (interface{}, bool) {
-	if d.data == nil {
-		return nil, false
-	}
-	value, exists := d.data[key]
-	return value, exists
+	return utils.GetPath(d.data, key)
 }
 
 // Has checks if a key exists
 func (d *DataProvider) Has(key string) bool {
-	if d.data == nil {
-		return false
-	}
-	_, exists := d.data[key]
+	_, exists := utils.GetPath(d.data, key)
 	return exists
 }
 
@@ -198,3 +596,30 @@ func (d *DataProvider) Has(key string) bool {
 func (d *DataProvider) All() map[string]interface{} {
 	return d.data
 }
+
+// unwrappingProvider unwraps the database values of a custom contract.DataProvider as they are
+// read, keeping its own Get and Has semantics (lazy loading, virtual fields). Paths the provider
+// does not resolve itself ("profile.city" in a flat provider) are looked up in its All() data.
+type unwrappingProvider struct {
+	contract.DataProvider
+}
+
+// Get retrieves a value from the wrapped provider with its database values unwrapped
+func (p unwrappingProvider) Get(field string) (any, bool) {
+	value, exists := p.DataProvider.Get(field)
+	if !exists {
+		value, exists = utils.GetPath(p.DataProvider.All(), field)
+	}
+	return utils.UnwrapValue(value), exists
+}
+
+// Has checks if the wrapped provider or its All() data holds field
+func (p unwrappingProvider) Has(field string) bool {
+	_, exists := p.Get(field)
+	return exists
+}
+
+// All returns the data of the wrapped provider with its database values unwrapped
+func (p unwrappingProvider) All() map[string]any {
+	return utils.UnwrapData(p.DataProvider.All())
+}
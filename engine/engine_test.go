@@ -1,10 +1,19 @@
 package engine
 
 import (
+	"context"
+	"database/sql"
 	"errors"
+	"math"
+	"reflect"
+	"runtime"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry"
+	"github.com/next-trace/scg-validator/registry/tenant"
 )
 
 type alwaysFailRule struct{}
@@ -52,3 +61,1059 @@ func TestEngine_ResolveErrorMessage_FallbackToOriginal(t *testing.T) {
 		t.Fatalf("unexpected message: %q", got)
 	}
 }
+
+type messageRule struct{ alwaysFailRule }
+
+func (r *messageRule) Message() string { return "the :attribute is not a valid code" }
+
+func TestEngine_RuleMessageFallback(t *testing.T) {
+	e := NewEngine()
+	_ = e.Registry.Register("code", func(_ []string) (contract.Rule, error) { return &messageRule{}, nil })
+	_ = e.Registry.Register("custom_fail", func(_ []string) (contract.Rule, error) { return &alwaysFailRule{}, nil })
+
+	res := e.Execute(NewDataProvider(map[string]any{"f": 1}), map[string]string{"f": "code|custom_fail"})
+	errs := res.Errors()["f"]
+	if len(errs) != 2 || errs[0] != "the f is not a valid code" || errs[1] != "The f field is invalid" {
+		t.Fatalf("unexpected messages: %#v", errs)
+	}
+}
+
+// fieldResolver implements only contract.MessageResolver, not contract.RuleMessageResolver
+type fieldResolver struct{}
+
+func (fieldResolver) Resolve(rule, field string, _ []string) string { return field + " failed " + rule }
+func (fieldResolver) SetCustomMessage(string, string)               {}
+func (fieldResolver) SetCustomAttribute(string, string)             {}
+func (r fieldResolver) Clone() contract.MessageResolver             { return r }
+
+func TestEngine_PlainMessageResolver(t *testing.T) {
+	e := NewEngine()
+	e.SetMessageResolver(fieldResolver{})
+
+	res := e.Execute(NewDataProvider(map[string]any{"f": ""}), map[string]string{"f": "required"})
+	if got := res.FieldError("f"); got != "f failed required" {
+		t.Fatalf("unexpected message: %q", got)
+	}
+}
+
+func TestEngine_KeyStyle(t *testing.T) {
+	data := NewDataProvider(map[string]any{
+		"items": []any{map[string]any{"name": ""}},
+	})
+	rules := map[string]string{"items.0.name": "required"}
+
+	tests := []struct {
+		style contract.KeyStyle
+		key   string
+	}{
+		{contract.KeyStyleDot, "items.0.name"},
+		{contract.KeyStyleBracket, "items[0].name"},
+		{contract.KeyStylePointer, "/items/0/name"},
+	}
+
+	for _, tt := range tests {
+		e := NewEngine(WithKeyStyle(tt.style))
+		res := e.CloneWithResolver(e.MessageResolver.Clone()).Execute(data, rules)
+		if !res.HasFieldError(tt.key) {
+			t.Fatalf("expected error under %q, got %#v", tt.key, res.Errors())
+		}
+	}
+}
+
+func TestEngine_WithEscapedInput(t *testing.T) {
+	e := NewEngine(WithEscapedInput())
+	e.SetCustomMessage("email", "\":input\" is not an email")
+	res := e.Execute(NewDataProvider(map[string]any{"email": "<b>x</b>"}), map[string]string{"email": "email"})
+	if msg := res.FieldError("email"); msg != "\"&lt;b&gt;x&lt;/b&gt;\" is not an email" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+}
+
+func TestEngine_WithICUMessages(t *testing.T) {
+	e := NewEngine(WithICUMessages("en", map[string]string{
+		"max": "{attribute} may have at most {0, plural, one {# tag} other {# tags}}",
+	}))
+	res := e.Execute(NewDataProvider(map[string]any{"tags": []any{"a", "b"}}), map[string]string{"tags": "max:1"})
+	if msg := res.FieldError("tags"); msg != "tags may have at most 1 tag" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+}
+
+func TestEngine_WithLocale(t *testing.T) {
+	e := NewEngine(
+		WithLocaleCatalog("de", map[string]string{"required": "Das Feld :attribute ist erforderlich"}),
+		WithLocale("de-CH", "en"),
+	)
+	res := e.Execute(NewDataProvider(map[string]any{"age": "x"}), map[string]string{"name": "required", "age": "integer"})
+	if msg := res.FieldError("name"); msg != "Das Feld name ist erforderlich" {
+		t.Fatalf("expected the de catalog for de-CH, got %q", msg)
+	}
+	if msg := res.FieldError("age"); msg == "" || msg == "integer" {
+		t.Fatalf("expected the built-in message for an untranslated rule, got %q", msg)
+	}
+}
+
+func TestEngine_ErrorsByRule(t *testing.T) {
+	e := NewEngine()
+	input := map[string]any{"email": "nope", "backup": "also nope", "role": "root"}
+	rules := map[string]string{"email": "email", "backup": "email", "role": "!in:root,admin", "name": "required"}
+
+	byRule := contract.ByRuleOf(e.Execute(NewDataProvider(input), rules))
+	if len(byRule["email"]) != 2 || len(byRule["!in"]["role"]) != 1 || len(byRule["required"]["name"]) != 1 {
+		t.Fatalf("unexpected errors by rule: %#v", byRule)
+	}
+}
+
+func TestEngine_FailedRules(t *testing.T) {
+	e := NewEngine(WithKeyStyle(contract.KeyStyleBracket))
+	input := map[string]any{"items": []any{map[string]any{"qty": 0}}}
+	res := e.Execute(NewDataProvider(input), map[string]string{"items.0.qty": "required|between:1,10"})
+
+	failed := contract.FailedOf(res)
+	if got := failed["items[0].qty"]["between"]; len(got) != 2 || got[0] != "1" || got[1] != "10" {
+		t.Fatalf("unexpected failed rules: %#v", failed)
+	}
+}
+
+func TestEngine_Warnings(t *testing.T) {
+	e := NewEngine()
+	input := map[string]any{"bio": "short", "email": "a@example.com"}
+	rules := map[string]string{"bio": "bail|warn:min:20|alpha", "email": "required|email|warn:!in:a@example.com"}
+
+	res := e.Execute(NewDataProvider(input), rules)
+	if !res.IsValid() {
+		t.Fatalf("warnings must not invalidate the result: %#v", res.Errors())
+	}
+	warnings := contract.WarningsOf(res)
+	if len(warnings["bio"]) != 1 || len(warnings["email"]) != 1 {
+		t.Fatalf("unexpected warnings: %#v", warnings)
+	}
+	if len(contract.FailedOf(res)) != 0 || len(contract.ByRuleOf(res)) != 0 {
+		t.Fatalf("warnings must not be reported as failed rules: %#v", contract.FailedOf(res))
+	}
+
+	res = e.Execute(NewDataProvider(map[string]any{"bio": "x1"}), map[string]string{"bio": rules["bio"]})
+	if !res.HasFieldError("bio") || len(contract.WarningsOf(res)["bio"]) != 1 {
+		t.Fatalf("bail must not stop on a warning: %#v %#v", res.Errors(), contract.WarningsOf(res))
+	}
+}
+
+func TestEngine_ConvertEmptyStringsToNull(t *testing.T) {
+	e := NewEngine(WithPreprocessors(ConvertEmptyStringsToNull()))
+	input := map[string]any{
+		"nickname": "",
+		"website":  "",
+		"profile":  map[string]any{"bio": ""},
+	}
+	rules := map[string]string{
+		"nickname":    "nullable|min:3",
+		"website":     "required|nullable|url",
+		"profile.bio": "nullable|string",
+	}
+
+	res := e.Execute(NewDataProvider(input), rules)
+	if res.HasFieldError("nickname") || res.HasFieldError("profile.bio") {
+		t.Fatalf("nullable fields with blank input must pass, got %#v", res.Errors())
+	}
+	if !res.HasFieldError("website") || len(res.Errors()["website"]) != 1 {
+		t.Fatalf("required must still run for nil values, got %#v", res.Errors())
+	}
+	if contract.ValidatedOf(res)["nickname"] != nil || input["nickname"] != "" {
+		t.Fatalf("expected converted validated value and untouched input")
+	}
+}
+
+func TestEngine_TrimStrings(t *testing.T) {
+	e := NewEngine(WithDataOptions(TrimStrings("password")), WithPreprocessors(ConvertEmptyStringsToNull()))
+	input := map[string]any{
+		"name":     "  Ann  ",
+		"password": " secret ",
+		"note":     "   ",
+		"tags":     []any{" a ", "b "},
+	}
+	rules := map[string]string{
+		"name":     "required|size:3",
+		"password": "required|size:8",
+		"note":     "nullable|min:2",
+		"tags.1":   "size:1",
+	}
+
+	res := e.Execute(NewDataProvider(input), rules)
+	if !res.IsValid() {
+		t.Fatalf("expected trimmed input to pass, got %#v", res.Errors())
+	}
+	validated := contract.ValidatedOf(res)
+	if validated["name"] != "Ann" || validated["password"] != " secret " || validated["note"] != nil {
+		t.Fatalf("unexpected validated data: %#v", validated)
+	}
+	if input["name"] != "  Ann  " {
+		t.Fatalf("expected the input to be left untouched, got %q", input["name"])
+	}
+
+	provider := NewDataProvider(map[string]any{"user": map[string]any{"name": " Bo ", "pin": " 1 "}},
+		TrimStrings("user.pin"))
+	if name, _ := provider.Get("user.name"); name != "Bo" {
+		t.Fatalf("expected the provider to trim nested strings, got %q", name)
+	}
+	if pin, _ := provider.Get("user.pin"); pin != " 1 " {
+		t.Fatalf("expected excluded fields to keep their whitespace, got %q", pin)
+	}
+}
+
+func TestEngine_ArrayIndexTargeting(t *testing.T) {
+	data := NewDataProvider(map[string]any{
+		"items": []any{
+			map[string]any{"sku": "AB"},
+			map[string]any{"sku": "CD"},
+			map[string]any{},
+		},
+	})
+	rules := map[string]string{
+		"items.*.sku":   "required",
+		"items.0.sku":   "min:3",
+		"items.1-4.sku": "max:2",
+	}
+
+	res := NewEngine().Execute(data, rules)
+	errs := res.Errors()
+	if len(errs["items.0.sku"]) != 1 || len(errs["items.2.sku"]) != 1 || res.HasFieldError("items.1.sku") {
+		t.Fatalf("unexpected errors: %#v", errs)
+	}
+}
+
+func TestEngine_PresenceRulesOnNestedFields(t *testing.T) {
+	data := NewDataProvider(map[string]any{
+		"items":   []any{map[string]any{"sku": "AB"}, map[string]any{"sku": "CD"}},
+		"address": map[string]any{"city": "Oslo", "zip": ""},
+		"billing": map[string]any{"city": "Oslo"},
+		"plan":    map[string]any{"type": "free"},
+	})
+	valid := NewEngine().Execute(data, map[string]string{
+		"items.*.sku":  "present|filled",
+		"billing.city": "same:address.city",
+		"address.city": "different:plan.type",
+		"address.role": "prohibited",
+		"address.vat":  "prohibited_if:plan.type,free",
+	})
+	if !valid.IsValid() {
+		t.Fatalf("expected nested lookups to pass, got %#v", valid.Errors())
+	}
+
+	invalid := NewEngine().Execute(data, map[string]string{
+		"items.*.sku":  "prohibited",
+		"address.zip":  "filled",
+		"address.city": "prohibited_if:plan.type,free|same:plan.type",
+	})
+	for _, field := range []string{"items.0.sku", "items.1.sku", "address.zip", "address.city"} {
+		if !invalid.HasFieldError(field) {
+			t.Fatalf("expected an error for %s, got %#v", field, invalid.Errors())
+		}
+	}
+	if got := len(invalid.Errors()["address.city"]); got != 2 {
+		t.Fatalf("expected prohibited_if and same to fail, got %#v", invalid.Errors())
+	}
+}
+
+func TestEngine_NegatedRules(t *testing.T) {
+	e := NewEngine()
+	rules := map[string]string{
+		"role":     "required|!in:admin,root",
+		"username": "!regex:^admin",
+		"nickname": "!email",
+	}
+
+	valid := e.Execute(NewDataProvider(map[string]any{
+		"role": "editor", "username": "jane", "nickname": "jj",
+	}), rules)
+	if !valid.IsValid() {
+		t.Fatalf("expected valid input, got %#v", valid.Errors())
+	}
+
+	e.SetCustomMessage("!regex", "The :attribute must not start with admin")
+	invalid := e.Execute(NewDataProvider(map[string]any{
+		"role": "root", "username": "admin1", "nickname": "a@b.co",
+	}), rules)
+	if msg := invalid.FieldError("role"); msg != "The selected role is invalid" {
+		t.Fatalf("unexpected role message: %q", msg)
+	}
+	if msg := invalid.FieldError("username"); msg != "The username must not start with admin" {
+		t.Fatalf("unexpected username message: %q", msg)
+	}
+	if msg := invalid.FieldError("nickname"); msg != "The nickname must not satisfy the email rule" {
+		t.Fatalf("unexpected nickname message: %q", msg)
+	}
+}
+
+func TestEngine_DatabaseAndTimeValues(t *testing.T) {
+	data := NewDataProvider(map[string]any{
+		"nickname":   sql.NullString{},
+		"name":       sql.NullString{String: "Ann", Valid: true},
+		"age":        sql.NullInt64{Int64: 17, Valid: true},
+		"created_at": time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+	})
+	rules := map[string]string{
+		"nickname":   "nullable|min:3",
+		"name":       "required|min:2",
+		"age":        "required|numeric|min:18",
+		"created_at": "required|after:2024-01-01T00:00:00Z",
+	}
+
+	res := NewEngine().Execute(data, rules)
+	errs := res.Errors()
+	if len(errs) != 1 || len(errs["age"]) != 1 {
+		t.Fatalf("expected only the min rule on age to fail, got %#v", errs)
+	}
+
+	input := map[string]any{
+		"email":   "ann@example.com",
+		"confirm": sql.NullString{String: "ann@example.com", Valid: true},
+		"profile": map[string]any{"city": sql.NullString{String: "Oslo", Valid: true}},
+	}
+	rules = map[string]string{"email": "same:confirm", "profile.city": "required|alpha"}
+	res = NewEngine().Execute(contract.NewSimpleDataProvider(input), rules)
+	if !res.IsValid() {
+		t.Fatalf("expected other fields to be compared unwrapped, got %#v", res.Errors())
+	}
+	if city, _ := contract.ValidatedOf(res)["profile"].(map[string]any); city["city"] != "Oslo" {
+		t.Fatalf("expected unwrapped validated values, got %#v", contract.ValidatedOf(res))
+	}
+	if _, wrapped := input["profile"].(map[string]any)["city"].(sql.NullString); !wrapped {
+		t.Fatal("expected the input to be left untouched")
+	}
+}
+
+// virtualProvider serves a computed field that is not part of its All() data
+type virtualProvider struct {
+	contract.DataProvider
+	reads int
+}
+
+func (p *virtualProvider) Get(field string) (any, bool) {
+	p.reads++
+	if field == "full_name" {
+		return sql.NullString{String: "Ann Lee", Valid: true}, true
+	}
+	return p.DataProvider.Get(field)
+}
+
+func TestEngine_CustomDataProvider(t *testing.T) {
+	provider := &virtualProvider{DataProvider: contract.NewSimpleDataProvider(map[string]any{
+		"code": sql.NullString{},
+	})}
+	rules := map[string]string{"full_name": "required|min:3", "code": "nullable|alpha"}
+
+	res := NewEngine().Execute(provider, rules)
+	if !res.IsValid() {
+		t.Fatalf("expected the virtual field and the unwrapped null to pass, got %#v", res.Errors())
+	}
+	if provider.reads == 0 {
+		t.Fatal("expected the custom provider's Get to be used")
+	}
+	if contract.ValidatedOf(res)["full_name"] != "Ann Lee" {
+		t.Fatalf("expected the unwrapped virtual value, got %#v", contract.ValidatedOf(res))
+	}
+	if plan := NewEngine().Plan(provider, rules); len(plan) != 2 || len(plan[1].Rules) != 2 {
+		t.Fatalf("expected the virtual field to be planned, got %#v", plan)
+	}
+}
+
+func TestEngine_PlanPreparesDataLikeExecute(t *testing.T) {
+	tenant.RegisterTenant("engine_test.plan", contract.Config{
+		CustomRules: map[string]contract.RuleCreator{
+			"vat_id": func([]string) (contract.Rule, error) { return &alwaysFailRule{}, nil },
+		},
+	})
+	e := NewEngine(WithNilPointers(NilPointerEmpty), WithTenant("engine_test.plan"))
+	data := NewDataProvider(map[string]any{"nick": (*string)(nil), "vat": "DE1"})
+
+	plan := e.Plan(data, map[string]string{"nick": "nullable|min:3", "vat": "vat_id"})
+	if len(plan) != 2 || len(plan[0].Rules) != 0 {
+		t.Fatalf("expected the nil pointer to be planned as nil, got %#v", plan)
+	}
+	if !plan[1].Rules[0].Registered {
+		t.Fatalf("expected the tenant rule to be registered in the plan, got %#v", plan[1])
+	}
+}
+
+func TestEngine_NormalizedValues(t *testing.T) {
+	data := NewDataProvider(map[string]any{
+		"price":    map[string]any{"amount": "1.234,5", "currency": "EUR"},
+		"discount": "12,345",
+	})
+	rules := map[string]string{
+		"price.amount": "required|money:price.currency,de|gte:1000",
+		"discount":     "money:EUR,de",
+	}
+
+	res := NewEngine().Execute(data, rules)
+	if !res.HasFieldError("discount") || len(res.Errors()) != 1 {
+		t.Fatalf("expected only discount to fail, got %#v", res.Errors())
+	}
+	price, _ := contract.ValidatedOf(res)["price"].(map[string]any)
+	if price["amount"] != "1234.50" {
+		t.Fatalf("expected normalized amount, got %#v", contract.ValidatedOf(res))
+	}
+}
+
+// slowRule is an async rule tracking how many instances run at once
+type slowRule struct {
+	fail             bool
+	inFlight, maxRun *int32
+	mu               *sync.Mutex
+}
+
+func (r *slowRule) Name() string { return "slow" }
+func (r *slowRule) Async() bool  { return true }
+func (r *slowRule) Validate(_ contract.RuleContext) error {
+	r.mu.Lock()
+	*r.inFlight++
+	if *r.inFlight > *r.maxRun {
+		*r.maxRun = *r.inFlight
+	}
+	r.mu.Unlock()
+
+	time.Sleep(30 * time.Millisecond)
+
+	r.mu.Lock()
+	*r.inFlight--
+	r.mu.Unlock()
+	if r.fail {
+		return errors.New("slow failed")
+	}
+	return nil
+}
+
+func TestEngine_AsyncRulesRunConcurrently(t *testing.T) {
+	var inFlight, maxRun int32
+	mu := &sync.Mutex{}
+	e := NewEngine(WithConcurrency(2))
+	creator := func(fail bool) contract.RuleCreator {
+		return func(_ []string) (contract.Rule, error) {
+			return &slowRule{fail: fail, inFlight: &inFlight, maxRun: &maxRun, mu: mu}, nil
+		}
+	}
+	_ = e.RegisterRule("slow_ok", creator(false))
+	_ = e.RegisterRule("slow_fail", creator(true))
+
+	data := NewDataProvider(map[string]any{"a": "x", "b": "x", "c": "x", "d": "x"})
+	rules := map[string]string{
+		"a": "slow_ok",
+		"b": "slow_ok",
+		"c": "slow_fail|min:5",
+		"d": "!slow_ok",
+	}
+
+	res := e.Execute(data, rules)
+	errs := res.Errors()
+	if len(errs) != 2 || len(errs["c"]) != 2 || len(errs["d"]) != 1 {
+		t.Fatalf("unexpected errors: %#v", errs)
+	}
+	if errs["c"][1] != "The c field is invalid" {
+		t.Fatalf("expected async error after the synchronous one, got %#v", errs["c"])
+	}
+	if maxRun != 2 {
+		t.Fatalf("expected async rules to overlap up to the limit of 2, got %d", maxRun)
+	}
+}
+
+// goroutineRule is an async rule recording the most goroutines seen while it runs
+type goroutineRule struct {
+	mu  *sync.Mutex
+	max *int
+}
+
+func (r *goroutineRule) Name() string { return "goroutines" }
+func (r *goroutineRule) Async() bool  { return true }
+func (r *goroutineRule) Validate(_ contract.RuleContext) error {
+	time.Sleep(time.Millisecond)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	*r.max = max(*r.max, runtime.NumGoroutine())
+	return nil
+}
+
+func TestEngine_AsyncRulesBoundGoroutines(t *testing.T) {
+	var most int
+	rule := &goroutineRule{mu: &sync.Mutex{}, max: &most}
+	e := NewEngine(WithConcurrency(2))
+	_ = e.RegisterRule("goroutines", func(_ []string) (contract.Rule, error) { return rule, nil })
+
+	items := make([]any, 200)
+	for i := range items {
+		items[i] = "x"
+	}
+	before := runtime.NumGoroutine()
+	e.Execute(NewDataProvider(map[string]any{"items": items}), map[string]string{"items.*": "goroutines"})
+	if most > before+2 {
+		t.Fatalf("expected at most 2 async goroutines, saw %d over %d", most-before, before)
+	}
+}
+
+func TestEngine_AsyncRulesRespectBail(t *testing.T) {
+	e := NewEngine()
+	_ = e.RegisterRule("slow_fail", func(_ []string) (contract.Rule, error) {
+		var inFlight, maxRun int32
+		return &slowRule{fail: true, inFlight: &inFlight, maxRun: &maxRun, mu: &sync.Mutex{}}, nil
+	})
+
+	res := e.Execute(NewDataProvider(map[string]any{"a": "x"}), map[string]string{"a": "bail|slow_fail|min:5"})
+	if len(res.Errors()["a"]) != 1 {
+		t.Fatalf("expected bail to stop after the async failure, got %#v", res.Errors())
+	}
+}
+
+// countingRule is a memoized async rule counting its evaluations
+type countingRule struct {
+	calls *int32
+	mu    *sync.Mutex
+}
+
+func (r *countingRule) Name() string { return "taken" }
+func (r *countingRule) Async() bool  { return true }
+func (r *countingRule) MemoKey(ctx contract.RuleContext) (string, bool) {
+	value, ok := ctx.Value().(string)
+	return value, ok
+}
+func (r *countingRule) Validate(ctx contract.RuleContext) error {
+	r.mu.Lock()
+	*r.calls++
+	r.mu.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	if ctx.Value() == "a@b.co" {
+		return errors.New("taken")
+	}
+	return nil
+}
+
+func TestEngine_MemoizesRuleResultsPerRun(t *testing.T) {
+	var calls int32
+	mu := &sync.Mutex{}
+	e := NewEngine()
+	_ = e.RegisterRule("taken", func(_ []string) (contract.Rule, error) {
+		return &countingRule{calls: &calls, mu: mu}, nil
+	})
+
+	data := NewDataProvider(map[string]any{
+		"emails": []any{"a@b.co", "c@d.co", "a@b.co", "a@b.co"},
+		"backup": "c@d.co",
+	})
+	rules := map[string]string{"emails.*": "taken", "backup": "bail|taken"}
+
+	res := e.Execute(data, rules)
+	if calls != 2 {
+		t.Fatalf("expected one evaluation per distinct value, got %d", calls)
+	}
+	errs := res.Errors()
+	if len(errs) != 3 || len(errs["emails.0"]) != 1 || len(errs["emails.2"]) != 1 || len(errs["emails.3"]) != 1 {
+		t.Fatalf("expected every duplicate to report the cached failure, got %#v", errs)
+	}
+
+	e.Execute(data, rules)
+	if calls != 4 {
+		t.Fatalf("expected results not to be shared across runs, got %d evaluations", calls)
+	}
+}
+
+// emailAddress is a value object exposing its text form through fmt.Stringer
+type emailAddress struct{ local, domain string }
+
+func (a emailAddress) String() string { return a.local + "@" + a.domain }
+
+// level exposes its text form through encoding.TextMarshaler
+type level int
+
+func (l level) MarshalText() ([]byte, error) { return []byte([]string{"low", "HIGH"}[l]), nil }
+
+func TestEngine_StringCoercion(t *testing.T) {
+	data := NewDataProvider(map[string]any{"email": emailAddress{"a", "b.co"}, "level": level(1)})
+	rules := map[string]string{"email": "email", "level": "alpha|lowercase"}
+
+	strict := NewEngine().Execute(data, rules).Errors()
+	if len(strict["email"]) != 1 || len(strict["level"]) != 2 {
+		t.Fatalf("expected non-string values to be rejected by default, got %#v", strict)
+	}
+
+	coerced := NewEngine(WithStringCoercion()).Execute(data, rules).Errors()
+	if len(coerced) != 1 || len(coerced["level"]) != 1 {
+		t.Fatalf("expected text forms to be validated, got %#v", coerced)
+	}
+}
+
+func TestEngine_PartialValidation(t *testing.T) {
+	rules := map[string]string{
+		"name":  "required|min:3",
+		"email": "required|email",
+		"age":   "required_with:name|integer",
+	}
+	data := NewDataProvider(map[string]any{"email": "nope"})
+
+	full := NewEngine().Execute(data, rules).Errors()
+	if _, ok := full["name"]; !ok {
+		t.Fatalf("expected full validation to require name, got %#v", full)
+	}
+
+	partial := NewEngine(WithPartial()).Execute(data, rules).Errors()
+	if len(partial) != 1 || len(partial["email"]) != 1 {
+		t.Fatalf("expected only the provided email to be validated, got %#v", partial)
+	}
+
+	provided := NewDataProvider(map[string]any{"email": "", "name": "Jo"})
+	partial = NewEngine(WithPartial()).Execute(provided, rules).Errors()
+	if len(partial["email"]) != 2 || len(partial["name"]) != 1 {
+		t.Fatalf("expected provided fields to keep their required rules, got %#v", partial)
+	}
+}
+
+func TestEngine_Scoring(t *testing.T) {
+	rules := map[string]string{
+		"name":  "required|min:3",
+		"email": "required|email",
+		"bio":   "nullable|min:10",
+	}
+	data := NewDataProvider(map[string]any{"name": "Al", "email": "al@example.com", "bio": nil})
+
+	if score := contract.ScoreOf(NewEngine().Execute(data, rules)); score != 100 {
+		t.Fatalf("expected a score of 100 without scoring, got %v", score)
+	}
+
+	e := NewEngine(WithScoring(map[string]float64{"required": 3, "min.name": 2}))
+	res := e.Execute(data, rules)
+	// name: required 3 + min 2, email: required 3 + email 1; bio is skipped and nullable weighs nothing
+	if score := contract.ScoreOf(res); math.Abs(score-700.0/9) > 1e-9 {
+		t.Fatalf("expected a score of 77.78, got %v", score)
+	}
+	deductions := contract.DeductionsOf(res)
+	if len(deductions) != 1 || math.Abs(deductions["name"]-200.0/9) > 1e-9 {
+		t.Fatalf("unexpected deductions: %#v", deductions)
+	}
+	if score := contract.ScoreOf(contract.ExceptOf(res, "name")); score != 100 {
+		t.Fatalf("expected the view without name to keep all points, got %v", score)
+	}
+}
+
+// cappedResult keeps at most limit errors
+type cappedResult struct {
+	*contract.ValidationErrors
+	limit, count int
+}
+
+func (c *cappedResult) AddRuleError(field string, rule contract.ParsedRule, message string) {
+	if c.count < c.limit {
+		c.count++
+		c.ValidationErrors.AddRuleError(field, rule, message)
+	}
+}
+
+func TestEngine_ResultFactory(t *testing.T) {
+	factory := func() contract.ResultAccumulator {
+		return &cappedResult{ValidationErrors: contract.NewValidationErrors(), limit: 1}
+	}
+	e := NewEngine(WithResultFactory(factory))
+	rules := map[string]string{"name": "required|alpha", "email": "required|email"}
+	res := e.Execute(NewDataProvider(map[string]any{}), rules)
+
+	if _, ok := res.(*cappedResult); !ok {
+		t.Fatalf("expected the factory's result, got %T", res)
+	}
+	if res.IsValid() || len(res.Errors()) != 1 {
+		t.Fatalf("expected a single capped error, got %#v", res.Errors())
+	}
+}
+
+func TestEngine_RuleMiddleware(t *testing.T) {
+	var calls []string
+	trace := func(next RuleFunc) RuleFunc {
+		return func(name string, rule contract.Rule, ctx contract.RuleContext) error {
+			calls = append(calls, "before:"+name)
+			err := next(name, rule, ctx)
+			calls = append(calls, "after:"+name)
+			return err
+		}
+	}
+	skipEmail := func(next RuleFunc) RuleFunc {
+		return func(name string, rule contract.Rule, ctx contract.RuleContext) error {
+			if name == "email" {
+				return nil
+			}
+			return next(name, rule, ctx)
+		}
+	}
+
+	e := NewEngine(WithRuleMiddleware(trace))
+	e.UseRuleMiddleware(skipEmail)
+	res := e.Execute(NewDataProvider(map[string]any{"email": "nope"}), map[string]string{"email": "required|email"})
+
+	if !res.IsValid() {
+		t.Fatalf("expected the middleware to short-circuit email, got %#v", res.Errors())
+	}
+	want := []string{"before:required", "after:required", "before:email", "after:email"}
+	if len(calls) != len(want) {
+		t.Fatalf("expected %v, got %v", want, calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, calls)
+		}
+	}
+}
+
+type externalRule struct{ alwaysFailRule }
+
+func (r *externalRule) Name() string     { return "remote_check" }
+func (r *externalRule) Resource() string { return "remote" }
+
+func TestEngine_RateLimitSkipsExternalRules(t *testing.T) {
+	e := NewEngine(WithRateLimit("remote", NewTokenBucket(0, 1), RateLimitFailFast))
+	_ = e.RegisterRule("remote_check", func(_ []string) (contract.Rule, error) { return &externalRule{}, nil })
+
+	data := NewDataProvider(map[string]any{"a": "x", "b": "y"})
+	res := e.Execute(data, map[string]string{"a": "remote_check", "b": "remote_check|alpha"})
+
+	skipped := contract.SkippedOf(res)
+	if len(skipped) != 1 || len(res.Errors()) != 1 {
+		t.Fatalf("expected one evaluated and one skipped rule, got errors %#v, skipped %#v", res.Errors(), skipped)
+	}
+	for field, rules := range skipped {
+		if len(rules) != 1 || rules[0] != "remote_check" || res.HasFieldError(field) {
+			t.Fatalf("unexpected skipped rules for %s: %v, %#v", field, rules, res.Errors())
+		}
+	}
+}
+
+// memoizedExternalRule is an external rule keyed by its value
+type memoizedExternalRule struct{ externalRule }
+
+func (r *memoizedExternalRule) MemoKey(ctx contract.RuleContext) (string, bool) {
+	value, ok := ctx.Value().(string)
+	return value, ok
+}
+
+// countingLimiter admits every evaluation and counts the tokens taken
+type countingLimiter struct{ tokens int }
+
+func (l *countingLimiter) Allow() bool { l.tokens++; return true }
+func (l *countingLimiter) Wait(_ context.Context) error {
+	l.tokens++
+	return nil
+}
+
+func TestEngine_MemoHitsSkipRateLimit(t *testing.T) {
+	limiter := &countingLimiter{}
+	e := NewEngine(WithRateLimit("remote", limiter, RateLimitWait))
+	_ = e.RegisterRule("remote_check", func(_ []string) (contract.Rule, error) {
+		return &memoizedExternalRule{}, nil
+	})
+
+	data := NewDataProvider(map[string]any{"a": "x", "b": "x", "c": "y"})
+	res := e.Execute(data, map[string]string{"a": "remote_check", "b": "remote_check", "c": "remote_check"})
+	if limiter.tokens != 2 {
+		t.Fatalf("expected one token per distinct value, got %d", limiter.tokens)
+	}
+	if len(res.Errors()) != 3 {
+		t.Fatalf("expected the cached failure to be reported for every field, got %#v", res.Errors())
+	}
+}
+
+func TestEngine_RateLimitWaitHonorsContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	e := NewEngine(WithRateLimit("", NewTokenBucket(1, 0), RateLimitWait))
+	e.Context = ctx
+	_ = e.RegisterRule("remote_check", func(_ []string) (contract.Rule, error) { return &externalRule{}, nil })
+
+	res := e.Execute(NewDataProvider(map[string]any{"a": "x"}), map[string]string{"a": "required|remote_check"})
+	if !res.IsValid() || len(contract.SkippedOf(res)["a"]) != 1 {
+		t.Fatalf("expected the queued rule to be skipped once the context is done, got %#v", contract.SkippedOf(res))
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := NewTokenBucket(1000, 2)
+	if !bucket.Allow() || !bucket.Allow() {
+		t.Fatal("expected the burst to be admitted")
+	}
+	if bucket.Allow() {
+		t.Fatal("expected the empty bucket to refuse")
+	}
+	if err := bucket.Wait(context.Background()); err != nil {
+		t.Fatalf("expected Wait to get a refilled token, got %v", err)
+	}
+}
+
+type downRule struct{ calls *int }
+
+func (r *downRule) Name() string     { return "remote_check" }
+func (r *downRule) Resource() string { return contract.ResourceDatabase }
+func (r *downRule) Validate(_ contract.RuleContext) error {
+	*r.calls++
+	return &contract.ExternalError{Resource: contract.ResourceDatabase, Err: errors.New("connection refused")}
+}
+
+func TestEngine_CircuitBreakerPolicies(t *testing.T) {
+	data := NewDataProvider(map[string]any{"email": "a@b.co"})
+	rules := map[string]string{"email": "remote_check"}
+
+	for _, tc := range []struct {
+		name      string
+		policy    BreakerPolicy
+		valid     bool
+		warnings  int
+		errorsLen int
+	}{
+		{"fail closed", BreakerFailClosed, false, 0, 1},
+		{"fail open", BreakerFailOpen, true, 0, 0},
+		{"warn", BreakerWarn, true, 1, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			breaker := NewCircuitBreaker(1, time.Hour)
+			e := NewEngine(WithCircuitBreaker(contract.ResourceDatabase, breaker, tc.policy))
+			_ = e.RegisterRule("remote_check", func(_ []string) (contract.Rule, error) {
+				return &downRule{calls: &calls}, nil
+			})
+
+			if e.Execute(data, rules).IsValid() {
+				t.Fatal("expected the unreachable database to fail the rule while closed")
+			}
+			if breaker.State() != BreakerOpen {
+				t.Fatalf("expected the breaker to open, got %v", breaker.State())
+			}
+
+			res := e.Execute(data, rules)
+			if calls != 1 {
+				t.Fatalf("expected the open breaker to short-circuit the rule, got %d calls", calls)
+			}
+			if res.IsValid() != tc.valid || len(contract.WarningsOf(res)["email"]) != tc.warnings ||
+				len(res.Errors()["email"]) != tc.errorsLen {
+				t.Fatalf("unexpected result: errors %#v, warnings %#v", res.Errors(), contract.WarningsOf(res))
+			}
+		})
+	}
+}
+
+func TestCircuitBreaker_HalfOpenTrial(t *testing.T) {
+	breaker := NewCircuitBreaker(2, 0)
+	down := &contract.ExternalError{Resource: "remote", Err: errors.New("timeout")}
+
+	breaker.Record(down)
+	if breaker.State() != BreakerClosed {
+		t.Fatal("expected the breaker to stay closed below the threshold")
+	}
+	breaker.Record(down)
+	if breaker.State() != BreakerHalfOpen {
+		t.Fatalf("expected the elapsed cooldown to half-open the breaker, got %v", breaker.State())
+	}
+	if !breaker.Allow() || breaker.Allow() {
+		t.Fatal("expected a single trial while half-open")
+	}
+	breaker.Record(errors.New("the value is taken"))
+	if breaker.State() != BreakerClosed || !breaker.Allow() {
+		t.Fatal("expected a successful trial to close the breaker")
+	}
+}
+
+func TestEngine_DisabledRules(t *testing.T) {
+	defer registry.Enable("alpha")
+	e := NewEngine()
+	data := NewDataProvider(map[string]any{"name": "x1"})
+
+	registry.Disable("alpha")
+	if res := e.Execute(data, map[string]string{"name": "alpha"}); !res.IsValid() {
+		t.Fatalf("expected a disabled rule to pass by default, got %#v", res.Errors())
+	}
+
+	registry.Disable("alpha", registry.DisabledFail)
+	ok := NewDataProvider(map[string]any{"name": "abc"})
+	res := e.Execute(ok, map[string]string{"name": "alpha"})
+	if res.FieldError("name") != "The name may only contain letters" {
+		t.Fatalf("expected the disabled rule to fail with its message, got %#v", res.Errors())
+	}
+	if res := e.Execute(ok, map[string]string{"name": "!alpha"}); res.IsValid() {
+		t.Fatal("expected a negated disabled rule to fail as well")
+	}
+
+	registry.Disable("alpha", registry.DisabledWarn)
+	res = e.Execute(ok, map[string]string{"name": "alpha"})
+	if !res.IsValid() || len(contract.WarningsOf(res)["name"]) != 1 {
+		t.Fatalf("expected a warning, got errors %#v, warnings %#v", res.Errors(), contract.WarningsOf(res))
+	}
+
+	registry.Enable("alpha")
+	if res := e.Execute(data, map[string]string{"name": "alpha"}); res.IsValid() {
+		t.Fatal("expected the enabled rule to be evaluated again")
+	}
+}
+
+func TestEngine_DeprecatedRules(t *testing.T) {
+	var reported []string
+	registry.SetDeprecationHandler(func(rule, replacement string) {
+		reported = append(reported, rule+"->"+replacement)
+	})
+	defer registry.SetDeprecationHandler(nil)
+	registry.Deprecate("letters_only", "alpha")
+
+	e := NewEngine()
+	rules := map[string]string{"name": "letters_only"}
+	for i := 0; i < 2; i++ {
+		res := e.Execute(NewDataProvider(map[string]any{"name": "x1"}), rules)
+		if res.FieldError("name") != "The name may only contain letters" {
+			t.Fatalf("expected the replacement rule to run, got %#v", res.Errors())
+		}
+	}
+	if len(reported) != 1 || reported[0] != "letters_only->alpha" {
+		t.Fatalf("expected one deprecation report, got %v", reported)
+	}
+
+	plan := e.Explain(rules)
+	step := plan[0].Rules[0]
+	if step.Name != "letters_only" || step.Replacement != "alpha" || !step.Registered {
+		t.Fatalf("unexpected plan %#v", step)
+	}
+}
+
+func TestEngine_RejectUnknownFields(t *testing.T) {
+	e := NewEngine(WithRejectUnknownFields("meta.*"))
+	data := NewDataProvider(map[string]any{
+		"name":  "abc",
+		"admin": true,
+		"items": []any{map[string]any{"sku": "a", "price": 1}},
+		"meta":  map[string]any{"source": "web"},
+	})
+	rules := map[string]string{"name": "alpha", "items.*.sku": "required"}
+
+	res := e.Execute(data, rules)
+	if res.IsValid() {
+		t.Fatal("expected unknown fields to fail validation")
+	}
+	errs := res.Errors()
+	if len(errs) != 2 || errs["admin"] == nil || errs["items.0.price"] == nil {
+		t.Fatalf("expected admin and items.0.price to be rejected, got %#v", errs)
+	}
+	if res.FieldError("admin") != "The admin field is not allowed" {
+		t.Fatalf("unexpected message %q", res.FieldError("admin"))
+	}
+
+	if res := NewEngine().Execute(data, rules); !res.IsValid() {
+		t.Fatalf("expected unknown fields to be ignored by default, got %#v", res.Errors())
+	}
+}
+
+func TestEngine_RejectUnknownFields_EmptyContainers(t *testing.T) {
+	e := NewEngine(WithRejectUnknownFields("meta.*.source"))
+	data := NewDataProvider(map[string]any{"items": []any{}, "meta": []any{}, "tags": []any{}})
+	rules := map[string]string{"items.*.sku": "required", "tags.0-2": "string"}
+
+	if res := e.Execute(data, rules); !res.IsValid() {
+		t.Fatalf("expected empty lists under rule and allowed patterns to be covered, got %#v", res.Errors())
+	}
+}
+
+func TestEngine_Projection(t *testing.T) {
+	var dropped []string
+	e := NewEngine(WithProjection(func(paths []string) { dropped = paths }))
+	data := NewDataProvider(map[string]any{
+		"name":    "abc",
+		"isAdmin": true,
+		"items":   []any{map[string]any{"sku": "a", "price": 1}},
+		"meta":    map[string]any{"source": "web"},
+	})
+
+	rules := map[string]string{"name": "alpha", "items": "required", "items.*.sku": "required", "meta": "required"}
+	res := e.Execute(data, rules)
+	if !res.IsValid() {
+		t.Fatalf("expected projection not to fail validation, got %#v", res.Errors())
+	}
+	want := map[string]any{
+		"name":  "abc",
+		"items": []any{map[string]any{"sku": "a"}},
+		"meta":  map[string]any{"source": "web"},
+	}
+	if !reflect.DeepEqual(contract.ValidatedOf(res), want) {
+		t.Fatalf("expected %#v, got %#v", want, contract.ValidatedOf(res))
+	}
+	if !reflect.DeepEqual(dropped, []string{"isAdmin", "items.0.price"}) {
+		t.Fatalf("unexpected dropped fields %v", dropped)
+	}
+}
+
+func TestEngine_DereferencePolicy(t *testing.T) {
+	age := 0
+	name := "abc"
+	namePtr := &name
+	var missing *string
+	data := map[string]any{"age": &age, "name": &namePtr, "nick": missing}
+	rules := map[string]string{"age": "integer", "name": "alpha", "nick": "nullable|alpha"}
+
+	res := NewEngine().Execute(NewDataProvider(data), rules)
+	if res.IsValid() {
+		t.Fatal("expected pointers to reach the rules as they are by default")
+	}
+
+	res = NewEngine(WithDereference(DerefAll), WithNilPointers(NilPointerEmpty)).Execute(NewDataProvider(data), rules)
+	if !res.IsValid() {
+		t.Fatalf("expected dereferenced values to pass, got %#v", res.Errors())
+	}
+	if contract.ValidatedOf(res)["age"] != 0 || contract.ValidatedOf(res)["name"] != "abc" {
+		t.Fatalf("expected dereferenced validated values, got %#v", contract.ValidatedOf(res))
+	}
+	if _, ok := data["age"].(*int); !ok {
+		t.Fatal("expected the input to be untouched")
+	}
+
+	res = NewEngine(WithDereference(1)).Execute(NewDataProvider(data), map[string]string{"name": "alpha"})
+	if res.IsValid() {
+		t.Fatal("expected a dereference depth of 1 to keep the inner pointer")
+	}
+
+	partial := NewEngine(WithPartial(), WithNilPointers(NilPointerMissing))
+	if res := partial.Execute(NewDataProvider(data), map[string]string{"nick": "required"}); !res.IsValid() {
+		t.Fatalf("expected a missing nil pointer to be skipped by partial validation, got %#v", res.Errors())
+	}
+	res = NewEngine(WithNilPointers(NilPointerEmpty)).Execute(NewDataProvider(data), map[string]string{"nick": "required"})
+	if !res.HasFieldError("nick") {
+		t.Fatal("expected an empty nil pointer to fail required")
+	}
+}
+
+func TestEngine_RequiredPreset(t *testing.T) {
+	data := NewDataProvider(map[string]any{"active": false, "count": 0})
+	rules := map[string]string{"active": "required", "count": "required"}
+
+	if res := NewEngine().Execute(data, rules); len(res.Errors()) != 2 {
+		t.Fatalf("expected false and 0 to fail required by default, got %#v", res.Errors())
+	}
+	if res := NewEngine(WithRequiredPreset(contract.RequiredGo)).Execute(data, rules); !res.IsValid() {
+		t.Fatalf("expected false and 0 to pass with the Go preset, got %#v", res.Errors())
+	}
+}
+
+func TestEngine_ErrorBudget(t *testing.T) {
+	items := make([]any, 1000)
+	for i := range items {
+		items[i] = "not a number"
+	}
+	data := NewDataProvider(map[string]any{"items": items, "name": "1"})
+
+	res := NewEngine(WithMaxTotalErrors(10)).Execute(data, map[string]string{"items.*": "integer"})
+	if count := countErrors(res); count != 10 || !contract.TruncatedOf(res) {
+		t.Fatalf("expected 10 errors and a truncated result, got %d (truncated %v)", count, contract.TruncatedOf(res))
+	}
+
+	res = NewEngine(WithMaxErrorsPerField(1)).Execute(data, map[string]string{"name": "alpha|min:3"})
+	if len(res.Errors()["name"]) != 1 || !contract.TruncatedOf(res) {
+		t.Fatalf("expected a single error for name and a truncated result, got %#v", res.Errors())
+	}
+
+	res = NewEngine(WithMaxTotalErrors(10), WithMaxErrorsPerField(2)).Execute(data, map[string]string{"name": "alpha"})
+	if res.IsValid() || contract.TruncatedOf(res) {
+		t.Fatalf("expected an error within budget to leave the result complete, got %#v", res.Errors())
+	}
+}
+
+func countErrors(res contract.Result) int {
+	count := 0
+	for _, messages := range res.Errors() {
+		count += len(messages)
+	}
+	return count
+}
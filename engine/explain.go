@@ -0,0 +1,115 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/parser"
+)
+
+// Explain returns the normalized rule plan for rulesMap without running any validation.
+// Fields are sorted by name; rules missing from the registry are reported as unregistered.
+func (e *Engine) Explain(rulesMap map[string]string) []contract.FieldPlan {
+	if e.Tenant != "" {
+		return e.tenantScoped(false).Explain(rulesMap)
+	}
+	fields := make([]string, 0, len(rulesMap))
+	for field := range rulesMap {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	plans := make([]contract.FieldPlan, 0, len(fields))
+	for _, field := range fields {
+		plans = append(plans, e.explainField(field, rulesMap[field]))
+	}
+	return plans
+}
+
+// explainField builds the plan for a single field
+func (e *Engine) explainField(field, ruleString string) contract.FieldPlan {
+	parsedRules := parser.ParseRules(ruleString)
+	plan := contract.FieldPlan{Field: field, Bail: e.shouldStopOnFailure(parsedRules)}
+
+	for _, parsedRule := range parsedRules {
+		if parsedRule.Name == BailRuleName {
+			continue
+		}
+		plan.Rules = append(plan.Rules, e.rulePlan(parsedRule))
+	}
+	return plan
+}
+
+// rulePlan describes a parsed rule, following deprecated names to their replacement
+func (e *Engine) rulePlan(parsedRule parser.ParsedRule) contract.RulePlan {
+	plan := contract.RulePlan{
+		Name:    parsedRule.Name,
+		Params:  parsedRule.Params,
+		Negated: parsedRule.Negated,
+		Warning: parsedRule.Warning,
+	}
+	name := e.migrateRuleName(parsedRule.Name, false)
+	if name != parsedRule.Name {
+		plan.Replacement = name
+	}
+	plan.Registered = e.Registry.Has(name)
+	return plan
+}
+
+// Plan returns the rules that would run for each field of data, sorted by field, without
+// running any validator. Wildcard and index range keys are expanded against data, nil values
+// of nullable fields drop their non-implicit rules, and contract.ConditionalRule rules only
+// appear when their condition holds, with the condition as Reason (e.g. "type=premium").
+func (e *Engine) Plan(data contract.DataProvider, rulesMap map[string]string) []contract.FieldPlan {
+	if e.Tenant != "" {
+		return e.tenantScoped(false).Plan(data, rulesMap)
+	}
+	data = e.prepareData(data)
+
+	expanded := e.expandRules(data, rulesMap)
+	fields := make([]string, 0, len(expanded))
+	for field := range expanded {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	plans := make([]contract.FieldPlan, 0, len(fields))
+	for _, field := range fields {
+		if e.skipsAbsent(field, data) {
+			continue
+		}
+		plans = append(plans, e.planField(field, expanded[field], data))
+	}
+	return plans
+}
+
+// planField builds the data-aware plan for a single field
+func (e *Engine) planField(field, ruleString string, data contract.DataProvider) contract.FieldPlan {
+	parsedRules := parser.ParseRules(ruleString)
+	plan := contract.FieldPlan{Field: field, Bail: e.shouldStopOnFailure(parsedRules)}
+
+	value, _ := data.Get(field)
+	skipNonImplicit := value == nil && hasRule(parsedRules, NullableRuleName)
+
+	for _, parsedRule := range parsedRules {
+		if parsedRule.Name == BailRuleName || (skipNonImplicit && !isImplicitRule(parsedRule.Name)) {
+			continue
+		}
+
+		step := e.rulePlan(parsedRule)
+		ruleCreator, registered := e.Registry.Get(e.migrateRuleName(parsedRule.Name, false))
+		if registered {
+			rule, err := ruleCreator(parsedRule.Params)
+			if conditional, ok := rule.(contract.ConditionalRule); ok && err == nil {
+				ctx := contract.NewValidationContext(field, value, parsedRule.Params, data.All())
+				applies, reason := conditional.Applies(ctx)
+				if !applies {
+					continue
+				}
+				step.Reason = reason
+			}
+		}
+		plan.Rules = append(plan.Rules, step)
+	}
+	return plan
+}
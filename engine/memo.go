@@ -0,0 +1,60 @@
+package engine
+
+import (
+	"sync"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// memoEntry is the outcome of one memoized evaluation; done closes once err is set
+type memoEntry struct {
+	done chan struct{}
+	err  error
+}
+
+// ruleMemo caches the outcomes of contract.MemoizedRule rules within one Execute.
+// Concurrent evaluations of the same key wait for the first one instead of repeating it.
+type ruleMemo struct {
+	mu      sync.Mutex
+	entries map[string]*memoEntry
+}
+
+// newRuleMemo creates an empty memo
+func newRuleMemo() *ruleMemo {
+	return &ruleMemo{entries: make(map[string]*memoEntry)}
+}
+
+// wrap runs next, reusing an earlier outcome for rules that report a memo key. It sits outside
+// the middleware chain, so a cache hit is not rate limited, timed or counted by a circuit breaker.
+func (m *ruleMemo) wrap(next RuleFunc) RuleFunc {
+	return func(ruleName string, rule contract.Rule, ctx contract.RuleContext) error {
+		memoized, ok := rule.(contract.MemoizedRule)
+		if !ok {
+			return next(ruleName, rule, ctx)
+		}
+		key, ok := memoized.MemoKey(ctx)
+		if !ok {
+			return next(ruleName, rule, ctx)
+		}
+		key = ruleName + "\x00" + key
+
+		m.mu.Lock()
+		if entry, exists := m.entries[key]; exists {
+			m.mu.Unlock()
+			<-entry.done
+			return entry.err
+		}
+		entry := &memoEntry{done: make(chan struct{})}
+		m.entries[key] = entry
+		m.mu.Unlock()
+
+		entry.err = next(ruleName, rule, ctx)
+		close(entry.done)
+		return entry.err
+	}
+}
+
+// evaluateRule is the innermost RuleFunc, calling the rule itself
+func evaluateRule(_ string, rule contract.Rule, ctx contract.RuleContext) error {
+	return rule.Validate(ctx)
+}
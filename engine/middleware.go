@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// RuleFunc evaluates one rule, registered as name, in ctx and returns its validation error
+type RuleFunc func(name string, rule contract.Rule, ctx contract.RuleContext) error
+
+// RuleMiddleware wraps the evaluation of every rule, like HTTP middleware: it may act before and
+// after calling next (timing, logging), or skip next to short-circuit the rule (feature flags).
+// Rule errors returned by a middleware are reported as the rule's failure.
+type RuleMiddleware func(next RuleFunc) RuleFunc
+
+// UseRuleMiddleware appends middleware around every rule evaluation, sync and async alike.
+// The first middleware registered is the outermost one.
+func (e *Engine) UseRuleMiddleware(middleware ...RuleMiddleware) {
+	e.Middleware = append(e.Middleware, middleware...)
+}
+
+// ruleChain wraps evaluate with the engine's middleware
+func (e *Engine) ruleChain(evaluate RuleFunc) RuleFunc {
+	for i := len(e.Middleware) - 1; i >= 0; i-- {
+		evaluate = e.Middleware[i](evaluate)
+	}
+	return evaluate
+}
+
+// MetricsMiddleware reports the duration and outcome of every rule evaluation to observer.
+// Failures are rule errors as returned by the rule, before negation is applied.
+func MetricsMiddleware(observer contract.RuleObserver) RuleMiddleware {
+	return func(next RuleFunc) RuleFunc {
+		return func(name string, rule contract.Rule, ctx contract.RuleContext) error {
+			start := time.Now()
+			err := next(name, rule, ctx)
+			observer.ObserveRule(name, err != nil, time.Since(start))
+			return err
+		}
+	}
+}
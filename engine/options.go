@@ -0,0 +1,248 @@
+package engine
+
+import (
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/message"
+)
+
+// Option configures an Engine
+type Option func(*Engine)
+
+// WithKeyStyle sets how nested field paths are rendered as error keys
+func WithKeyStyle(style contract.KeyStyle) Option {
+	return func(e *Engine) {
+		e.KeyStyle = style
+	}
+}
+
+// WithEscapedInput HTML-escapes user-provided values interpolated into messages
+// through the :input placeholder. It applies to the engine's default message resolver.
+func WithEscapedInput() Option {
+	return func(e *Engine) {
+		if resolver, ok := e.MessageResolver.(*message.Resolver); ok {
+			resolver.SetEscapeInput(true)
+		}
+	}
+}
+
+// WithICUMessages loads an ICU MessageFormat catalog (rule name to pattern) into the engine's
+// default message resolver and interprets its messages as ICU, with plural rules for locale.
+func WithICUMessages(locale string, catalog map[string]string) Option {
+	return func(e *Engine) {
+		if resolver, ok := e.MessageResolver.(*message.Resolver); ok {
+			resolver.LoadCatalog(catalog)
+			resolver.UseICU(locale)
+		}
+	}
+}
+
+// WithLocale sets the engine's default locale chain: messages come from the catalog of locale,
+// then its BCP 47 parents and the fallbacks (de-AT → de → en), then the built-in messages.
+func WithLocale(locale string, fallbacks ...string) Option {
+	return func(e *Engine) {
+		if resolver, ok := e.MessageResolver.(*message.Resolver); ok {
+			resolver.SetLocale(locale, fallbacks...)
+		}
+	}
+}
+
+// WithFormatter replaces how the engine's default message resolver formats numbers, dates and
+// :values lists for the active locale (message.LocaleFormatter by default).
+func WithFormatter(formatter message.Formatter) Option {
+	return func(e *Engine) {
+		if resolver, ok := e.MessageResolver.(*message.Resolver); ok {
+			resolver.SetFormatter(formatter)
+		}
+	}
+}
+
+// WithLocaleCatalog loads the messages of a locale into the engine's default message resolver
+func WithLocaleCatalog(locale string, catalog map[string]string) Option {
+	return func(e *Engine) {
+		if resolver, ok := e.MessageResolver.(*message.Resolver); ok {
+			resolver.LoadLocaleCatalog(locale, catalog)
+		}
+	}
+}
+
+// WithPreprocessors registers preprocessors that rewrite the input before any rule runs.
+// Rules and Result.Validated() both observe the preprocessed values.
+func WithPreprocessors(preprocessors ...Preprocessor) Option {
+	return func(e *Engine) {
+		e.Preprocessors = append(e.Preprocessors, preprocessors...)
+	}
+}
+
+// WithDataOptions applies options such as TrimStrings to the data provider of every run, before
+// the preprocessors
+func WithDataOptions(options ...DataOption) Option {
+	return func(e *Engine) {
+		e.DataOptions = append(e.DataOptions, options...)
+	}
+}
+
+// WithProfile selects the rule set profile (e.g. "staging", "prod") whose overrides apply
+// when validating against a contract.RuleSet.
+func WithProfile(profile string) Option {
+	return func(e *Engine) {
+		e.Profile = profile
+	}
+}
+
+// WithConcurrency bounds how many contract.AsyncRule validations run at once within one
+// Execute (DefaultConcurrency when unset). Use 1 to run async rules one at a time.
+func WithConcurrency(limit int) Option {
+	return func(e *Engine) {
+		e.Concurrency = limit
+	}
+}
+
+// WithStringCoercion lets string rules (email, url, regex, alpha, ...) validate non-string values
+// implementing encoding.TextMarshaler or fmt.Stringer through their text form instead of
+// rejecting them as the wrong type.
+func WithStringCoercion() Option {
+	return func(e *Engine) {
+		e.CoerceStrings = true
+	}
+}
+
+// WithPartial enables PATCH-style validation: fields absent from the input are skipped, including
+// their required* rules, while provided fields are validated as usual. One rule set can then serve
+// both full (POST) and partial (PATCH) updates.
+func WithPartial() Option {
+	return func(e *Engine) {
+		e.Partial = true
+	}
+}
+
+// WithScoring enables the data quality scoring mode: every evaluated rule contributes a weight,
+// keyed by rule ("required") or by rule and field ("required.phone"), DefaultScoreWeight when
+// missing, and Result.Score() reports the passing share from 0 to 100 with per-field deductions.
+func WithScoring(weights map[string]float64) Option {
+	return func(e *Engine) {
+		e.ScoreWeights = make(map[string]float64, len(weights))
+		for key, weight := range weights {
+			e.ScoreWeights[key] = weight
+		}
+	}
+}
+
+// WithResultFactory makes the engine record outcomes into the accumulators created by factory
+// instead of *contract.ValidationErrors, one per Execute.
+func WithResultFactory(factory contract.ResultFactory) Option {
+	return func(e *Engine) {
+		e.ResultFactory = factory
+	}
+}
+
+// WithRuleMiddleware wraps every rule evaluation with middleware (see Engine.UseRuleMiddleware)
+func WithRuleMiddleware(middleware ...RuleMiddleware) Option {
+	return func(e *Engine) {
+		e.UseRuleMiddleware(middleware...)
+	}
+}
+
+// WithMetrics reports per-rule evaluations, failures and durations to observer
+// (see adapters/metrics for expvar and Prometheus exporters)
+func WithMetrics(observer contract.RuleObserver) Option {
+	return func(e *Engine) {
+		e.UseRuleMiddleware(MetricsMiddleware(observer))
+	}
+}
+
+// WithRateLimit caps the evaluations of external rules of resource (contract.ResourceDatabase,
+// contract.ResourceDNS, or "" for all), waiting or skipping them when the limit is reached
+func WithRateLimit(resource string, limiter contract.RateLimiter, mode RateLimitMode) Option {
+	return func(e *Engine) {
+		e.UseRuleMiddleware(RateLimitMiddleware(resource, limiter, mode))
+	}
+}
+
+// WithCircuitBreaker guards the external rules of resource (contract.ResourceDatabase,
+// contract.ResourceDNS, or "" for all) with breaker, so an unavailable dependency is answered
+// by policy instead of slowing down or failing every validation
+func WithCircuitBreaker(resource string, breaker *CircuitBreaker, policy BreakerPolicy) Option {
+	return func(e *Engine) {
+		e.UseRuleMiddleware(CircuitBreakerMiddleware(resource, breaker, policy))
+	}
+}
+
+// WithTenant validates with the policy registered for tenant id (see tenant.RegisterTenant):
+// its custom rules and messages are layered over the shared ones and its disabled rules are
+// unknown. Use Engine.ForTenant to pick a tenant per validation.
+func WithTenant(id string) Option {
+	return func(e *Engine) {
+		e.Tenant = id
+	}
+}
+
+// WithRejectUnknownFields fails validation for input fields the rules do not cover, so unexpected
+// attributes are not accepted silently. allowed lists fields (wildcards included, e.g.
+// "meta.*") accepted without rules; a field also covers everything nested below it.
+func WithRejectUnknownFields(allowed ...string) Option {
+	return func(e *Engine) {
+		e.RejectUnknown = true
+		e.AllowedFields = append(e.AllowedFields, allowed...)
+	}
+}
+
+// WithProjection carries only the fields named by the rules into Result.Validated(), dropping
+// everything else, including unchecked keys nested in validated objects and lists, as
+// mass-assignment protection. report, if not nil, receives the input fields that were dropped.
+func WithProjection(report func(dropped []string)) Option {
+	return func(e *Engine) {
+		e.Projection = true
+		e.ProjectionReport = report
+	}
+}
+
+// WithEmbeddedStructs selects whether the fields of embedded structs are validated under
+// flattened names (EmbedFlattened) or prefixed paths (EmbedPrefixed, the default) by struct
+// validation. An `embed:"flatten"` or `embed:"prefix"` tag overrides the mode per field.
+func WithEmbeddedStructs(mode EmbedMode) Option {
+	return func(e *Engine) {
+		e.Embedding = mode
+	}
+}
+
+// WithDereference dereferences up to depth levels of pointers and interfaces in the input before
+// rules run (DerefAll for any depth), so rules and Result.Validated() see *int values as ints.
+// By default values are handed to the rules as they are.
+func WithDereference(depth int) Option {
+	return func(e *Engine) {
+		e.DerefDepth = depth
+	}
+}
+
+// WithNilPointers decides whether a typed nil pointer in the input is kept as it is
+// (NilPointerKeep, the default), present but empty (NilPointerEmpty) or missing (NilPointerMissing)
+func WithNilPointers(policy NilPointerPolicy) Option {
+	return func(e *Engine) {
+		e.NilPointers = policy
+	}
+}
+
+// WithRequiredPreset selects what required considers empty: contract.RequiredLaravel (the default)
+// fails false and 0 as well, contract.RequiredGo only nil, empty strings and empty collections
+func WithRequiredPreset(preset contract.RequiredPreset) Option {
+	return func(e *Engine) {
+		e.RequiredPreset = preset
+	}
+}
+
+// WithMaxErrorsPerField keeps at most n errors per field, so a field failing many rules does not
+// flood the result. Further errors are dropped and Result.Truncated reports it.
+func WithMaxErrorsPerField(n int) Option {
+	return func(e *Engine) {
+		e.MaxErrorsPerField = n
+	}
+}
+
+// WithMaxTotalErrors keeps at most n errors per run, bounding the error maps and memory of
+// adversarial payloads with thousands of invalid list entries. Fields are validated in sorted
+// order and validation stops once n errors are recorded; Result.Truncated reports it.
+func WithMaxTotalErrors(n int) Option {
+	return func(e *Engine) {
+		e.MaxTotalErrors = n
+	}
+}
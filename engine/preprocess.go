@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// NullableRuleName marks a field whose nil value skips every non-implicit rule
+const NullableRuleName = "nullable"
+
+// Preprocessor rewrites a single input value before validation. It is applied
+// recursively to every value of nested maps and []any slices; field is the
+// dot-notation path of the value (e.g. "items.0.name").
+type Preprocessor func(field string, value any) any
+
+// ConvertEmptyStringsToNull returns a preprocessor that turns empty strings into nil,
+// matching how HTML forms submit blank optional inputs. Pair it with the nullable rule.
+func ConvertEmptyStringsToNull() Preprocessor {
+	return func(_ string, value any) any {
+		if str, ok := value.(string); ok && str == "" {
+			return nil
+		}
+		return value
+	}
+}
+
+// preprocess applies the engine's preprocessors to a copy of data
+func (e *Engine) preprocess(data map[string]any) map[string]any {
+	processed := utils.CloneData(data)
+	for field, value := range processed {
+		processed[field] = e.preprocessValue(field, value)
+	}
+	return processed
+}
+
+// preprocessValue applies the preprocessors to value, descending into containers
+func (e *Engine) preprocessValue(field string, value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = e.preprocessValue(field+"."+key, item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = e.preprocessValue(field+"."+strconv.Itoa(i), item)
+		}
+		return v
+	}
+
+	for _, preprocessor := range e.Preprocessors {
+		value = preprocessor(field, value)
+	}
+	return value
+}
+
+// isImplicitRule reports whether a rule must run even for nil values of nullable fields
+func isImplicitRule(name string) bool {
+	switch name {
+	case "accepted", "accepted_if", "declined", "declined_if", "filled", "present":
+		return true
+	}
+	return strings.HasPrefix(name, "required") || strings.HasPrefix(name, "prohibit")
+}
@@ -0,0 +1,56 @@
+package engine
+
+import (
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// project restricts validated to the fields of the expanded rules (WithProjection): values nested
+// in a validated object or list are kept only when no rule names a field below it. The input
+// fields left out are handed to the projection report, if any.
+func (e *Engine) project(
+	data contract.DataProvider,
+	fields map[string]string,
+	validated map[string]any,
+) map[string]any {
+	keep := projectionFilter(fields)
+	projected, _ := utils.FilterPaths(validated, keep)
+	if e.ProjectionReport != nil {
+		if _, dropped := utils.FilterPaths(data.All(), keep); len(dropped) > 0 {
+			for i, path := range dropped {
+				dropped[i] = e.KeyStyle.Format(path)
+			}
+			e.ProjectionReport(dropped)
+		}
+	}
+	return projected
+}
+
+// projectionFilter accepts the paths of fields, their parents, and the paths below fields that
+// have no rules for nested fields
+func projectionFilter(fields map[string]string) func(path string) bool {
+	leaves := make(map[string]bool, len(fields))
+	for field := range fields {
+		leaves[field] = true
+	}
+	for field := range fields {
+		for parent := field; strings.Contains(parent, "."); {
+			parent = parent[:strings.LastIndex(parent, ".")]
+			delete(leaves, parent)
+		}
+	}
+
+	return func(path string) bool {
+		for field := range fields {
+			if path == field || strings.HasPrefix(field, path+".") {
+				return true
+			}
+			if leaves[field] && strings.HasPrefix(path, field+".") {
+				return true
+			}
+		}
+		return false
+	}
+}
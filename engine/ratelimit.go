@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// RateLimitMode selects what happens to an external rule when its rate limit is reached
+type RateLimitMode int
+
+const (
+	// RateLimitWait queues the rule until the limiter allows it or the request context is done
+	RateLimitWait RateLimitMode = iota
+	// RateLimitFailFast skips the rule immediately
+	RateLimitFailFast
+)
+
+// RateLimitMiddleware limits the evaluations of contract.ExternalRule rules of resource (every
+// external rule when resource is empty). Rules the limiter does not admit are not evaluated and
+// are reported through Result.Skipped.
+func RateLimitMiddleware(resource string, limiter contract.RateLimiter, mode RateLimitMode) RuleMiddleware {
+	return func(next RuleFunc) RuleFunc {
+		return func(name string, rule contract.Rule, ctx contract.RuleContext) error {
+			external, ok := rule.(contract.ExternalRule)
+			if !ok || (resource != "" && external.Resource() != resource) {
+				return next(name, rule, ctx)
+			}
+			if mode == RateLimitFailFast {
+				if !limiter.Allow() {
+					return fmt.Errorf("%w: %s rate limit reached", contract.ErrRuleSkipped, external.Resource())
+				}
+				return next(name, rule, ctx)
+			}
+			if err := limiter.Wait(contract.ContextOf(ctx)); err != nil {
+				return fmt.Errorf("%w: %v", contract.ErrRuleSkipped, err)
+			}
+			return next(name, rule, ctx)
+		}
+	}
+}
+
+// TokenBucket is a simple contract.RateLimiter admitting perSecond evaluations on average with
+// bursts of up to burst evaluations
+type TokenBucket struct {
+	mu        sync.Mutex
+	perSecond float64
+	burst     float64
+	tokens    float64
+	last      time.Time
+}
+
+// Ensure TokenBucket implements contract.RateLimiter
+var _ contract.RateLimiter = (*TokenBucket)(nil)
+
+// NewTokenBucket creates a full token bucket refilling perSecond tokens per second
+func NewTokenBucket(perSecond float64, burst int) *TokenBucket {
+	return &TokenBucket{perSecond: perSecond, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// Allow takes a token if one is available
+func (b *TokenBucket) Allow() bool {
+	return b.reserve(false) == 0
+}
+
+// Wait takes a token, blocking until one is available or ctx is done
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		delay := b.reserve(true)
+		if delay == 0 {
+			return nil
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token and returns 0, or returns how long until the next token is available.
+// A bucket that never refills reports an hour when empty so Wait only ends with its context.
+func (b *TokenBucket) reserve(wait bool) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.perSecond)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	if !wait || b.perSecond <= 0 {
+		return time.Hour
+	}
+	return time.Duration((1 - b.tokens) / b.perSecond * float64(time.Second))
+}
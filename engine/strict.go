@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// UnknownFieldRuleName is the rule reported for input fields not covered by the rules when
+// unknown fields are rejected (WithRejectUnknownFields). Its message is customizable like any
+// rule's ("unknown_field", "unknown_field.<field>").
+const UnknownFieldRuleName = "unknown_field"
+
+const unknownFieldErrorMsg = "The :attribute field is not allowed"
+
+// rejectUnknownFields adds an error for each input field that neither the rules nor the allowed
+// fields cover. The unexpanded patterns count too, so the rule items.*.sku covers an empty
+// "items" list.
+func (e *Engine) rejectUnknownFields(
+	data contract.DataProvider,
+	rulesMap map[string]string,
+	fields map[string]string,
+	validationErrors contract.ResultAccumulator,
+) {
+	allData := data.All()
+	known := make([]string, 0, len(rulesMap)+len(fields)+len(e.AllowedFields))
+	for field := range rulesMap {
+		known = append(known, field)
+	}
+	for field := range fields {
+		known = append(known, field)
+	}
+	for _, pattern := range e.AllowedFields {
+		known = append(known, pattern)
+		known = append(known, utils.ExpandPath(allData, pattern)...)
+	}
+
+	for _, path := range utils.LeafPaths(allData) {
+		if coveredField(path, known) {
+			continue
+		}
+		value, _ := data.Get(path)
+		ctx := contract.NewValidationContext(path, value, nil, allData).WithContext(e.Context)
+		message := unknownFieldErrorMsg
+		if e.MessageResolver != nil {
+			message = e.resolveRuleMessage(UnknownFieldRuleName, ctx, unknownFieldErrorMsg)
+		}
+		validationErrors.AddRuleError(
+			e.KeyStyle.Format(path), contract.ParsedRule{Name: UnknownFieldRuleName}, message,
+		)
+	}
+}
+
+// coveredField reports whether path is one of fields, lies below one (a validated object or
+// list) or above one (an empty container or scalar where nested fields were expected)
+func coveredField(path string, fields []string) bool {
+	for _, field := range fields {
+		if path == field || strings.HasPrefix(path, field+".") || strings.HasPrefix(field, path+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,431 @@
+package engine
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// StructTag is the struct tag holding a field's rule string, e.g. `validate:"required|email"`
+const StructTag = "validate"
+
+// StructEmbedTag is the struct tag overriding the EmbedMode of an embedded struct field,
+// e.g. `embed:"flatten"`
+const StructEmbedTag = "embed"
+
+// EmbedMode selects how the fields of embedded structs are keyed
+type EmbedMode string
+
+const (
+	// EmbedPrefixed keys embedded struct fields below the embedded field ("Audit.created_by"),
+	// named by its json tag or its type name. It is the default.
+	EmbedPrefixed EmbedMode = "prefix"
+	// EmbedFlattened promotes embedded struct fields to the parent ("created_by") like encoding/json:
+	// embedded fields with a json tag name stay prefixed and the parent's own fields win on conflicts.
+	EmbedFlattened EmbedMode = "flatten"
+)
+
+// structField is the cached metadata of a single exported struct field
+type structField struct {
+	name     string
+	index    int
+	rules    string
+	embedded bool
+	named    bool
+	embed    EmbedMode
+}
+
+// flattens reports whether the field is an embedded struct whose fields are promoted under mode
+func (f structField) flattens(mode EmbedMode) bool {
+	if !f.embedded {
+		return false
+	}
+	switch f.embed {
+	case EmbedFlattened:
+		return true
+	case EmbedPrefixed:
+		return false
+	default:
+		return mode == EmbedFlattened && !f.named
+	}
+}
+
+// structMeta is the cached metadata of a struct type
+type structMeta struct {
+	fields []structField
+}
+
+// valuerType is used to keep sql.Null* and other driver.Valuer structs as scalar values
+var valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+
+// structCache caches structMeta per reflect.Type so repeated validation of the
+// same DTO type doesn't repeat reflection work.
+var structCache sync.Map
+
+// metaFor returns the cached metadata of a struct type, computing it on first use
+func metaFor(t reflect.Type) *structMeta {
+	if cached, ok := structCache.Load(t); ok {
+		return cached.(*structMeta)
+	}
+
+	meta := &structMeta{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, skip := fieldName(field)
+		if skip {
+			continue
+		}
+		jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		meta.fields = append(meta.fields, structField{
+			name:     name,
+			index:    i,
+			rules:    field.Tag.Get(StructTag),
+			embedded: field.Anonymous && isStructType(field.Type),
+			named:    jsonName != "",
+			embed:    EmbedMode(field.Tag.Get(StructEmbedTag)),
+		})
+	}
+
+	actual, _ := structCache.LoadOrStore(t, meta)
+	return actual.(*structMeta)
+}
+
+// fieldName returns the data key of a field: its json tag name, or the Go field name
+func fieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, false
+	}
+	return field.Name, false
+}
+
+// NewStructDataProvider creates a data provider from a struct or pointer to a struct.
+// Nested structs, slices and maps are converted so dot-notation paths resolve into them.
+func NewStructDataProvider(value any) *DataProvider {
+	return NewDataProvider(StructToMap(value))
+}
+
+// StructToMap converts a struct (or pointer to a struct) to map[string]any keyed by
+// json tag names. It returns nil for values that are not structs.
+func StructToMap(value any) map[string]any {
+	return StructToMapWith(value, EmbedPrefixed)
+}
+
+// StructToMapWith converts a struct like StructToMap, keying embedded struct fields by mode
+// unless their embed tag says otherwise
+func StructToMapWith(value any, mode EmbedMode) map[string]any {
+	val := indirect(reflect.ValueOf(value))
+	if !val.IsValid() || val.Kind() != reflect.Struct {
+		return nil
+	}
+	seen := visiting{}
+	key := seen.enter(reflect.ValueOf(value))
+	defer seen.leave(key)
+	return structValueToMap(val, mode, seen)
+}
+
+// StructRules returns the rule strings declared in the `validate` tags of a struct, including
+// those of the structs nested in it under dotted paths: struct fields ("address.city"), slices
+// and arrays ("others.0.city") and maps with string keys ("by_kind.home.city"). The rules of a
+// nested field apply to it as a whole alongside its children's; nil pointers are not descended.
+func StructRules(value any) map[string]string {
+	return StructRulesWith(value, EmbedPrefixed)
+}
+
+// StructRulesWith returns the rule strings of a struct like StructRules, keying embedded struct
+// fields by mode unless their embed tag says otherwise. The rules of flattened embedded fields
+// themselves are ignored.
+func StructRulesWith(value any, mode EmbedMode) map[string]string {
+	val := indirect(reflect.ValueOf(value))
+	if !val.IsValid() || val.Kind() != reflect.Struct {
+		return nil
+	}
+
+	seen := visiting{}
+	key := seen.enter(reflect.ValueOf(value))
+	defer seen.leave(key)
+
+	rules := make(map[string]string)
+	collectStructRules(val, "", rules, mode, seen)
+	return rules
+}
+
+// collectStructRules adds the rules of a struct value and its nested structs below prefix
+func collectStructRules(val reflect.Value, prefix string, rules map[string]string, mode EmbedMode, seen visiting) {
+	meta := metaFor(val.Type())
+	for _, field := range meta.fields {
+		if field.flattens(mode) {
+			continue
+		}
+		path := field.name
+		if prefix != "" {
+			path = prefix + "." + field.name
+		}
+		if field.rules != "" {
+			rules[path] = field.rules
+		}
+		collectNestedRules(val.Field(field.index), path, rules, mode, seen)
+	}
+
+	for _, field := range meta.fields {
+		embedded := indirect(val.Field(field.index))
+		if !field.flattens(mode) || !embedded.IsValid() {
+			continue
+		}
+		promoted := make(map[string]string)
+		collectStructRules(embedded, prefix, promoted, mode, seen)
+		for path, rule := range promoted {
+			if _, exists := rules[path]; !exists {
+				rules[path] = rule
+			}
+		}
+	}
+}
+
+// collectNestedRules adds the rules of the structs held by a field value at path. Values
+// referring back to one being collected are not descended again.
+func collectNestedRules(val reflect.Value, path string, rules map[string]string, mode EmbedMode, seen visiting) {
+	key := seen.enter(val)
+	if key == cyclic {
+		return
+	}
+	defer seen.leave(key)
+
+	val = indirect(val)
+	if !val.IsValid() || val.Type().Implements(valuerType) {
+		return
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		if _, isTime := val.Interface().(time.Time); !isTime {
+			collectStructRules(val, path, rules, mode, seen)
+		}
+	case reflect.Slice, reflect.Array:
+		if !hasStructElements(val.Type().Elem()) {
+			return
+		}
+		for i := 0; i < val.Len(); i++ {
+			collectNestedRules(val.Index(i), path+"."+strconv.Itoa(i), rules, mode, seen)
+		}
+	case reflect.Map:
+		if val.Type().Key().Kind() != reflect.String || !hasStructElements(val.Type().Elem()) {
+			return
+		}
+		iter := val.MapRange()
+		for iter.Next() {
+			collectNestedRules(iter.Value(), path+"."+iter.Key().String(), rules, mode, seen)
+		}
+	}
+}
+
+// structValueToMap converts a struct value using the cached type metadata
+func structValueToMap(val reflect.Value, mode EmbedMode, seen visiting) map[string]any {
+	meta := metaFor(val.Type())
+	data := make(map[string]any, len(meta.fields))
+	for _, field := range meta.fields {
+		if !field.flattens(mode) {
+			data[field.name] = convertValue(val.Field(field.index), mode, seen)
+		}
+	}
+
+	for _, field := range meta.fields {
+		embedded := indirect(val.Field(field.index))
+		if !field.flattens(mode) || !embedded.IsValid() {
+			continue
+		}
+		for key, value := range structValueToMap(embedded, mode, seen) {
+			if _, exists := data[key]; !exists {
+				data[key] = value
+			}
+		}
+	}
+	return data
+}
+
+// convertValue converts nested structs, slices and maps into map/slice form. A value referring
+// back to one being converted becomes nil, so cyclic structs convert to a finite map.
+func convertValue(val reflect.Value, mode EmbedMode, seen visiting) any {
+	key := seen.enter(val)
+	if key == cyclic {
+		return nil
+	}
+	defer seen.leave(key)
+
+	val = indirect(val)
+	if !val.IsValid() {
+		return nil
+	}
+	if val.Type().Implements(valuerType) {
+		return utils.Unwrap(val.Interface())
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		if _, isTime := val.Interface().(time.Time); isTime {
+			return val.Interface()
+		}
+		return structValueToMap(val, mode, seen)
+	case reflect.Slice, reflect.Array:
+		if val.Kind() == reflect.Slice && val.IsNil() {
+			return nil
+		}
+		if !hasStructElements(val.Type().Elem()) && !isNamedBasic(val.Type().Elem()) {
+			return val.Interface()
+		}
+		items := make([]any, val.Len())
+		for i := range items {
+			items[i] = convertValue(val.Index(i), mode, seen)
+		}
+		return items
+	case reflect.Map:
+		if val.IsNil() || val.Type().Key().Kind() != reflect.String ||
+			!hasStructElements(val.Type().Elem()) && !isNamedBasic(val.Type().Elem()) {
+			return val.Interface()
+		}
+		items := make(map[string]any, val.Len())
+		iter := val.MapRange()
+		for iter.Next() {
+			items[iter.Key().String()] = convertValue(iter.Value(), mode, seen)
+		}
+		return items
+	default:
+		return underlyingValue(val)
+	}
+}
+
+// basicTypes are the predeclared types named basic kinds are converted to
+var basicTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:    reflect.TypeOf(false),
+	reflect.String:  reflect.TypeOf(""),
+	reflect.Int:     reflect.TypeOf(0),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+}
+
+// isNamedBasic reports whether t is a named type of a basic kind, e.g. type Role string
+func isNamedBasic(t reflect.Type) bool {
+	_, basic := basicTypes[t.Kind()]
+	return basic && t != basicTypes[t.Kind()]
+}
+
+// underlyingValue converts named basic kinds (type Role string) to their underlying type, as
+// encoding/json would, so rules see plain strings and numbers. Types with a registered
+// contract.TypeHandler, or implementing contract.Sizer or contract.ExactNumber, keep their type.
+func underlyingValue(val reflect.Value) any {
+	value := val.Interface()
+	if !isNamedBasic(val.Type()) {
+		return value
+	}
+	if _, ok := registry.FindTypeHandler(value); ok {
+		return value
+	}
+	switch value.(type) {
+	case contract.Sizer, contract.ExactNumber:
+		return value
+	}
+	return val.Convert(basicTypes[val.Kind()]).Interface()
+}
+
+// isStructType reports whether t, or the type t points to, is a struct other than time.Time
+// and driver.Valuer implementations
+func isStructType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t != reflect.TypeOf(time.Time{}) &&
+		!t.Implements(valuerType) && !reflect.PointerTo(t).Implements(valuerType)
+}
+
+// hasStructElements reports whether elements of type t need conversion
+func hasStructElements(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Interface {
+		return true
+	}
+	return t.Kind() == reflect.Struct && t != reflect.TypeOf(time.Time{})
+}
+
+// indirect dereferences pointers and interfaces; nil pointers yield an invalid value
+func indirect(val reflect.Value) reflect.Value {
+	for val.IsValid() && (val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface) {
+		if val.IsNil() {
+			return reflect.Value{}
+		}
+		val = val.Elem()
+	}
+	return val
+}
+
+// visitKey identifies a pointer, map or slice by its address, type and, for slices, length
+type visitKey struct {
+	ptr  uintptr
+	typ  reflect.Type
+	size int
+}
+
+// cyclic is returned by visiting.enter for a reference that is already being visited
+var cyclic = visitKey{size: -1}
+
+// visiting holds the references on the path from the root value to the one being walked,
+// so values referring back to an ancestor (a.Next = b, b.Next = a) end the walk instead of
+// recursing until the stack overflows
+type visiting map[visitKey]bool
+
+// enter marks the outermost pointer, map or slice held by val as visited. It returns cyclic if
+// that reference is already on the path and otherwise a key to pass to leave once val is done;
+// values holding no reference yield the zero key.
+func (v visiting) enter(val reflect.Value) visitKey {
+	for val.IsValid() && val.Kind() == reflect.Interface && !val.IsNil() {
+		val = val.Elem()
+	}
+	var key visitKey
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Map:
+		if val.IsNil() {
+			return key
+		}
+		key = visitKey{ptr: val.Pointer(), typ: val.Type()}
+	case reflect.Slice:
+		if val.IsNil() {
+			return key
+		}
+		key = visitKey{ptr: val.Pointer(), typ: val.Type(), size: val.Len()}
+	default:
+		return key
+	}
+	if v[key] {
+		return cyclic
+	}
+	v[key] = true
+	return key
+}
+
+// leave removes a key returned by enter from the path
+func (v visiting) leave(key visitKey) {
+	delete(v, key)
+}
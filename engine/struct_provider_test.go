@@ -0,0 +1,227 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry"
+)
+
+type addressDTO struct {
+	City string `json:"city" validate:"required"`
+}
+
+type userDTO struct {
+	Name      string                `json:"name" validate:"required|min:2"`
+	Email     string                `json:"email,omitempty" validate:"email"`
+	Password  string                `json:"-"`
+	Age       *int                  `validate:"nullable|integer"`
+	CreatedAt time.Time             `json:"created_at"`
+	Address   *addressDTO           `json:"address"`
+	Others    []addressDTO          `json:"others"`
+	ByKind    map[string]addressDTO `json:"by_kind"`
+	internal  string
+}
+
+func TestStructToMap(t *testing.T) {
+	created := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	data := StructToMap(&userDTO{
+		Name:      "Ann",
+		CreatedAt: created,
+		Address:   &addressDTO{City: "Oslo"},
+		Others:    []addressDTO{{City: "Rome"}},
+		ByKind:    map[string]addressDTO{"home": {City: "Bern"}},
+		internal:  "hidden",
+	})
+
+	if data["name"] != "Ann" || data["Age"] != nil || data["created_at"] != created {
+		t.Fatalf("unexpected scalar values: %#v", data)
+	}
+	if _, ok := data["Password"]; ok {
+		t.Fatal(`fields tagged json:"-" must be skipped`)
+	}
+	if _, ok := data["internal"]; ok {
+		t.Fatal("unexported fields must be skipped")
+	}
+
+	provider := NewDataProvider(data)
+	paths := map[string]string{"address.city": "Oslo", "others.0.city": "Rome", "by_kind.home.city": "Bern"}
+	for path, want := range paths {
+		if got, _ := provider.Get(path); got != want {
+			t.Fatalf("Get(%q) = %v, want %v", path, got, want)
+		}
+	}
+
+	if StructToMap(42) != nil {
+		t.Fatal("non-struct values must yield nil")
+	}
+}
+
+func TestStructRules_UsesCache(t *testing.T) {
+	rules := StructRules(userDTO{})
+	want := map[string]string{"name": "required|min:2", "email": "email", "Age": "nullable|integer"}
+	if !reflect.DeepEqual(rules, want) {
+		t.Fatalf("StructRules() = %#v, want %#v", rules, want)
+	}
+
+	if _, ok := structCache.Load(reflect.TypeOf(userDTO{})); !ok {
+		t.Fatal("expected struct metadata to be cached")
+	}
+	if metaFor(reflect.TypeOf(userDTO{})) != metaFor(reflect.TypeOf(userDTO{})) {
+		t.Fatal("expected cached metadata to be reused")
+	}
+}
+
+func TestStructRules_Nested(t *testing.T) {
+	type itemDTO struct {
+		SKU string `json:"sku" validate:"required"`
+	}
+	type orderDTO struct {
+		Address *addressDTO         `json:"address" validate:"required"`
+		Items   []itemDTO           `json:"items" validate:"min:1"`
+		ByKind  map[string]*itemDTO `json:"by_kind"`
+		Billing addressDTO          `json:"billing"`
+		Spare   *addressDTO         `json:"spare"`
+		Stamp   time.Time           `json:"stamp" validate:"required"`
+		Tags    []string            `json:"tags"`
+		Lookup  map[string]addressDTO
+	}
+
+	rules := StructRules(orderDTO{
+		Address: &addressDTO{},
+		Items:   []itemDTO{{}, {}},
+		ByKind:  map[string]*itemDTO{"gift": {}},
+	})
+	want := map[string]string{
+		"address":          "required",
+		"address.city":     "required",
+		"items":            "min:1",
+		"items.0.sku":      "required",
+		"items.1.sku":      "required",
+		"by_kind.gift.sku": "required",
+		"billing.city":     "required",
+		"stamp":            "required",
+	}
+	if !reflect.DeepEqual(rules, want) {
+		t.Fatalf("StructRules() = %#v, want %#v", rules, want)
+	}
+}
+
+type nodeDTO struct {
+	Name string   `json:"name" validate:"required"`
+	Next *nodeDTO `json:"next"`
+}
+
+type roleName string
+
+type priority int
+
+type handledCode string
+
+type memberDTO struct {
+	Role     roleName            `json:"role" validate:"alpha"`
+	Roles    []roleName          `json:"roles" validate:"min:1"`
+	Priority priority            `json:"priority" validate:"integer|max:5"`
+	Code     handledCode         `json:"code"`
+	ByTeam   map[string]roleName `json:"by_team"`
+}
+
+func TestStructToMap_NamedBasicTypes(t *testing.T) {
+	registry.RegisterTypeHandler(reflect.TypeOf(handledCode("")), contract.TypeHandler{
+		String: func(value any) (string, bool) { return string(value.(handledCode)), true },
+	})
+	member := memberDTO{
+		Role: "admin", Roles: []roleName{"editor"}, Priority: 3, Code: "x1", ByTeam: map[string]roleName{"a": "owner"},
+	}
+
+	data := StructToMap(member)
+	want := map[string]any{
+		"role": "admin", "roles": []any{"editor"}, "priority": 3, "code": handledCode("x1"),
+		"by_team": map[string]any{"a": "owner"},
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Fatalf("StructToMap() = %#v, want %#v", data, want)
+	}
+
+	res := NewEngine().Execute(NewDataProvider(data), StructRules(member))
+	if !res.IsValid() {
+		t.Fatalf("expected named basic types to validate like their underlying types, got %#v", res.Errors())
+	}
+}
+
+func TestStruct_Cyclic(t *testing.T) {
+	a, b := &nodeDTO{}, &nodeDTO{}
+	a.Next, b.Next = b, a
+
+	rules := StructRules(a)
+	want := map[string]string{"name": "required", "next.name": "required"}
+	if !reflect.DeepEqual(rules, want) {
+		t.Fatalf("StructRules() = %#v, want %#v", rules, want)
+	}
+
+	data := StructToMap(a)
+	next, _ := data["next"].(map[string]any)
+	if next == nil || next["next"] != nil {
+		t.Fatalf("expected the cycle to end after one level, got %#v", data)
+	}
+
+	shared := &addressDTO{}
+	type twiceDTO struct {
+		Home *addressDTO `json:"home"`
+		Work *addressDTO `json:"work"`
+	}
+	rules = StructRules(twiceDTO{Home: shared, Work: shared})
+	if rules["home.city"] == "" || rules["work.city"] == "" {
+		t.Fatalf("expected a shared pointer to be descended in both places, got %#v", rules)
+	}
+}
+
+type AuditMixin struct {
+	CreatedBy string `json:"created_by" validate:"required"`
+	Name      string `json:"name" validate:"min:9"`
+}
+
+type TimestampsMixin struct {
+	UpdatedAt string `json:"updated_at" validate:"required"`
+}
+
+type documentDTO struct {
+	AuditMixin
+	*TimestampsMixin `embed:"prefix"`
+	Name             string `json:"name" validate:"required"`
+}
+
+func TestStructRules_EmbeddedStructs(t *testing.T) {
+	doc := documentDTO{AuditMixin: AuditMixin{CreatedBy: "ann"}, TimestampsMixin: &TimestampsMixin{}, Name: "x"}
+
+	prefixed := StructRules(doc)
+	want := map[string]string{
+		"AuditMixin.created_by":      "required",
+		"AuditMixin.name":            "min:9",
+		"TimestampsMixin.updated_at": "required",
+		"name":                       "required",
+	}
+	if !reflect.DeepEqual(prefixed, want) {
+		t.Fatalf("StructRules() = %#v, want %#v", prefixed, want)
+	}
+
+	flattened := StructRulesWith(doc, EmbedFlattened)
+	want = map[string]string{
+		"created_by":                 "required",
+		"TimestampsMixin.updated_at": "required",
+		"name":                       "required",
+	}
+	if !reflect.DeepEqual(flattened, want) {
+		t.Fatalf("StructRulesWith() = %#v, want %#v", flattened, want)
+	}
+
+	data := StructToMapWith(doc, EmbedFlattened)
+	if data["created_by"] != "ann" || data["name"] != "x" {
+		t.Fatalf("expected promoted fields with the parent winning conflicts, got %#v", data)
+	}
+	if _, ok := data["TimestampsMixin"].(map[string]any); !ok {
+		t.Fatalf("expected the embed tag to keep TimestampsMixin prefixed, got %#v", data)
+	}
+}
@@ -0,0 +1,37 @@
+package engine
+
+import (
+	registryRules "github.com/next-trace/scg-validator/registry/rules"
+	"github.com/next-trace/scg-validator/registry/tenant"
+)
+
+// ForTenant returns a copy of the engine validating with the policy of tenant id
+// (see tenant.RegisterTenant), sharing its registry and message resolver
+func (e *Engine) ForTenant(id string) *Engine {
+	scoped := *e
+	scoped.Tenant = id
+	return &scoped
+}
+
+// tenantScoped returns a copy of the engine without tenant that layers the registered policy of
+// its tenant over the registry and messages. Tenant messages are set on a clone of the resolver,
+// or on the resolver itself when it is request-scoped already (ownResolver).
+func (e *Engine) tenantScoped(ownResolver bool) *Engine {
+	scoped := *e
+	scoped.Tenant = ""
+	config, ok := tenant.FindTenant(e.Tenant)
+	if !ok {
+		return &scoped
+	}
+
+	scoped.Registry = registryRules.NewLayered(e.Registry, config)
+	if len(config.CustomMessages) > 0 && e.MessageResolver != nil {
+		if !ownResolver {
+			scoped.MessageResolver = e.MessageResolver.Clone()
+		}
+		for key, msg := range config.CustomMessages {
+			scoped.MessageResolver.SetCustomMessage(key, msg)
+		}
+	}
+	return &scoped
+}
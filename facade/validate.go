@@ -119,20 +119,12 @@ func (vr *ValidatorRequest) Validate() *contract.ValidationErrors {
 
 	// Execute validator using the engine
 	result := vr.engine.Execute(vr.data, rulesMap)
-
-	// Convert result to ValidationErrors
-	validationErrors := contract.NewValidationErrors()
-	if !result.IsValid() {
-		// Add errors from the result
-		errors := result.Errors()
-		for field, fieldErrors := range errors {
-			for _, err := range fieldErrors {
-				validationErrors.AddError(field, err)
-			}
-		}
+	if validationErrors, ok := result.(*contract.ValidationErrors); ok {
+		return validationErrors
 	}
 
-	return validationErrors
+	// Copy custom results (engine.WithResultFactory) into ValidationErrors
+	return contract.MergeResults(result)
 }
 
 // Fails returns true if validator failed (Laravel-style API)
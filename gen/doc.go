@@ -0,0 +1,12 @@
+// Package gen generates typed validators: for each schema a ValidateX function taking the schema's
+// Go type, constants for its field names and the rule set it validates against. Schemas come from
+// the `validate` tags of struct types (Package.Struct) or from rule set documents (FromRuleSet).
+// The scg-validator command runs it from go:generate directives:
+//
+//	//go:generate go run github.com/next-trace/scg-validator/cmd/scg-validator gen -type SignupRequest
+//
+// generates signuprequest_validator.go with ValidateSignupRequest(x SignupRequest) contract.Result
+// and SignupRequestFieldEmail = "email". The generated code references every field the rules were
+// read from, so renaming one fails the build until the validator is regenerated. Static schemas
+// (-static) inline their rules as plain Go code, without the rule registry or reflection.
+package gen
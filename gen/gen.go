@@ -0,0 +1,300 @@
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+)
+
+// Header is the first line of generated files, recognized by go tooling as generated code
+const Header = "// Code generated by scg-validator gen; DO NOT EDIT."
+
+// modulePath prefixes the imports of this module, grouped after the standard library ones
+const modulePath = "github.com/next-trace/scg-validator/"
+
+var errNoSchemas = errors.New("gen: no schemas to generate")
+
+// Schema describes one generated validator: the ValidateName functions, the NameField* constants
+// and the NameRuleSet variable. Type is the Go type the functions accept; without one they take
+// map[string]any.
+type Schema struct {
+	Name    string
+	Type    string
+	RuleSet *contract.RuleSet
+	// Embedding is how the validator used by ValidateName keys embedded struct fields
+	Embedding engine.EmbedMode
+	// Selectors maps fields to Go selectors on the type ("Ship.City", "Lines[0].SKU"); they are
+	// referenced by the generated code so renaming a field breaks the build instead of the rules.
+	Selectors map[string]string
+	// Static makes ValidateName run code generated from the rules instead of the validator, for hot
+	// paths: no rule registry lookup and no reflection. It needs a schema read with Package.Struct
+	// whose rules are all StaticRules; messages are rendered at generation time.
+	Static bool
+
+	access map[string]fieldAccess
+}
+
+// FromRuleSet creates a schema for a rule set, e.g. one read with loader.LoadFile. typeName may be empty.
+func FromRuleSet(name, typeName string, set *contract.RuleSet) *Schema {
+	return &Schema{Name: name, Type: typeName, RuleSet: set}
+}
+
+// field is a rule set field with its generated constant
+type field struct {
+	Path     string
+	Const    string
+	Selector string
+}
+
+// schemaData is the template data of one schema
+type schemaData struct {
+	*Schema
+	Fields     []field
+	Consts     map[string]string
+	Validator  string
+	Flatten    bool
+	StaticBody string
+}
+
+// fileData is the template data of a generated file
+type fileData struct {
+	Header     string
+	Package    string
+	StdImports []string
+	Imports    []string
+	Engine     bool
+	Schemas    []schemaData
+	Preamble   string
+}
+
+// Generate returns the gofmt-ed source of a file of package pkg holding the validators of schemas
+func Generate(pkg string, schemas ...*Schema) ([]byte, error) {
+	if len(schemas) == 0 {
+		return nil, errNoSchemas
+	}
+	if !token.IsIdentifier(pkg) {
+		return nil, fmt.Errorf("gen: invalid package name %q", pkg)
+	}
+
+	data := fileData{Header: Header, Package: pkg}
+	static := newStaticWriter()
+	for _, schema := range schemas {
+		if !token.IsIdentifier(schema.Name) {
+			return nil, fmt.Errorf("gen: invalid schema name %q", schema.Name)
+		}
+		if schema.RuleSet == nil {
+			return nil, fmt.Errorf("gen: schema %s has no rule set", schema.Name)
+		}
+		flatten := schema.Embedding == engine.EmbedFlattened
+		data.Engine = data.Engine || flatten
+		schemaData := schemaData{
+			Schema:    schema,
+			Fields:    schemaFields(schema),
+			Validator: lowerFirst(schema.Name) + "Validator",
+			Flatten:   flatten,
+		}
+		if schema.Static {
+			body, err := static.body(schema)
+			if err != nil {
+				return nil, err
+			}
+			schemaData.StaticBody = body
+		}
+		data.Schemas = append(data.Schemas, schemaData)
+	}
+	data.Preamble = static.preamble()
+	for _, path := range sortedKeys(static.imports) {
+		if strings.HasPrefix(path, modulePath) {
+			data.Imports = append(data.Imports, path)
+		} else {
+			data.StdImports = append(data.StdImports, path)
+		}
+	}
+	for i := range data.Schemas {
+		data.Schemas[i].Consts = make(map[string]string, len(data.Schemas[i].Fields))
+		for _, f := range data.Schemas[i].Fields {
+			data.Schemas[i].Consts[f.Path] = f.Const
+		}
+	}
+
+	var out bytes.Buffer
+	if err := fileTemplate.Execute(&out, data); err != nil {
+		return nil, err
+	}
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("gen: format generated code: %w", err)
+	}
+	return source, nil
+}
+
+// schemaFields returns the fields of the schema's rules and profiles sorted by path, with a
+// constant each. Paths mapping to the same identifier get a numeric suffix.
+func schemaFields(schema *Schema) []field {
+	paths := make(map[string]bool)
+	for path := range schema.RuleSet.Rules {
+		paths[path] = true
+	}
+	for _, overrides := range schema.RuleSet.Profiles {
+		for path := range overrides {
+			paths[path] = true
+		}
+	}
+
+	fields := make([]field, 0, len(paths))
+	for path := range paths {
+		fields = append(fields, field{Path: path, Selector: schema.Selectors[path]})
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Path < fields[j].Path })
+
+	used := make(map[string]int)
+	for i := range fields {
+		name := schema.Name + "Field" + identifier(fields[i].Path)
+		if used[name]++; used[name] > 1 {
+			name += strconv.Itoa(used[name])
+		}
+		fields[i].Const = name
+	}
+	return fields
+}
+
+// identifier converts a field path to an exported identifier part: "ship.postal_code" becomes
+// "ShipPostalCode" and wildcards become "Each" ("lines.*.sku" is "LinesEachSku").
+func identifier(path string) string {
+	var out strings.Builder
+	upper := true
+	for _, r := range path {
+		switch {
+		case r == '*':
+			out.WriteString("Each")
+			upper = true
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if upper {
+				r = unicode.ToUpper(r)
+			}
+			out.WriteRune(r)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+	return out.String()
+}
+
+func lowerFirst(name string) string {
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+// sortedKeys returns the keys of m in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var fileTemplate = template.Must(template.New("file").Funcs(template.FuncMap{
+	"quote":  strconv.Quote,
+	"keys":   sortedKeys[string],
+	"groups": sortedKeys[map[string]string],
+}).Parse(`{{.Header}}
+
+package {{.Package}}
+
+import (
+{{- range .StdImports}}
+	{{quote .}}
+{{- end}}
+{{- if .StdImports}}
+{{end}}
+	"github.com/next-trace/scg-validator/contract"
+{{- if .Engine}}
+	"github.com/next-trace/scg-validator/engine"
+{{- end}}
+{{- range .Imports}}
+	{{quote .}}
+{{- end}}
+	"github.com/next-trace/scg-validator/validator"
+)
+{{range .Schemas}}{{$schema := .}}
+// Fields of the {{.Name}} rules
+const (
+{{- range .Fields}}
+	{{.Const}} = {{quote .Path}}
+{{- end}}
+)
+
+// {{.Name}}RuleSet holds the rules, messages and attributes {{.Name}} is validated against
+var {{.Name}}RuleSet = &contract.RuleSet{
+	Rules: map[string]string{
+{{- range $path := keys .RuleSet.Rules}}
+		{{index $schema.Consts $path}}: {{quote (index $schema.RuleSet.Rules $path)}},
+{{- end}}
+	},
+	Messages: map[string]string{
+{{- range $key := keys .RuleSet.Messages}}
+		{{quote $key}}: {{quote (index $schema.RuleSet.Messages $key)}},
+{{- end}}
+	},
+	Attributes: map[string]string{
+{{- range $path := keys .RuleSet.Attributes}}{{$attribute := index $schema.RuleSet.Attributes $path}}
+		{{with index $schema.Consts $path}}{{.}}{{else}}{{quote $path}}{{end}}: {{quote $attribute}},
+{{- end}}
+	},
+	Profiles: map[string]map[string]string{
+{{- range $profile := groups .RuleSet.Profiles}}{{$overrides := index $schema.RuleSet.Profiles $profile}}
+		{{quote $profile}}: {
+{{- range $path := keys $overrides}}
+			{{index $schema.Consts $path}}: {{quote (index $overrides $path)}},
+{{- end}}
+		},
+{{- end}}
+	},
+}
+
+var {{.Validator}} = validator.New({{if .Flatten}}engine.WithEmbeddedStructs(engine.EmbedFlattened){{end}})
+
+{{- if .StaticBody}}
+
+// Validate{{.Name}} validates x against {{.Name}}RuleSet with code generated from its rules,
+// without the validator; the result does not hold the validated data
+func Validate{{.Name}}(x {{.Type}}) contract.Result {
+	{{.StaticBody}}
+}
+{{- else}}
+
+// Validate{{.Name}} validates x against {{.Name}}RuleSet
+func Validate{{.Name}}(x {{with .Type}}{{.}}{{else}}map[string]any{{end}}) contract.Result {
+	return Validate{{.Name}}With({{.Validator}}, x)
+}
+{{- end}}
+
+// Validate{{.Name}}With validates x against {{.Name}}RuleSet with v
+func Validate{{.Name}}With(v *validator.Validator, x {{with .Type}}{{.}}{{else}}map[string]any{{end}}) contract.Result {
+	return v.ValidateRuleSet(x, {{.Name}}RuleSet)
+}
+{{- if and .Type .Selectors}}
+
+// Referencing the fields {{.Name}}RuleSet was generated from keeps it in sync with {{.Type}}
+func _() {
+	var x {{.Type}}
+{{- range .Fields}}{{if .Selector}}
+	_ = x.{{.Selector}}
+{{- end}}{{end}}
+}
+{{- end}}
+{{end}}{{.Preamble}}`))
@@ -0,0 +1,199 @@
+package gen_test
+
+import (
+	"errors"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+	"github.com/next-trace/scg-validator/gen"
+)
+
+const dtoSource = `package dto
+
+import "time"
+
+type Audit struct {
+	CreatedBy string ` + "`json:\"created_by\" validate:\"required\"`" + `
+}
+
+type Line struct {
+	SKU string ` + "`json:\"sku\" validate:\"required|alpha_num\"`" + `
+}
+
+type Order struct {
+	Audit
+	Email  string          ` + "`json:\"email\" validate:\"required|email\"`" + `
+	Lines  []Line          ` + "`json:\"lines\" validate:\"required\"`" + `
+	Ship   *Address        ` + "`json:\"ship\"`" + `
+	ByKind map[string]Line ` + "`json:\"by_kind\"`" + `
+	At     time.Time       ` + "`json:\"at\" validate:\"required\"`" + `
+	Secret string          ` + "`json:\"-\" validate:\"required\"`" + `
+	note   string          ` + "`validate:\"required\"`" + `
+}
+
+type Address struct {
+	PostalCode string ` + "`json:\"postal_code\" validate:\"required\"`" + `
+	Parent     *Address
+}
+`
+
+func loadPackage(t *testing.T) *gen.Package {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "dto.go"), []byte(dtoSource), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := gen.LoadPackage(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return pkg
+}
+
+func TestPackage_Struct(t *testing.T) {
+	pkg := loadPackage(t)
+	if pkg.Name != "dto" {
+		t.Fatalf("unexpected package name %q", pkg.Name)
+	}
+
+	schema, err := pkg.Struct("Order", engine.EmbedPrefixed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{
+		"Audit.created_by": "required",
+		"email":            "required|email",
+		"lines":            "required",
+		"lines.*.sku":      "required|alpha_num",
+		"ship.postal_code": "required",
+		"by_kind.*.sku":    "required|alpha_num",
+		"at":               "required",
+	}
+	if len(schema.RuleSet.Rules) != len(want) {
+		t.Fatalf("unexpected rules: %v", schema.RuleSet.Rules)
+	}
+	for field, rule := range want {
+		if schema.RuleSet.Rules[field] != rule {
+			t.Fatalf("expected %s to be %q, got %v", field, rule, schema.RuleSet.Rules)
+		}
+	}
+	if got := schema.Selectors["lines.*.sku"]; got != "Lines[0].SKU" {
+		t.Fatalf("unexpected selector %q", got)
+	}
+
+	flattened, err := pkg.Struct("Order", engine.EmbedFlattened)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if flattened.RuleSet.Rules["created_by"] != "required" || flattened.Selectors["created_by"] != "Audit.CreatedBy" {
+		t.Fatalf("expected the embedded rules to be promoted: %v", flattened.RuleSet.Rules)
+	}
+
+	if _, err := pkg.Struct("Missing", engine.EmbedPrefixed); err == nil {
+		t.Fatal("expected an error for an unknown type")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	schema, err := loadPackage(t).Struct("Order", engine.EmbedFlattened)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	set := contract.NewRuleSet()
+	set.Rules["email"] = "required|email"
+	set.Messages["required.email"] = "We need your email"
+	set.Attributes["email"] = "e-mail"
+	set.Profiles["staging"] = map[string]string{"website": "nullable|url"}
+
+	source, err := gen.Generate("dto", schema, gen.FromRuleSet("Signup", "", set))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "order_validator.go", source, 0); err != nil {
+		t.Fatalf("generated code does not parse: %v\n%s", err, source)
+	}
+
+	code := string(source)
+	for _, want := range []string{
+		gen.Header,
+		"OrderFieldLinesEachSku",
+		"func ValidateOrder(x Order) contract.Result",
+		"func ValidateOrderWith(v *validator.Validator, x Order) contract.Result",
+		"validator.New(engine.WithEmbeddedStructs(engine.EmbedFlattened))",
+		"_ = x.Audit.CreatedBy",
+		"_ = x.ByKind[\"\"].SKU",
+		"func ValidateSignup(x map[string]any) contract.Result",
+		`SignupFieldWebsite: "nullable|url"`,
+		`SignupFieldEmail: "e-mail"`,
+		`"required.email": "We need your email"`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Fatalf("expected generated code to contain %q:\n%s", want, code)
+		}
+	}
+}
+
+func TestGenerate_Static(t *testing.T) {
+	pkg := loadPackage(t)
+	line, err := pkg.Struct("Line", engine.EmbedPrefixed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	line.Static = true
+
+	source, err := gen.Generate("dto", line)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "line_validator.go", source, 0); err != nil {
+		t.Fatalf("generated code does not parse: %v\n%s", err, source)
+	}
+	code := string(source)
+	for _, want := range []string{
+		"func ValidateLine(x Line) contract.Result",
+		"func ValidateLineWith(v *validator.Validator, x Line) contract.Result",
+		"value := x.SKU",
+		"if !scgAlphaNum(value) {",
+		"func scgAlphaNum(s string) bool",
+		`"unicode"`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Fatalf("expected generated code to contain %q:\n%s", want, code)
+		}
+	}
+
+	// time.Time fields are not supported statically
+	order, err := pkg.Struct("Order", engine.EmbedPrefixed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	order.Static = true
+	_, err = gen.Generate("dto", order)
+	if !errors.Is(err, contract.ErrInvalidRule) || !strings.Contains(err.Error(), "field at") {
+		t.Fatalf("expected an error for the at field, got %v", err)
+	}
+
+	signup := gen.FromRuleSet("Signup", "Signup", contract.NewRuleSet())
+	signup.Static = true
+	if _, err := gen.Generate("dto", signup); err == nil {
+		t.Fatal("expected an error for a static schema without struct source")
+	}
+}
+
+func TestGenerate_InvalidInput(t *testing.T) {
+	if _, err := gen.Generate("dto"); err == nil {
+		t.Fatal("expected an error without schemas")
+	}
+	if _, err := gen.Generate("my-pkg", gen.FromRuleSet("Signup", "", contract.NewRuleSet())); err == nil {
+		t.Fatal("expected an error for an invalid package name")
+	}
+	if _, err := gen.Generate("dto", gen.FromRuleSet("sign up", "", contract.NewRuleSet())); err == nil {
+		t.Fatal("expected an error for an invalid schema name")
+	}
+}
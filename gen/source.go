@@ -0,0 +1,305 @@
+package gen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+)
+
+// Package is the parsed Go source of a package, read to generate validators of its struct types
+type Package struct {
+	Name  string
+	types map[string]*ast.StructType
+}
+
+// LoadPackage parses the non-test Go files of the package in dir
+func LoadPackage(dir string) (*Package, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &Package{types: make(map[string]*ast.StructType)}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		parsed, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		if pkg.Name == "" {
+			pkg.Name = parsed.Name.Name
+		}
+		for _, decl := range parsed.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+					pkg.types[typeSpec.Name.Name] = structType
+				}
+			}
+		}
+	}
+	if pkg.Name == "" {
+		return nil, fmt.Errorf("gen: no Go files in %s", dir)
+	}
+	return pkg, nil
+}
+
+// Struct creates a schema for the struct type typeName from its `validate` and `json` tags, keyed
+// like engine.StructRulesWith under mode. Fields of structs in the package are descended into,
+// those of slices and maps of them below a "*" wildcard ("lines.*.sku"). Structs of other packages
+// are treated as values.
+func (p *Package) Struct(typeName string, mode engine.EmbedMode) (*Schema, error) {
+	structType, ok := p.types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("gen: struct type %s not found in package %s", typeName, p.Name)
+	}
+
+	walker := newStructWalker(p, mode, make(map[*ast.StructType]bool))
+	walker.walk(structType, "", "", nil)
+
+	set := contract.NewRuleSet()
+	set.Rules = walker.rules
+	return &Schema{
+		Name:      typeName,
+		Type:      typeName,
+		RuleSet:   set,
+		Embedding: mode,
+		Selectors: walker.selectors,
+		access:    walker.access,
+	}, nil
+}
+
+// fieldAccess is how generated code reaches a field from the validated value: the steps through
+// its parents, the field's type and whether it is a struct of the package
+type fieldAccess struct {
+	steps    []accessStep
+	leaf     ast.Expr
+	isStruct bool
+}
+
+// accessStep selects a struct field, checks a pointer for nil or ranges over a slice or map.
+// segment is the data path segment of a field step, empty for flattened embedded structs.
+type accessStep struct {
+	field   string
+	segment string
+	nilable bool
+	each    bool
+	keyed   bool
+}
+
+// withStep returns a copy of steps with step appended
+func withStep(steps []accessStep, step accessStep) []accessStep {
+	return append(append(make([]accessStep, 0, len(steps)+1), steps...), step)
+}
+
+// structWalker collects the rules and selectors of a struct type and the structs nested in it
+type structWalker struct {
+	pkg       *Package
+	mode      engine.EmbedMode
+	visiting  map[*ast.StructType]bool
+	rules     map[string]string
+	selectors map[string]string
+	access    map[string]fieldAccess
+}
+
+func newStructWalker(pkg *Package, mode engine.EmbedMode, visiting map[*ast.StructType]bool) *structWalker {
+	return &structWalker{
+		pkg:       pkg,
+		mode:      mode,
+		visiting:  visiting,
+		rules:     make(map[string]string),
+		selectors: make(map[string]string),
+		access:    make(map[string]fieldAccess),
+	}
+}
+
+// astField is an exported struct field as engine's struct metadata sees it
+type astField struct {
+	name     string
+	goName   string
+	rules    string
+	expr     ast.Expr
+	embedded bool
+	named    bool
+	embed    engine.EmbedMode
+}
+
+// flattens mirrors the engine's handling of embedded structs under mode
+func (f astField) flattens(mode engine.EmbedMode) bool {
+	if !f.embedded {
+		return false
+	}
+	switch f.embed {
+	case engine.EmbedFlattened:
+		return true
+	case engine.EmbedPrefixed:
+		return false
+	default:
+		return mode == engine.EmbedFlattened && !f.named
+	}
+}
+
+// walk adds the rules of structType below the data path prefix and Go selector, reached by steps
+func (w *structWalker) walk(structType *ast.StructType, prefix, selector string, steps []accessStep) {
+	if w.visiting[structType] {
+		return
+	}
+	w.visiting[structType] = true
+	defer delete(w.visiting, structType)
+
+	fields := w.fields(structType)
+	for _, f := range fields {
+		if f.flattens(w.mode) {
+			continue
+		}
+		path := joinPath(prefix, f.name)
+		goPath := joinPath(selector, f.goName)
+		fieldSteps := withStep(steps, accessStep{field: f.goName, segment: f.name})
+		if f.rules != "" {
+			w.rules[path] = f.rules
+			w.selectors[path] = goPath
+			_, isStruct := w.structOf(f.expr)
+			w.access[path] = fieldAccess{steps: fieldSteps, leaf: f.expr, isStruct: isStruct}
+		}
+		w.nested(f.expr, path, goPath, fieldSteps)
+	}
+
+	for _, f := range fields {
+		nested, ok := w.structOf(f.expr)
+		if !f.flattens(w.mode) || !ok {
+			continue
+		}
+		embeddedSteps := withStep(steps, accessStep{field: f.goName})
+		if _, isPointer := f.expr.(*ast.StarExpr); isPointer {
+			embeddedSteps = withStep(embeddedSteps, accessStep{nilable: true})
+		}
+		promoted := newStructWalker(w.pkg, w.mode, w.visiting)
+		promoted.walk(nested, prefix, joinPath(selector, f.goName), embeddedSteps)
+		for path, rule := range promoted.rules {
+			if _, exists := w.rules[path]; !exists {
+				w.rules[path] = rule
+				w.selectors[path] = promoted.selectors[path]
+				w.access[path] = promoted.access[path]
+			}
+		}
+	}
+}
+
+// nested descends into the struct, slice or map of structs of type expr at path
+func (w *structWalker) nested(expr ast.Expr, path, selector string, steps []accessStep) {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		w.nested(t.X, path, selector, withStep(steps, accessStep{nilable: true}))
+	case *ast.ArrayType:
+		w.nested(t.Elt, path+".*", selector+"[0]", withStep(steps, accessStep{each: true}))
+	case *ast.MapType:
+		if key, ok := t.Key.(*ast.Ident); ok && key.Name == "string" {
+			w.nested(t.Value, path+".*", selector+`[""]`, withStep(steps, accessStep{each: true, keyed: true}))
+		}
+	default:
+		if structType, ok := w.structOf(expr); ok {
+			w.walk(structType, path, selector, steps)
+		}
+	}
+}
+
+// fields returns the exported fields of structType that are not skipped by their json tag
+func (w *structWalker) fields(structType *ast.StructType) []astField {
+	var fields []astField
+	for _, f := range structType.Fields.List {
+		var tag reflect.StructTag
+		if f.Tag != nil {
+			value, err := strconv.Unquote(f.Tag.Value)
+			if err == nil {
+				tag = reflect.StructTag(value)
+			}
+		}
+		jsonName, _, _ := strings.Cut(tag.Get("json"), ",")
+		if tag.Get("json") == "-" {
+			continue
+		}
+
+		names := make([]string, 0, len(f.Names))
+		for _, name := range f.Names {
+			names = append(names, name.Name)
+		}
+		embedded := len(names) == 0
+		if embedded {
+			names = append(names, typeName(f.Type))
+		}
+
+		for _, name := range names {
+			if !ast.IsExported(name) {
+				continue
+			}
+			_, isStruct := w.structOf(f.Type)
+			field := astField{
+				name:     name,
+				goName:   name,
+				rules:    tag.Get(engine.StructTag),
+				expr:     f.Type,
+				embedded: embedded && isStruct,
+				named:    jsonName != "",
+				embed:    engine.EmbedMode(tag.Get(engine.StructEmbedTag)),
+			}
+			if jsonName != "" {
+				field.name = jsonName
+			}
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// structOf returns the struct type expr names in the package, looking through pointers
+func (w *structWalker) structOf(expr ast.Expr) (*ast.StructType, bool) {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return w.structOf(t.X)
+	case *ast.StructType:
+		return t, true
+	case *ast.Ident:
+		structType, ok := w.pkg.types[t.Name]
+		return structType, ok
+	default:
+		return nil, false
+	}
+}
+
+// typeName returns the name of an embedded field's type ("Audit" for *Audit and pkg.Audit)
+func typeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return typeName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.IndexExpr:
+		return typeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	default:
+		return ""
+	}
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
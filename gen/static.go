@@ -0,0 +1,687 @@
+package gen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/message"
+	"github.com/next-trace/scg-validator/parser"
+	"github.com/next-trace/scg-validator/rules"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+// wildcardMarker stands for the concrete path when rendering the messages of wildcard fields
+const wildcardMarker = "\x00"
+
+// StaticRules are the rules static validators (Schema.Static) inline. Schemas using other rules,
+// negated ("!") or warning ("warn:") rules cannot be generated statically.
+var StaticRules = []string{
+	rules.RuleAlpha, rules.RuleAlphaNum, rules.RuleBail, rules.RuleBetween, rules.RuleEmail, rules.RuleIn,
+	rules.RuleMax, rules.RuleMin, rules.RuleNotIn, rules.RuleNullable, rules.RuleRegex, rules.RuleRequired,
+	rules.RuleSize,
+}
+
+// valueKind is the kind of a field's Go type as far as static rules are concerned
+type valueKind int
+
+const (
+	kindOther valueKind = iota
+	kindString
+	kindBool
+	kindInt
+	kindUint
+	kindFloat
+	kindSlice
+	kindArray
+	kindMap
+	kindStruct
+)
+
+// identKinds maps predeclared type names to their kind
+var identKinds = map[string]valueKind{
+	"string": kindString, "bool": kindBool,
+	"int": kindInt, "int8": kindInt, "int16": kindInt, "int32": kindInt, "int64": kindInt, "rune": kindInt,
+	"uint": kindUint, "uint8": kindUint, "uint16": kindUint, "uint32": kindUint, "uint64": kindUint,
+	"byte": kindUint, "uintptr": kindUint, "float32": kindFloat, "float64": kindFloat,
+}
+
+// staticHelpers are the functions generated once per file for the rules using them
+var staticHelpers = map[string]string{
+	"scgEmail": `// scgEmail reports whether s is a valid email address with a dotted domain
+func scgEmail(s string) bool {
+	if strings.TrimSpace(s) == "" {
+		return false
+	}
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Address != s {
+		return false
+	}
+	domain := s[strings.LastIndex(s, "@")+1:]
+	if domain == "" || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") ||
+		!strings.Contains(domain, ".") {
+		return false
+	}
+	_, ok := format.ToASCII(domain)
+	return ok
+}
+`,
+	"scgAlpha": `// scgAlpha reports whether s is a non-empty string of letters
+func scgAlpha(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsMark(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+`,
+	"scgAlphaNum": `// scgAlphaNum reports whether s is a non-empty string of letters and digits
+func scgAlphaNum(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+`,
+}
+
+// formatImport is the package of format.ToASCII, which scgEmail checks internationalized domains with
+const formatImport = modulePath + "rules/format"
+
+// staticHelperImports are the imports of each helper
+var staticHelperImports = map[string][]string{
+	"scgEmail":    {"net/mail", "strings", formatImport},
+	"scgAlpha":    {"unicode"},
+	"scgAlphaNum": {"unicode"},
+}
+
+// staticLeaf is a field's type: its kind, the Go type of scalars and whether it is a pointer
+type staticLeaf struct {
+	kind    valueKind
+	goType  string
+	pointer bool
+	expr    ast.Expr
+}
+
+// collection reports whether rules see the length of the field rather than its value
+func (l staticLeaf) collection() bool {
+	return l.kind == kindSlice || l.kind == kindArray || l.kind == kindMap
+}
+
+// staticCheck is the failure condition of one rule and the code recording its error
+type staticCheck struct {
+	cond      string
+	record    string
+	usesValue bool
+	usesNil   bool
+}
+
+// staticWriter writes the static validators of a file and collects what they need
+type staticWriter struct {
+	imports  map[string]bool
+	helpers  map[string]bool
+	patterns []string
+	registry contract.Registry
+	resolver *message.Resolver
+	schema   *Schema
+	out      strings.Builder
+	loops    int
+}
+
+func newStaticWriter() *staticWriter {
+	return &staticWriter{
+		imports:  make(map[string]bool),
+		helpers:  make(map[string]bool),
+		registry: rules.NewRuleRegistry(),
+	}
+}
+
+// body returns the statements of the static ValidateName function of schema
+func (w *staticWriter) body(schema *Schema) (string, error) {
+	if schema.Type == "" || schema.access == nil {
+		return "", fmt.Errorf("gen: static schema %s needs a struct type read with Package.Struct", schema.Name)
+	}
+	w.schema = schema
+	w.resolver = message.NewResolver()
+	for key, msg := range schema.RuleSet.Messages {
+		w.resolver.SetCustomMessage(key, msg)
+	}
+	for field, attribute := range schema.RuleSet.Attributes {
+		w.resolver.SetCustomAttribute(field, attribute)
+	}
+
+	w.out.Reset()
+	w.out.WriteString("result := contract.NewValidationErrors()\n")
+	for _, path := range sortedKeys(schema.RuleSet.Rules) {
+		if err := w.field(path, schema.RuleSet.Rules[path]); err != nil {
+			return "", fmt.Errorf("gen: static schema %s field %s: %w", schema.Name, path, err)
+		}
+	}
+	w.out.WriteString("return result")
+	return w.out.String(), nil
+}
+
+// field writes the checks of one field inside the loops and nil checks reaching it
+func (w *staticWriter) field(path, ruleString string) error {
+	access, ok := w.schema.access[path]
+	if !ok {
+		return fmt.Errorf("%w: not a field of %s", contract.ErrInvalidRule, w.schema.Type)
+	}
+	leaf := staticLeafOf(access)
+
+	// Steps up to the last wildcard are loops, the ones after it lead to the value
+	lastLoop := -1
+	for i, step := range access.steps {
+		if step.each {
+			lastLoop = i
+		}
+	}
+	loops, tail := access.steps[:lastLoop+1], access.steps[lastLoop+1:]
+	canNil := leaf.pointer || leaf.kind == kindSlice
+	for _, step := range tail {
+		canNil = canNil || step.nilable
+	}
+
+	nodes := parser.ParseField(ruleString)
+	nullable := false
+	for _, node := range nodes {
+		nullable = nullable || node.Name == rules.RuleNullable
+	}
+	var checks []staticCheck
+	usesValue, usesNil := false, false
+	for _, node := range nodes {
+		if node.Name == rules.RuleNullable {
+			continue
+		}
+		check, err := w.check(node, leaf, path, canNil)
+		if err != nil {
+			return err
+		}
+		if nullable && canNil && node.Name != rules.RuleRequired {
+			check.cond = "!isNil && (" + check.cond + ")"
+			check.usesNil = true
+		}
+		usesValue, usesNil = usesValue || check.usesValue, usesNil || check.usesNil
+		checks = append(checks, check)
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(&w.out, "\n// %s\n", path)
+	w.loops = 0
+	expr, closers := w.openLoops(loops)
+	if closers == "" {
+		w.out.WriteString("{\n")
+		closers = "}\n"
+	}
+	fmt.Fprintf(&w.out, "field := %s\n", fieldPathExpr(access.steps, w.imports))
+	w.declareValue(expr, tail, leaf, usesValue, usesNil)
+	for i, check := range checks {
+		if i > 0 && nodes[0].Bail {
+			w.out.WriteString(" else ")
+		} else if i > 0 {
+			w.out.WriteString("\n")
+		}
+		fmt.Fprintf(&w.out, "if %s {\n%s\n}", check.cond, check.record)
+	}
+	w.out.WriteString("\n" + closers)
+	return nil
+}
+
+// openLoops writes the nil checks and range loops of steps, returning the expression of the
+// innermost value and the code closing the blocks
+func (w *staticWriter) openLoops(steps []accessStep) (string, string) {
+	expr, closers := "x", ""
+	for _, step := range steps {
+		switch {
+		case step.field != "":
+			expr += "." + step.field
+		case step.nilable:
+			fmt.Fprintf(&w.out, "if %s != nil {\n", expr)
+			closers += "}\n"
+		case step.each:
+			w.loops++
+			key, item := "k"+strconv.Itoa(w.loops), "v"+strconv.Itoa(w.loops)
+			fmt.Fprintf(&w.out, "for %s, %s := range %s {\n", key, item, expr)
+			closers += "}\n"
+			expr = item
+		}
+	}
+	return expr, closers
+}
+
+// fieldPathExpr returns the Go expression of the concrete data path reached by steps, with the
+// loop keys numbered like openLoops does
+func fieldPathExpr(steps []accessStep, imports map[string]bool) string {
+	var parts []string
+	var literal strings.Builder
+	loops, first := 0, true
+	for _, step := range steps {
+		if step.segment == "" && !step.each {
+			continue
+		}
+		if !first {
+			literal.WriteString(".")
+		}
+		first = false
+		if step.segment != "" {
+			literal.WriteString(step.segment)
+			continue
+		}
+		if literal.Len() > 0 {
+			parts = append(parts, strconv.Quote(literal.String()))
+			literal.Reset()
+		}
+		loops++
+		key := "k" + strconv.Itoa(loops)
+		if !step.keyed {
+			imports["strconv"] = true
+			key = "strconv.Itoa(" + key + ")"
+		}
+		parts = append(parts, key)
+	}
+	if literal.Len() > 0 || len(parts) == 0 {
+		parts = append(parts, strconv.Quote(literal.String()))
+	}
+	return strings.Join(parts, " + ")
+}
+
+// declareValue declares value (the length for collections) and isNil as far as the checks use
+// them, following the pointers of the steps after the last loop
+func (w *staticWriter) declareValue(expr string, tail []accessStep, leaf staticLeaf, usesValue, usesNil bool) {
+	name, goType := "value", leaf.goType
+	if leaf.collection() {
+		name, goType = "length", "int"
+	}
+	measure := func(e string) string {
+		if leaf.collection() {
+			return "len(" + e + ")"
+		}
+		return e
+	}
+
+	direct := !leaf.pointer
+	for _, step := range tail {
+		direct = direct && !step.nilable
+	}
+	if direct {
+		for _, step := range tail {
+			expr += "." + step.field
+		}
+		switch {
+		case usesValue && usesNil:
+			fmt.Fprintf(&w.out, "%s, isNil := %s, %s == nil\n", name, measure(expr), expr)
+		case usesValue:
+			fmt.Fprintf(&w.out, "%s := %s\n", name, measure(expr))
+		case usesNil:
+			fmt.Fprintf(&w.out, "isNil := %s == nil\n", expr)
+		}
+		return
+	}
+
+	if usesValue {
+		fmt.Fprintf(&w.out, "var %s %s\n", name, goType)
+	}
+	if usesNil {
+		w.out.WriteString("isNil := true\n")
+	}
+	closers := ""
+	for _, step := range tail {
+		if step.nilable {
+			fmt.Fprintf(&w.out, "if %s != nil {\n", expr)
+			closers += "}\n"
+		} else {
+			expr += "." + step.field
+		}
+	}
+
+	var lhs, rhs []string
+	if usesValue {
+		lhs = append(lhs, name)
+	}
+	if usesNil {
+		lhs = append(lhs, "isNil")
+	}
+	switch {
+	case leaf.pointer:
+		fmt.Fprintf(&w.out, "if p := %s; p != nil {\n", expr)
+		closers += "}\n"
+		expr = "*p"
+		rhs = append(rhs, measure(expr), "false")
+	case leaf.kind == kindSlice:
+		rhs = append(rhs, measure(expr), expr+" == nil")
+	default:
+		rhs = append(rhs, measure(expr), "false")
+	}
+	if !usesValue {
+		rhs = rhs[1:]
+	}
+	if !usesNil {
+		rhs = rhs[:1]
+	}
+	if len(lhs) > 0 {
+		fmt.Fprintf(&w.out, "%s = %s\n", strings.Join(lhs, ", "), strings.Join(rhs, ", "))
+	}
+	w.out.WriteString(closers)
+}
+
+// check returns the failure condition of node on a field of type leaf
+func (w *staticWriter) check(node parser.RuleNode, leaf staticLeaf, path string, canNil bool) (staticCheck, error) {
+	if node.Negated || node.Warning {
+		return staticCheck{}, fmt.Errorf("%w: %s cannot be generated statically", contract.ErrInvalidRule, node.Raw)
+	}
+	creator, ok := w.registry.Get(node.Name)
+	if !ok {
+		return staticCheck{}, fmt.Errorf("%w: unknown rule %s", contract.ErrRuleNotFound, node.Name)
+	}
+	rule, err := creator(node.Params)
+	if err != nil {
+		return staticCheck{}, fmt.Errorf("%w: %s: %v", contract.ErrInvalidRule, node.Name, err)
+	}
+
+	check := staticCheck{usesValue: true}
+	unsupported := fmt.Errorf("%w: rule %s is not supported statically on %s fields",
+		contract.ErrInvalidRule, node.Name, types.ExprString(leaf.expr))
+	orNil := func(cond string) string {
+		if !canNil {
+			return cond
+		}
+		check.usesNil = true
+		return "isNil || " + cond
+	}
+
+	switch node.Name {
+	case rules.RuleRequired:
+		switch leaf.kind {
+		case kindString:
+			check.cond = `value == ""`
+		case kindBool:
+			check.cond = "!value"
+		case kindInt, kindUint, kindFloat:
+			check.cond = "value == 0"
+		case kindSlice, kindArray, kindMap:
+			check.cond = "length == 0"
+		case kindStruct:
+			if !canNil {
+				return staticCheck{}, unsupported
+			}
+			check.cond, check.usesValue, check.usesNil = "isNil", false, true
+		default:
+			return staticCheck{}, unsupported
+		}
+	case rules.RuleMin, rules.RuleMax, rules.RuleSize, rules.RuleBetween:
+		size, ok := w.sizeExpr(leaf)
+		if !ok {
+			return staticCheck{}, unsupported
+		}
+		check.cond, err = sizeCondition(node, leaf, size)
+		if err != nil {
+			return staticCheck{}, err
+		}
+	case rules.RuleEmail, rules.RuleAlpha, rules.RuleAlphaNum:
+		if leaf.kind != kindString || (node.Name == rules.RuleEmail && !rfcEmailParams(node.Params)) {
+			return staticCheck{}, unsupported
+		}
+		helper := map[string]string{
+			rules.RuleEmail: "scgEmail", rules.RuleAlpha: "scgAlpha", rules.RuleAlphaNum: "scgAlphaNum",
+		}[node.Name]
+		w.helpers[helper] = true
+		check.cond = "!" + helper + "(value)"
+	case rules.RuleRegex:
+		if leaf.kind != kindString {
+			return staticCheck{}, unsupported
+		}
+		w.imports["regexp"] = true
+		w.patterns = append(w.patterns, strings.Join(node.Params, ","))
+		check.cond = orNil(fmt.Sprintf("!scgPattern%d.MatchString(value)", len(w.patterns)-1))
+	case rules.RuleIn, rules.RuleNotIn:
+		matches, ok := matchCondition(node.Params, leaf)
+		if !ok {
+			return staticCheck{}, unsupported
+		}
+		if node.Name == rules.RuleIn {
+			check.cond = orNil(negate(matches))
+		} else if canNil {
+			check.cond, check.usesNil = "!isNil && ("+matches+")", true
+		} else {
+			check.cond = matches
+		}
+	default:
+		return staticCheck{}, unsupported
+	}
+
+	check.record = fmt.Sprintf("result.AddRuleError(field, contract.ParsedRule{Name: %s, Parameters: %s}, %s)",
+		strconv.Quote(node.Name), stringSlice(node.Params), w.messageExpr(node, rule, path))
+	return check, nil
+}
+
+// rfcEmailParams reports whether email rule params only select the default rfc mode, the one
+// scgEmail implements. punycode only changes the validated data, which static validators do not hold.
+func rfcEmailParams(params []string) bool {
+	for _, param := range params {
+		switch strings.ToLower(strings.TrimSpace(param)) {
+		case format.EmailModeRFC, format.PunycodeParam, "":
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// messageExpr renders the message of a failed rule at generation time. Messages of wildcard
+// fields are rendered for a marker and built around the concrete path by the generated code.
+func (w *staticWriter) messageExpr(node parser.RuleNode, rule contract.Rule, path string) string {
+	field := path
+	if strings.Contains(path, "*") {
+		field = wildcardMarker
+	}
+	fallback := ""
+	if provider, ok := rule.(contract.MessageProvider); ok {
+		fallback = provider.Message()
+	}
+	msg := w.resolver.ResolveRule(node.Name, contract.NewValidationContext(field, nil, node.Params, nil), fallback)
+	if field != wildcardMarker {
+		return strconv.Quote(msg)
+	}
+
+	var parts []string
+	for i, part := range strings.Split(msg, wildcardMarker) {
+		if i > 0 {
+			parts = append(parts, "field")
+		}
+		if part != "" {
+			parts = append(parts, strconv.Quote(part))
+		}
+	}
+	if len(parts) == 0 {
+		return `""`
+	}
+	return strings.Join(parts, " + ")
+}
+
+// negate returns the negation of a condition, simplifying single terms
+func negate(cond string) string {
+	switch {
+	case strings.Contains(cond, " "):
+		return "!(" + cond + ")"
+	case cond == "false":
+		return "true"
+	case strings.HasPrefix(cond, "!"):
+		return cond[1:]
+	default:
+		return "!" + cond
+	}
+}
+
+// sizeExpr returns the expression size rules compare: the rune count of strings, the length of
+// collections and numbers themselves
+func (w *staticWriter) sizeExpr(leaf staticLeaf) (string, bool) {
+	switch leaf.kind {
+	case kindString:
+		w.imports["unicode/utf8"] = true
+		return "utf8.RuneCountInString(value)", true
+	case kindSlice, kindArray, kindMap:
+		return "length", true
+	case kindInt, kindUint, kindFloat:
+		return "value", true
+	default:
+		return "", false
+	}
+}
+
+// sizeCondition returns the failure condition of min, max, size and between on size
+func sizeCondition(node parser.RuleNode, leaf staticLeaf, size string) (string, error) {
+	bounds := make([]string, 0, 2)
+	for _, param := range node.Params {
+		bound, err := compareBound(leaf, size, param)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s: %v", contract.ErrInvalidRule, node.Name, err)
+		}
+		bounds = append(bounds, bound)
+	}
+	lhs, bound := comparedSize(leaf, size, bounds[0])
+	switch node.Name {
+	case rules.RuleMin:
+		return lhs + " < " + bound, nil
+	case rules.RuleMax:
+		return lhs + " > " + bound, nil
+	case rules.RuleSize:
+		return lhs + " != " + bound, nil
+	default:
+		if len(bounds) < 2 {
+			return "", fmt.Errorf("%w: between needs two bounds", contract.ErrInvalidRule)
+		}
+		upperLHS, upper := comparedSize(leaf, size, bounds[1])
+		return lhs + " < " + bound + " || " + upperLHS + " > " + upper, nil
+	}
+}
+
+// compareBound returns the Go literal of a size bound: an integer literal when the size and the
+// bound are integral, a float literal otherwise
+func compareBound(leaf staticLeaf, size, param string) (string, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(param), 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return "", fmt.Errorf("bound %q is not a number", param)
+	}
+	if leaf.kind != kindFloat && f == math.Trunc(f) && math.Abs(f) < 1<<53 && (leaf.kind != kindUint || f >= 0) {
+		return strconv.FormatInt(int64(f), 10), nil
+	}
+	bound := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(bound, ".e") {
+		bound += ".0"
+	}
+	return bound, nil
+}
+
+// comparedSize converts size to the type of bound: int64 or uint64 for integer bounds of
+// integer fields (avoiding constant overflow on small types), float64 for float bounds
+func comparedSize(leaf staticLeaf, size, bound string) (string, string) {
+	isFloat := strings.ContainsAny(bound, ".e")
+	switch {
+	case leaf.kind == kindFloat:
+		return size, bound
+	case isFloat:
+		return "float64(" + size + ")", bound
+	case leaf.kind == kindInt:
+		return "int64(" + size + ")", bound
+	case leaf.kind == kindUint:
+		return "uint64(" + size + ")", bound
+	default:
+		return size, bound
+	}
+}
+
+// matchCondition returns the condition of a value equal to one of params, compared like the in
+// rule compares them (by their %v text)
+func matchCondition(params []string, leaf staticLeaf) (string, bool) {
+	var terms []string
+	for _, param := range params {
+		switch leaf.kind {
+		case kindString:
+			terms = append(terms, "value == "+strconv.Quote(param))
+		case kindBool:
+			if param == "true" {
+				terms = append(terms, "value")
+			} else if param == "false" {
+				terms = append(terms, "!value")
+			}
+		case kindInt:
+			if n, err := strconv.ParseInt(param, 10, 64); err == nil && strconv.FormatInt(n, 10) == param {
+				terms = append(terms, "int64(value) == "+param)
+			}
+		case kindUint:
+			if n, err := strconv.ParseUint(param, 10, 64); err == nil && strconv.FormatUint(n, 10) == param {
+				terms = append(terms, "uint64(value) == "+param)
+			}
+		default:
+			return "", false
+		}
+	}
+	if len(terms) == 0 {
+		return "false", true
+	}
+	return strings.Join(terms, " || "), true
+}
+
+// staticLeafOf returns the static type of the field reached by access
+func staticLeafOf(access fieldAccess) staticLeaf {
+	expr := access.leaf
+	leaf := staticLeaf{expr: expr}
+	if star, ok := expr.(*ast.StarExpr); ok {
+		leaf.pointer = true
+		expr = star.X
+	}
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if kind, ok := identKinds[t.Name]; ok {
+			leaf.kind, leaf.goType = kind, t.Name
+		} else if access.isStruct {
+			leaf.kind = kindStruct
+		}
+	case *ast.StructType:
+		leaf.kind = kindStruct
+	case *ast.ArrayType:
+		leaf.kind = kindArray
+		if t.Len == nil {
+			leaf.kind = kindSlice
+		}
+	case *ast.MapType:
+		leaf.kind = kindMap
+	}
+	return leaf
+}
+
+// stringSlice renders values as a []string literal, nil when empty
+func stringSlice(values []string) string {
+	if len(values) == 0 {
+		return "nil"
+	}
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+// preamble returns the package-level patterns and helpers the written validators use
+func (w *staticWriter) preamble() string {
+	var out strings.Builder
+	for i, pattern := range w.patterns {
+		fmt.Fprintf(&out, "\nvar scgPattern%d = regexp.MustCompile(%s)\n", i, strconv.Quote(pattern))
+	}
+	for _, helper := range sortedKeys(w.helpers) {
+		for _, path := range staticHelperImports[helper] {
+			w.imports[path] = true
+		}
+		out.WriteString("\n" + staticHelpers[helper])
+	}
+	return out.String()
+}
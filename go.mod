@@ -5,4 +5,5 @@ go 1.25.0
 require (
 	github.com/google/uuid v1.6.0
 	golang.org/x/text v0.28.0
+	gopkg.in/yaml.v3 v3.0.1
 )
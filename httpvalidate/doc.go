@@ -0,0 +1,14 @@
+// Package httpvalidate validates the query parameters of HTTP requests against a rule set and
+// hands the validated, typed values to the handler.
+//
+//	values, err := httpvalidate.Query(r, map[string]string{
+//		"page":   "integer|min:1",
+//		"limit":  "integer|between:1,100",
+//		"tags.*": "alpha_dash",
+//	})
+//	if err != nil {
+//		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+//		return
+//	}
+//	page, tags := values.Int("page"), values.Strings("tags")
+package httpvalidate
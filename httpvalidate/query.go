@@ -0,0 +1,188 @@
+package httpvalidate
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/next-trace/scg-validator/parser"
+	"github.com/next-trace/scg-validator/rules"
+	"github.com/next-trace/scg-validator/validator"
+)
+
+// arraySuffix marks array parameters in the PHP and Rails style ("tags[]=a&tags[]=b")
+const arraySuffix = "[]"
+
+var (
+	defaultValidator     *validator.Validator
+	defaultValidatorOnce sync.Once
+)
+
+// getDefaultValidator returns the validator used without WithValidator, creating it on first use
+func getDefaultValidator() *validator.Validator {
+	defaultValidatorOnce.Do(func() {
+		defaultValidator = validator.New()
+	})
+	return defaultValidator
+}
+
+// Option configures Query
+type Option func(*config)
+
+type config struct {
+	validator *validator.Validator
+}
+
+// WithValidator validates with v, e.g. one with custom rules or messages, instead of a default
+// validator.New()
+func WithValidator(v *validator.Validator) Option {
+	return func(c *config) {
+		c.validator = v
+	}
+}
+
+// Values are the validated query parameters of a request. Parameters are typed by their rules:
+// int for integer, float64 for numeric, bool for boolean and string otherwise. Array parameters
+// are []any of such values.
+type Values map[string]any
+
+// String returns the string parameter name, or "" if it is absent or of another type
+func (v Values) String(name string) string {
+	s, _ := v[name].(string)
+	return s
+}
+
+// Int returns the integer parameter name, or 0 if it is absent or of another type
+func (v Values) Int(name string) int {
+	i, _ := v[name].(int)
+	return i
+}
+
+// Float returns the numeric parameter name, or 0 if it is absent or of another type
+func (v Values) Float(name string) float64 {
+	f, _ := v[name].(float64)
+	return f
+}
+
+// Bool returns the boolean parameter name, or false if it is absent or of another type
+func (v Values) Bool(name string) bool {
+	b, _ := v[name].(bool)
+	return b
+}
+
+// Strings returns the string elements of the array parameter name
+func (v Values) Strings(name string) []string {
+	items, _ := v[name].([]any)
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// Ints returns the integer elements of the array parameter name
+func (v Values) Ints(name string) []int {
+	items, _ := v[name].([]any)
+	values := make([]int, 0, len(items))
+	for _, item := range items {
+		if i, ok := item.(int); ok {
+			values = append(values, i)
+		}
+	}
+	return values
+}
+
+// Query validates the query parameters of r against rules and returns those with rules, typed.
+// A parameter is an array when its rules have a wildcard key ("tags.*") or when it is sent with
+// the "[]" suffix; otherwise its first value is used. Values whose
+// rules include integer, numeric or boolean are converted first, so that min, max and between
+// compare page and limit values as numbers rather than string lengths. Values that do not convert
+// stay strings and fail those rules. The error is the validation error of validator.Validate.
+func Query(r *http.Request, rules map[string]string, options ...Option) (Values, error) {
+	cfg := config{}
+	for _, option := range options {
+		option(&cfg)
+	}
+	if cfg.validator == nil {
+		cfg.validator = getDefaultValidator()
+	}
+
+	query := r.URL.Query()
+	values := make(Values)
+	for _, name := range parameterNames(rules) {
+		itemRules, isArray := rules[name+".*"]
+		if isArray || query.Has(name+arraySuffix) {
+			if items := arrayValues(query, name); items != nil {
+				converted := make([]any, len(items))
+				for i, item := range items {
+					converted[i] = convert(item, itemRules)
+				}
+				values[name] = converted
+			}
+			continue
+		}
+		if query.Has(name) {
+			values[name] = convert(query.Get(name), rules[name])
+		}
+	}
+
+	if err := cfg.validator.Validate(map[string]any(values), rules); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// parameterNames returns the top-level parameter names of the rule keys ("tags" for "tags.*")
+func parameterNames(rules map[string]string) []string {
+	seen := make(map[string]bool, len(rules))
+	var names []string
+	for key := range rules {
+		name, _, _ := strings.Cut(key, ".")
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// arrayValues returns the values of name and of name with the "[]" suffix
+func arrayValues(query url.Values, name string) []string {
+	return append(append([]string(nil), query[name]...), query[name+arraySuffix]...)
+}
+
+// hasRule reports whether the rule string has the rule name
+func hasRule(ruleString, name string) bool {
+	for _, rule := range parser.ParseRules(ruleString) {
+		if rule.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// convert types a value by the integer, numeric or boolean rule of its rule string
+func convert(value, ruleString string) any {
+	switch {
+	case hasRule(ruleString, rules.RuleInteger):
+		if i, err := strconv.Atoi(value); err == nil {
+			return i
+		}
+	case hasRule(ruleString, rules.RuleNumeric):
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	case hasRule(ruleString, rules.RuleBoolean):
+		switch strings.ToLower(value) {
+		case "true", "1", "yes", "on":
+			return true
+		case "false", "0", "no", "off":
+			return false
+		}
+	}
+	return value
+}
@@ -0,0 +1,85 @@
+package httpvalidate_test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/httpvalidate"
+	"github.com/next-trace/scg-validator/validator"
+)
+
+var listingRules = map[string]string{
+	"page":     "integer|min:1",
+	"limit":    "integer|between:1,100",
+	"price":    "numeric",
+	"archived": "boolean",
+	"q":        "max:20",
+	"tags.*":   "alpha_dash",
+	"ids.*":    "integer",
+}
+
+func TestQuery_ReturnsTypedValues(t *testing.T) {
+	r := httptest.NewRequest("GET",
+		"/items?page=2&limit=50&price=9.5&archived=yes&q=shoes&tags=red&tags=sale&ids[]=3&ids[]=7&other=x", nil)
+
+	values, err := httpvalidate.Query(r, listingRules)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if values.Int("page") != 2 || values.Int("limit") != 50 || values.Float("price") != 9.5 ||
+		!values.Bool("archived") || values.String("q") != "shoes" {
+		t.Fatalf("unexpected values: %v", values)
+	}
+	if got := values.Strings("tags"); !reflect.DeepEqual(got, []string{"red", "sale"}) {
+		t.Fatalf("unexpected tags: %v", got)
+	}
+	if got := values.Ints("ids"); !reflect.DeepEqual(got, []int{3, 7}) {
+		t.Fatalf("unexpected ids: %v", got)
+	}
+	if _, ok := values["other"]; ok {
+		t.Fatal("expected parameters without rules to be left out")
+	}
+}
+
+func TestQuery_ComparesNumbersAsNumbers(t *testing.T) {
+	// "150" is 3 characters long but above the limit of 100
+	r := httptest.NewRequest("GET", "/items?page=0&limit=150", nil)
+
+	_, err := httpvalidate.Query(r, listingRules)
+	var validationErrors *contract.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+	if !validationErrors.HasFieldError("page") || !validationErrors.HasFieldError("limit") {
+		t.Fatalf("unexpected errors: %v", validationErrors.Errors())
+	}
+}
+
+func TestQuery_RejectsUnconvertibleValues(t *testing.T) {
+	r := httptest.NewRequest("GET", "/items?page=two&ids=1&ids=x&tags[]=a%20b", nil)
+
+	_, err := httpvalidate.Query(r, listingRules, httpvalidate.WithValidator(validator.New()))
+	var validationErrors *contract.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+	if !validationErrors.HasFieldError("page") || !validationErrors.HasFieldError("ids.1") ||
+		!validationErrors.HasFieldError("tags.0") {
+		t.Fatalf("unexpected errors: %v", validationErrors.Errors())
+	}
+}
+
+func TestQuery_AbsentParameters(t *testing.T) {
+	r := httptest.NewRequest("GET", "/items", nil)
+
+	values, err := httpvalidate.Query(r, map[string]string{"page": "integer", "limit": "required|integer"})
+	if err == nil {
+		t.Fatal("expected the required limit to fail")
+	}
+	if values != nil {
+		t.Fatalf("expected no values, got %v", values)
+	}
+}
@@ -0,0 +1,154 @@
+package message
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formatter renders message parameters for the resolver's active locale: the numbers of size
+// rules (between, min, max, ...), the dates of date rules (after, before, ...) and the :values
+// list. Embed LocaleFormatter to override a single method.
+type Formatter interface {
+	FormatNumber(locale string, n float64) string
+	FormatDate(locale string, t time.Time) string
+	FormatList(locale string, values []string) string
+}
+
+// numberParamRules are the rules whose numeric parameters are formatted as numbers
+var numberParamRules = map[string]bool{
+	"between": true, "min": true, "max": true, "size": true,
+	"gt": true, "gte": true, "lt": true, "lte": true, "multiple_of": true,
+}
+
+// dateParamRules are the rules whose date parameters are formatted as dates
+var dateParamRules = map[string]bool{
+	"after": true, "after_or_equal": true, "before": true, "before_or_equal": true, "date_equals": true,
+}
+
+// dateParamLayouts are the layouts date parameters are recognized in
+var dateParamLayouts = []string{time.DateOnly, time.RFC3339, time.DateTime}
+
+// localeConventions holds the separators and layouts of a locale
+type localeConventions struct {
+	decimal     string
+	group       string
+	dateLayout  string
+	conjunction string
+}
+
+// localeConventionsByTag maps locale tags (or their language part) to their conventions
+var localeConventionsByTag = map[string]localeConventions{
+	"en":    {decimal: ".", group: ",", dateLayout: "01/02/2006", conjunction: "and"},
+	"en-gb": {decimal: ".", group: ",", dateLayout: "02/01/2006", conjunction: "and"},
+	"de":    {decimal: ",", group: ".", dateLayout: "02.01.2006", conjunction: "und"},
+	"de-ch": {decimal: ".", group: "’", dateLayout: "02.01.2006", conjunction: "und"},
+	"fr":    {decimal: ",", group: "\u202f", dateLayout: "02/01/2006", conjunction: "et"},
+	"es":    {decimal: ",", group: ".", dateLayout: "02/01/2006", conjunction: "y"},
+	"it":    {decimal: ",", group: ".", dateLayout: "02/01/2006", conjunction: "e"},
+	"nl":    {decimal: ",", group: ".", dateLayout: "02-01-2006", conjunction: "en"},
+	"pt":    {decimal: ",", group: ".", dateLayout: "02/01/2006", conjunction: "e"},
+}
+
+// LocaleFormatter is the built-in Formatter. It knows the number separators, date order and
+// list conjunction of common locales and falls back to English for the others.
+type LocaleFormatter struct{}
+
+// conventions returns the conventions of locale along its BCP 47 fallback chain
+func (LocaleFormatter) conventions(locale string) localeConventions {
+	for _, tag := range LocaleChain(locale) {
+		if conventions, ok := localeConventionsByTag[tag]; ok {
+			return conventions
+		}
+	}
+	return localeConventionsByTag["en"]
+}
+
+// FormatNumber renders n with the locale's decimal and grouping separators ("1.234,5" in de)
+func (f LocaleFormatter) FormatNumber(locale string, n float64) string {
+	conventions := f.conventions(locale)
+	digits := strconv.FormatFloat(math.Abs(n), 'f', -1, 64)
+	integer, fraction, hasFraction := strings.Cut(digits, ".")
+
+	var out strings.Builder
+	if n < 0 {
+		out.WriteByte('-')
+	}
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			out.WriteString(conventions.group)
+		}
+		out.WriteRune(digit)
+	}
+	if hasFraction {
+		out.WriteString(conventions.decimal)
+		out.WriteString(fraction)
+	}
+	return out.String()
+}
+
+// FormatDate renders t in the locale's date order ("31.12.2024" in de)
+func (f LocaleFormatter) FormatDate(locale string, t time.Time) string {
+	return t.Format(f.conventions(locale).dateLayout)
+}
+
+// FormatList joins values with commas and the locale's conjunction ("a, b und c" in de)
+func (f LocaleFormatter) FormatList(locale string, values []string) string {
+	if len(values) < 2 {
+		return strings.Join(values, "")
+	}
+	last := len(values) - 1
+	return strings.Join(values[:last], ", ") + " " + f.conventions(locale).conjunction + " " + values[last]
+}
+
+// SetFormatter replaces the formatter used to render parameters while a locale is set (see
+// SetLocale). Without a locale, parameters are rendered as given and :values is comma-separated.
+func (r *Resolver) SetFormatter(formatter Formatter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.formatter = formatter
+}
+
+// displayParams returns the parameters of rule formatted for the active locale
+func (r *Resolver) displayParams(rule string, parameters []string) []string {
+	if len(r.localeChain) == 0 || r.formatter == nil {
+		return parameters
+	}
+	locale := r.localeChain[0]
+	display := make([]string, len(parameters))
+	for i, param := range parameters {
+		display[i] = r.formatParam(rule, locale, param)
+	}
+	return display
+}
+
+// formatParam formats one parameter as a number or date when rule takes one
+func (r *Resolver) formatParam(rule, locale, param string) string {
+	if numberParamRules[rule] {
+		if n, err := strconv.ParseFloat(param, 64); err == nil {
+			return r.formatter.FormatNumber(locale, n)
+		}
+	}
+	if dateParamRules[rule] {
+		for _, layout := range dateParamLayouts {
+			if t, err := time.Parse(layout, param); err == nil {
+				return r.formatter.FormatDate(locale, t)
+			}
+		}
+	}
+	return param
+}
+
+// valuesList renders the :values placeholder. Messages that name another field through :other
+// list the parameters after it.
+func (r *Resolver) valuesList(message string, display []string) string {
+	values := display
+	if strings.Contains(message, ":other") && len(values) > 0 {
+		values = values[1:]
+	}
+	if len(r.localeChain) == 0 || r.formatter == nil {
+		return strings.Join(values, ", ")
+	}
+	return r.formatter.FormatList(r.localeChain[0], values)
+}
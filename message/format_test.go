@@ -0,0 +1,89 @@
+package message
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLocaleFormatter(t *testing.T) {
+	f := LocaleFormatter{}
+	date := time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale string
+		number string
+		date   string
+		list   string
+	}{
+		{"en", "-1,234,567.5", "12/31/2024", "a, b and c"},
+		{"en-GB", "-1,234,567.5", "31/12/2024", "a, b and c"},
+		{"de-AT", "-1.234.567,5", "31.12.2024", "a, b und c"},
+		{"fr", "-1\u202f234\u202f567,5", "31/12/2024", "a, b et c"},
+		{"xx", "-1,234,567.5", "12/31/2024", "a, b and c"},
+	}
+	for _, tt := range tests {
+		if got := f.FormatNumber(tt.locale, -1234567.5); got != tt.number {
+			t.Errorf("%s number: got %q, want %q", tt.locale, got, tt.number)
+		}
+		if got := f.FormatDate(tt.locale, date); got != tt.date {
+			t.Errorf("%s date: got %q, want %q", tt.locale, got, tt.date)
+		}
+		if got := f.FormatList(tt.locale, []string{"a", "b", "c"}); got != tt.list {
+			t.Errorf("%s list: got %q, want %q", tt.locale, got, tt.list)
+		}
+	}
+}
+
+func TestResolver_LocalizedParams(t *testing.T) {
+	r := NewResolver()
+	r.SetCustomMessage("in", "The :attribute must be one of :values")
+	r.SetCustomMessage("prohibited_unless", "The :attribute is prohibited unless :other is :values")
+
+	if msg := r.Resolve("between", "age", []string{"1000", "2500.5"}); msg != "The age must be between 1000 and 2500.5" {
+		t.Fatalf("expected raw parameters without a locale, got %q", msg)
+	}
+	if msg := r.Resolve("in", "state", []string{"a", "b"}); msg != "The state must be one of a, b" {
+		t.Fatalf("expected comma-separated values without a locale, got %q", msg)
+	}
+
+	r.SetLocale("de")
+	tests := []struct {
+		rule   string
+		params []string
+		want   string
+	}{
+		{"between", []string{"1000", "2500.5"}, "The age must be between 1.000 and 2.500,5"},
+		{"after", []string{"2024-12-31"}, "The age must be a date after 31.12.2024"},
+		{"after", []string{"tomorrow"}, "The age must be a date after tomorrow"},
+		{"in", []string{"a", "b", "c"}, "The age must be one of a, b und c"},
+		{"date_format", []string{"2006-01-02"}, "The age does not match the format 2006-01-02"},
+		{"prohibited_unless", []string{"type", "a", "b"}, "The age is prohibited unless type is a und b"},
+	}
+	for _, tt := range tests {
+		if msg := r.Clone().Resolve(tt.rule, "age", tt.params); msg != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.rule, msg, tt.want)
+		}
+	}
+}
+
+type upperListFormatter struct {
+	LocaleFormatter
+}
+
+func (upperListFormatter) FormatList(_ string, values []string) string {
+	return "[" + values[0] + "...]"
+}
+
+func TestResolver_SetFormatter(t *testing.T) {
+	r := NewResolver()
+	r.SetLocale("de")
+	r.SetFormatter(upperListFormatter{})
+	r.SetCustomMessage("in", ":attribute: :values (:param0)")
+
+	if msg := r.Resolve("in", "state", []string{"a", "b"}); msg != "state: [a...] (a)" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+	if msg := r.Resolve("max", "age", []string{"1000"}); msg != "The age may not be greater than 1.000" {
+		t.Fatalf("expected the embedded number formatting, got %q", msg)
+	}
+}
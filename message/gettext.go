@@ -0,0 +1,219 @@
+package message
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// gettext .mo magic numbers in little- and big-endian byte order
+const (
+	moMagicLittleEndian = 0x950412de
+	moMagicBigEndian    = 0xde120495
+	moHeaderSize        = 20
+)
+
+// gettext separators for message contexts and plural forms
+const (
+	gettextContextSeparator = "\x04"
+	gettextPluralSeparator  = "\x00"
+)
+
+var (
+	errMOInvalidMagic = errors.New("gettext: not a .mo file")
+	errMOTruncated    = errors.New("gettext: truncated .mo file")
+)
+
+// GettextCatalog is a parsed gettext catalog: its messages by msgid (the rule key, e.g. "required"
+// or "max.title") and the language announced in its header.
+type GettextCatalog struct {
+	Language string
+	Messages map[string]string
+}
+
+// LoadPO imports a gettext .po catalog into the resolver's catalog of locale; an empty locale uses
+// the catalog's "Language" header. Fuzzy and untranslated entries are skipped.
+func (r *Resolver) LoadPO(locale string, reader io.Reader) error {
+	catalog, err := ParsePO(reader)
+	if err != nil {
+		return err
+	}
+	return r.loadGettext(locale, catalog)
+}
+
+// LoadMO imports a compiled gettext .mo catalog like LoadPO
+func (r *Resolver) LoadMO(locale string, reader io.Reader) error {
+	catalog, err := ParseMO(reader)
+	if err != nil {
+		return err
+	}
+	return r.loadGettext(locale, catalog)
+}
+
+func (r *Resolver) loadGettext(locale string, catalog GettextCatalog) error {
+	if locale == "" {
+		locale = catalog.Language
+	}
+	if locale == "" {
+		return errors.New("gettext: no locale given and the catalog has no Language header")
+	}
+	r.LoadLocaleCatalog(locale, catalog.Messages)
+	return nil
+}
+
+// poEntry accumulates one .po entry while parsing
+type poEntry struct {
+	msgid   strings.Builder
+	msgstr  strings.Builder
+	fuzzy   bool
+	current *strings.Builder
+	started bool
+}
+
+// ParsePO parses a gettext .po catalog. Plural entries use their first form (msgstr[0]) and message
+// contexts (msgctxt) are ignored.
+func ParsePO(reader io.Reader) (GettextCatalog, error) {
+	catalog := GettextCatalog{Messages: make(map[string]string)}
+	entry := &poEntry{}
+	flush := func() {
+		if entry.started {
+			catalog.add(entry.msgid.String(), entry.msgstr.String(), entry.fuzzy)
+		}
+		entry = &poEntry{}
+	}
+
+	scanner := bufio.NewScanner(reader)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		keyword, rest, _ := strings.Cut(line, " ")
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#,"):
+			if entry.started {
+				flush()
+			}
+			entry.fuzzy = entry.fuzzy || strings.Contains(line, "fuzzy")
+		case strings.HasPrefix(line, "#"):
+			if entry.started {
+				flush()
+			}
+		case keyword == "msgctxt":
+			if entry.started {
+				flush()
+			}
+			entry.current = nil
+		case keyword == "msgid":
+			if entry.started {
+				flush()
+			}
+			entry.started = true
+			entry.current = &entry.msgid
+		case keyword == "msgid_plural":
+			entry.current = nil
+		case keyword == "msgstr" || keyword == "msgstr[0]":
+			entry.current = &entry.msgstr
+		case strings.HasPrefix(keyword, "msgstr["):
+			entry.current = nil
+		case strings.HasPrefix(line, `"`):
+			rest = line
+		default:
+			return GettextCatalog{}, fmt.Errorf("gettext: line %d: unexpected %q", lineNo, keyword)
+		}
+
+		if rest == "" || !strings.HasPrefix(rest, `"`) {
+			continue
+		}
+		text, err := strconv.Unquote(strings.TrimSpace(rest))
+		if err != nil {
+			return GettextCatalog{}, fmt.Errorf("gettext: line %d: %w", lineNo, err)
+		}
+		if entry.current != nil {
+			entry.current.WriteString(text)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return GettextCatalog{}, err
+	}
+	flush()
+	return catalog, nil
+}
+
+// ParseMO parses a compiled gettext .mo catalog in either byte order
+func ParseMO(reader io.Reader) (GettextCatalog, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return GettextCatalog{}, err
+	}
+	if len(data) < moHeaderSize {
+		return GettextCatalog{}, errMOTruncated
+	}
+
+	var order binary.ByteOrder
+	switch binary.LittleEndian.Uint32(data) {
+	case moMagicLittleEndian:
+		order = binary.LittleEndian
+	case moMagicBigEndian:
+		order = binary.BigEndian
+	default:
+		return GettextCatalog{}, errMOInvalidMagic
+	}
+
+	count := order.Uint32(data[8:])
+	// Every entry takes 8 bytes in both the original and the translation table, so a count the
+	// data cannot hold is rejected before it sizes anything
+	if uint64(count)*16 > uint64(len(data)-moHeaderSize) {
+		return GettextCatalog{}, errMOTruncated
+	}
+	originals, translations := order.Uint32(data[12:]), order.Uint32(data[16:])
+	str := func(table, i uint32) (string, error) {
+		pos := uint64(table) + uint64(i)*8
+		if pos+8 > uint64(len(data)) {
+			return "", errMOTruncated
+		}
+		length, offset := uint64(order.Uint32(data[pos:])), uint64(order.Uint32(data[pos+4:]))
+		if offset+length > uint64(len(data)) {
+			return "", errMOTruncated
+		}
+		return string(data[offset : offset+length]), nil
+	}
+
+	catalog := GettextCatalog{Messages: make(map[string]string, count)}
+	for i := uint32(0); i < count; i++ {
+		msgid, err := str(originals, i)
+		if err != nil {
+			return GettextCatalog{}, err
+		}
+		msgstr, err := str(translations, i)
+		if err != nil {
+			return GettextCatalog{}, err
+		}
+		if _, id, hasContext := strings.Cut(msgid, gettextContextSeparator); hasContext {
+			msgid = id
+		}
+		msgid, _, _ = strings.Cut(msgid, gettextPluralSeparator)
+		msgstr, _, _ = strings.Cut(msgstr, gettextPluralSeparator)
+		catalog.add(msgid, msgstr, false)
+	}
+	return catalog, nil
+}
+
+// add records a translated entry; the empty msgid is the header, whose Language is kept
+func (c *GettextCatalog) add(msgid, msgstr string, fuzzy bool) {
+	if msgid == "" {
+		for _, line := range strings.Split(msgstr, "\n") {
+			if name, value, ok := strings.Cut(line, ":"); ok && strings.TrimSpace(name) == "Language" {
+				c.Language = strings.TrimSpace(value)
+			}
+		}
+		return
+	}
+	if fuzzy || msgstr == "" {
+		return
+	}
+	c.Messages[msgid] = msgstr
+}
@@ -0,0 +1,129 @@
+package message
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+const testPO = `# German validator messages
+msgid ""
+msgstr ""
+"Content-Type: text/plain; charset=UTF-8\n"
+"Language: de\n"
+
+#: rules/conditional/required.go
+msgid "required"
+msgstr "Das Feld :attribute ist "
+"erforderlich"
+
+#, fuzzy
+msgid "email"
+msgstr "Ungeprüft"
+
+msgid "alpha"
+msgstr ""
+
+msgctxt "profile"
+msgid "max.title"
+msgstr "Der \"Titel\" ist zu lang"
+
+msgid "min"
+msgid_plural "min"
+msgstr[0] "Mindestens :param0"
+msgstr[1] "Mindestens :param0 (plural)"
+`
+
+func TestParsePO(t *testing.T) {
+	catalog, err := ParsePO(strings.NewReader(testPO))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if catalog.Language != "de" {
+		t.Errorf("expected the header language, got %q", catalog.Language)
+	}
+	want := map[string]string{
+		"required":  "Das Feld :attribute ist erforderlich",
+		"max.title": `Der "Titel" ist zu lang`,
+		"min":       "Mindestens :param0",
+	}
+	if len(catalog.Messages) != len(want) {
+		t.Fatalf("expected %d messages, got %#v", len(want), catalog.Messages)
+	}
+	for key, msg := range want {
+		if catalog.Messages[key] != msg {
+			t.Errorf("%s: got %q, want %q", key, catalog.Messages[key], msg)
+		}
+	}
+
+	if _, err := ParsePO(strings.NewReader("msgid \"unterminated\nmsgstr \"x\"")); err == nil {
+		t.Errorf("expected an error for a malformed string")
+	}
+}
+
+// buildMO compiles entries into a little-endian .mo file
+func buildMO(entries [][2]string) []byte {
+	count := uint32(len(entries))
+	originals, translations := uint32(moHeaderSize), uint32(moHeaderSize)+count*8
+	offset := translations + count*8
+
+	var table, strs bytes.Buffer
+	header := []uint32{moMagicLittleEndian, 0, count, originals, translations}
+	for column := 0; column < 2; column++ {
+		for _, entry := range entries {
+			_ = binary.Write(&table, binary.LittleEndian, []uint32{uint32(len(entry[column])), offset})
+			strs.WriteString(entry[column] + "\x00")
+			offset += uint32(len(entry[column])) + 1
+		}
+	}
+
+	var out bytes.Buffer
+	_ = binary.Write(&out, binary.LittleEndian, header)
+	out.Write(table.Bytes())
+	out.Write(strs.Bytes())
+	return out.Bytes()
+}
+
+func TestParseMO(t *testing.T) {
+	data := buildMO([][2]string{
+		{"", "Language: fr\n"},
+		{"required", "Le champ :attribute est obligatoire"},
+		{"profile\x04max\x00max", "Trop long\x00Trop longs"},
+	})
+	catalog, err := ParseMO(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if catalog.Language != "fr" || catalog.Messages["required"] != "Le champ :attribute est obligatoire" ||
+		catalog.Messages["max"] != "Trop long" {
+		t.Fatalf("unexpected catalog: %#v", catalog)
+	}
+
+	if _, err := ParseMO(bytes.NewReader(data[:30])); err == nil {
+		t.Errorf("expected an error for a truncated file")
+	}
+	huge := append([]byte{}, data[:moHeaderSize]...)
+	binary.LittleEndian.PutUint32(huge[8:], 0x7fffffff)
+	if _, err := ParseMO(bytes.NewReader(huge)); err == nil {
+		t.Errorf("expected an error for a count the file cannot hold")
+	}
+	if _, err := ParseMO(strings.NewReader("not a catalog at all")); err == nil {
+		t.Errorf("expected an error for a bad magic number")
+	}
+}
+
+func TestResolver_LoadPO(t *testing.T) {
+	r := NewResolver()
+	if err := r.LoadPO("", strings.NewReader(testPO)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r.SetLocale("de-AT", "en")
+	if msg := r.Resolve("required", "name", nil); msg != "Das Feld name ist erforderlich" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+
+	if err := NewResolver().LoadMO("", bytes.NewReader(buildMO([][2]string{{"required", "x"}}))); err == nil {
+		t.Errorf("expected an error without a locale")
+	}
+}
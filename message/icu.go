@@ -0,0 +1,353 @@
+package message
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// ICU argument and plural keywords
+const (
+	icuSelect        = "select"
+	icuPlural        = "plural"
+	icuSelectOrdinal = "selectordinal"
+	icuNumber        = "number"
+	icuOther         = "other"
+	icuOffset        = "offset:"
+	icuPound         = "#"
+)
+
+var (
+	errICUUnclosed      = errors.New("icu: unclosed argument")
+	errICUMissingName   = errors.New("icu: missing argument name")
+	errICUMissingOther  = errors.New("icu: select and plural arguments require an \"other\" option")
+	errICUInvalidOption = errors.New("icu: invalid option")
+)
+
+// icuMessage is a parsed ICU MessageFormat pattern
+type icuMessage []icuPart
+
+// icuPart is literal text, an argument ({name}, {name, number}, {name, select, ...}) or a plural "#"
+type icuPart struct {
+	text    string
+	arg     string
+	kind    string
+	offset  float64
+	options map[string]icuMessage
+}
+
+// icuParser is a recursive-descent parser for ICU MessageFormat patterns
+type icuParser struct {
+	src []rune
+	pos int
+}
+
+// parseICU parses an ICU MessageFormat pattern such as
+// "{attribute} must have {0, plural, one {# item} other {# items}}".
+func parseICU(pattern string) (icuMessage, error) {
+	p := &icuParser{src: []rune(pattern)}
+	msg, err := p.message(false)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.src) {
+		return nil, fmt.Errorf("icu: unexpected %q at offset %d", p.src[p.pos], p.pos)
+	}
+	return msg, nil
+}
+
+// message parses literal text and arguments up to an unmatched '}' or the end of the pattern
+func (p *icuParser) message(inPlural bool) (icuMessage, error) {
+	var msg icuMessage
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			msg = append(msg, icuPart{text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; {
+		case c == '}':
+			flush()
+			return msg, nil
+		case c == '{':
+			flush()
+			part, err := p.argument(inPlural)
+			if err != nil {
+				return nil, err
+			}
+			msg = append(msg, part)
+		case c == '#' && inPlural:
+			flush()
+			msg = append(msg, icuPart{kind: icuPound})
+			p.pos++
+		case c == '\'':
+			p.quoted(&text)
+		default:
+			text.WriteRune(c)
+			p.pos++
+		}
+	}
+	flush()
+	return msg, nil
+}
+
+// quoted handles ICU apostrophe quoting: "”" is a literal apostrophe and an apostrophe before
+// a syntax character starts a literal section up to the next single apostrophe.
+func (p *icuParser) quoted(text *strings.Builder) {
+	p.pos++
+	if p.pos < len(p.src) && p.src[p.pos] == '\'' {
+		text.WriteRune('\'')
+		p.pos++
+		return
+	}
+	if p.pos >= len(p.src) || !strings.ContainsRune("{}#|", p.src[p.pos]) {
+		text.WriteRune('\'')
+		return
+	}
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		p.pos++
+		if c != '\'' {
+			text.WriteRune(c)
+			continue
+		}
+		if p.pos < len(p.src) && p.src[p.pos] == '\'' {
+			text.WriteRune('\'')
+			p.pos++
+			continue
+		}
+		return
+	}
+}
+
+// argument parses one {...} argument; the current rune is the opening brace
+func (p *icuParser) argument(inPlural bool) (icuPart, error) {
+	p.pos++
+	part := icuPart{arg: p.word()}
+	if part.arg == "" {
+		return icuPart{}, errICUMissingName
+	}
+	if p.consume('}') {
+		return part, nil
+	}
+	if !p.consume(',') {
+		return icuPart{}, errICUUnclosed
+	}
+	part.kind = p.word()
+	if p.consume('}') {
+		return part, nil
+	}
+	if !p.consume(',') {
+		return icuPart{}, errICUUnclosed
+	}
+
+	switch part.kind {
+	case icuSelect, icuPlural, icuSelectOrdinal:
+		return p.options(part, inPlural || part.kind != icuSelect)
+	default:
+		// Styles of simple arguments ("{n, number, integer}") are accepted and ignored
+		for p.pos < len(p.src) && p.src[p.pos] != '}' {
+			p.pos++
+		}
+		if !p.consume('}') {
+			return icuPart{}, errICUUnclosed
+		}
+		return part, nil
+	}
+}
+
+// options parses the "key {message}" pairs of a select or plural argument
+func (p *icuParser) options(part icuPart, inPlural bool) (icuPart, error) {
+	part.options = make(map[string]icuMessage)
+	for {
+		p.skipSpace()
+		if p.consume('}') {
+			break
+		}
+		if part.kind != icuSelect && strings.HasPrefix(string(p.src[p.pos:]), icuOffset) {
+			p.pos += len(icuOffset)
+			offset, err := strconv.ParseFloat(p.word(), 64)
+			if err != nil {
+				return icuPart{}, errICUInvalidOption
+			}
+			part.offset = offset
+			continue
+		}
+		key := p.word()
+		if key == "" || !p.consume('{') {
+			return icuPart{}, errICUInvalidOption
+		}
+		msg, err := p.message(inPlural)
+		if err != nil {
+			return icuPart{}, err
+		}
+		if !p.consume('}') {
+			return icuPart{}, errICUUnclosed
+		}
+		part.options[key] = msg
+	}
+	if _, ok := part.options[icuOther]; !ok {
+		return icuPart{}, errICUMissingOther
+	}
+	return part, nil
+}
+
+// word reads an identifier, option key ("=0", "one") or number, skipping surrounding spaces
+func (p *icuParser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.src) && !unicode.IsSpace(p.src[p.pos]) && !strings.ContainsRune("{},", p.src[p.pos]) {
+		p.pos++
+	}
+	word := string(p.src[start:p.pos])
+	p.skipSpace()
+	return word
+}
+
+// consume skips spaces and advances past c when it is the next rune
+func (p *icuParser) consume(c rune) bool {
+	p.skipSpace()
+	if p.pos < len(p.src) && p.src[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *icuParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// format renders the message with args, choosing plural categories with rule
+func (m icuMessage) format(out *strings.Builder, args map[string]any, rule PluralRule, pound string) {
+	for _, part := range m {
+		switch part.kind {
+		case "":
+			if part.arg == "" {
+				out.WriteString(part.text)
+			} else {
+				out.WriteString(icuString(args[part.arg]))
+			}
+		case icuPound:
+			out.WriteString(pound)
+		case icuNumber:
+			if n, ok := icuNumberValue(args[part.arg]); ok {
+				out.WriteString(formatICUNumber(n))
+			} else {
+				out.WriteString(icuString(args[part.arg]))
+			}
+		case icuSelect:
+			part.selectOption(icuString(args[part.arg])).format(out, args, rule, pound)
+		case icuPlural, icuSelectOrdinal:
+			n, _ := icuNumberValue(args[part.arg])
+			option, exact := part.options["="+formatICUNumber(n)]
+			if !exact {
+				option = part.selectOption(rule(n-part.offset, part.kind == icuSelectOrdinal))
+			}
+			option.format(out, args, rule, formatICUNumber(n-part.offset))
+		default:
+			out.WriteString(icuString(args[part.arg]))
+		}
+	}
+}
+
+// selectOption returns the option for key, or the "other" option
+func (part icuPart) selectOption(key string) icuMessage {
+	if option, ok := part.options[key]; ok {
+		return option
+	}
+	return part.options[icuOther]
+}
+
+// icuString renders an argument value as text
+func icuString(value any) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// icuNumberValue converts numbers, numeric strings and the length of collections to a float
+func icuNumberValue(value any) (float64, bool) {
+	if s, ok := value.(string); ok {
+		n, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		return n, err == nil
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(v.Len()), true
+	default:
+		return 0, false
+	}
+}
+
+func formatICUNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// UseICU interprets messages containing "{" as ICU MessageFormat patterns, choosing plural
+// categories for locale ("en", "fr-CA"). Arguments are {attribute}, {field}, {value}, {input},
+// {other} and the rule parameters {0}, {1}, ...; colon placeholders are not replaced in them.
+func (r *Resolver) UseICU(locale string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.icuLocale = locale
+}
+
+// LoadCatalog replaces catalog messages by rule name, e.g. with ICU strings produced by an
+// existing translation pipeline. Rules missing from messages keep their default message.
+func (r *Resolver) LoadCatalog(messages map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for rule, msg := range messages {
+		r.defaultMessages[rule] = msg
+	}
+}
+
+// isICU reports whether message may contain ICU arguments
+func isICU(message string) bool {
+	return strings.ContainsRune(message, '{')
+}
+
+// renderICU formats an ICU message for a rule evaluated in ctx. It reports false when the
+// message is not valid ICU, so the caller can still treat it as a colon-placeholder message.
+func (r *Resolver) renderICU(message, attribute string, ctx contract.RuleContext, input string) (string, bool) {
+	msg, err := parseICU(message)
+	if err != nil {
+		return "", false
+	}
+
+	args := map[string]any{
+		"attribute": attribute,
+		"field":     ctx.Field(),
+		"value":     r.messageValue(ctx.Value(), input),
+		"input":     input,
+	}
+	for i, param := range ctx.Parameters() {
+		args[strconv.Itoa(i)] = param
+		if i == 0 {
+			args["other"] = r.attributeName(param)
+		}
+	}
+
+	var out strings.Builder
+	msg.format(&out, args, FindPluralRule(r.icuLocale), "")
+	return out.String(), true
+}
@@ -0,0 +1,103 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func TestResolver_ICUMessages(t *testing.T) {
+	r := NewResolver()
+	r.UseICU("en")
+	r.SetCustomAttribute("password_confirmation", "password confirmation")
+	r.LoadCatalog(map[string]string{
+		"max":  "{attribute} may have {0, plural, =0 {no items} one {# item} other {# items}}",
+		"same": "{attribute} must match {other}",
+		"in": "{attribute} must be one of: {0, select, draft {the draft state} other {{0}}} " +
+			"(you are the {1, selectordinal, one {#st} two {#nd} few {#rd} other {#th}} to ask)",
+		"min": "'{attribute}' is quoted, it''s {0, number}",
+	})
+
+	tests := []struct {
+		name   string
+		rule   string
+		field  string
+		params []string
+		want   string
+	}{
+		{"plural one", "max", "tags", []string{"1"}, "tags may have 1 item"},
+		{"plural other", "max", "tags", []string{"4"}, "tags may have 4 items"},
+		{"plural exact", "max", "tags", []string{"0"}, "tags may have no items"},
+		{"other attribute", "same", "password", []string{"password_confirmation"},
+			"password must match password confirmation"},
+		{"select and ordinal", "in", "state", []string{"draft", "22"},
+			"state must be one of: the draft state (you are the 22nd to ask)"},
+		{"select other", "in", "state", []string{"open", "13"}, "state must be one of: open (you are the 13th to ask)"},
+		{"quoting", "min", "age", []string{"18"}, "{attribute} is quoted, it's 18"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if msg := r.Clone().Resolve(tt.rule, tt.field, tt.params); msg != tt.want {
+				t.Errorf("got %q, want %q", msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolver_ICUPluralRules(t *testing.T) {
+	r := NewResolver()
+	r.UseICU("fr-CA")
+	r.SetCustomMessage("min", "{value, plural, one {# caractère} other {# caractères}}")
+
+	if msg := r.ResolveRule("min", contract.NewValidationContext("name", 0, nil, nil), ""); msg != "0 caractère" {
+		t.Fatalf("expected French plural rule for 0, got %q", msg)
+	}
+
+	RegisterPluralRule("xx", func(float64, bool) string { return PluralFew })
+	r.UseICU("xx")
+	r.SetCustomMessage("min", "{value, plural, few {few} other {other}}")
+	if msg := r.Resolve("min", "name", nil); msg != "few" {
+		t.Fatalf("expected registered plural rule, got %q", msg)
+	}
+}
+
+func TestResolver_ICUInvalidPatternFallsBack(t *testing.T) {
+	r := NewResolver()
+	r.UseICU("en")
+	r.SetCustomMessage("min", ":attribute needs {0, plural, one {x}}")
+
+	if msg := r.Resolve("min", "name", []string{"3"}); msg != "name needs {0, plural, one {x}}" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+	if msg := r.Resolve("required", "name", nil); msg != "The name field is required" {
+		t.Fatalf("colon catalog messages should still format, got %q", msg)
+	}
+}
+
+type markupValue struct{}
+
+func (markupValue) String() string { return "<b>x</b>" }
+
+func TestResolver_ICUEscapesValue(t *testing.T) {
+	r := NewResolver()
+	r.UseICU("en")
+	r.SetEscapeInput(true)
+	r.SetCustomMessage("email", "{value} is not a valid {attribute}")
+
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{"string", "<b>x</b>"},
+		{"stringer", markupValue{}},
+		{"bytes", []byte("<b>x</b>")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contract.NewValidationContext("email", tt.value, nil, nil)
+			if msg := r.ResolveRule("email", ctx, ""); msg != "&lt;b&gt;x&lt;/b&gt; is not a valid email" {
+				t.Errorf("unexpected message: %q", msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package message
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Translation key sources
+const (
+	SourceCatalog = "catalog"
+	SourceCustom  = "custom"
+	SourceRule    = "rule"
+	// SourceRuleSetPrefix prefixes the name of the rule set a message comes from ("ruleset:signup")
+	SourceRuleSetPrefix = "ruleset:"
+)
+
+// sourcePriority orders which source's text is kept when several define the same key
+var sourcePriority = map[string]int{SourceCustom: 3, SourceCatalog: 1, SourceRule: 0}
+
+// placeholderPatterns match colon placeholders, text/template fields and ICU arguments
+var placeholderPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`:[a-z][a-z0-9_]*`),
+	regexp.MustCompile(`\{\{-?\s*\.([A-Za-z]\w*)`),
+	regexp.MustCompile(`\{\s*([A-Za-z0-9_]+)\s*[,}]`),
+}
+
+// TranslationKey is one translatable message: its key (a rule or "<rule>.<field>"), source text,
+// the placeholders translators must keep, and where it was found.
+type TranslationKey struct {
+	Key          string   `json:"key"`
+	Message      string   `json:"message"`
+	Placeholders []string `json:"placeholders"`
+	Sources      []string `json:"sources"`
+}
+
+// KeyCollector gathers translation keys from the resolver, registered rules and rule sets
+type KeyCollector struct {
+	keys     map[string]*TranslationKey
+	priority map[string]int
+}
+
+// NewKeyCollector creates an empty KeyCollector
+func NewKeyCollector() *KeyCollector {
+	return &KeyCollector{
+		keys:     make(map[string]*TranslationKey),
+		priority: make(map[string]int),
+	}
+}
+
+// Add records message under key. When several sources define a key, custom messages win over
+// rule set messages, which win over catalog and rule messages.
+func (c *KeyCollector) Add(key, message, source string) {
+	entry, exists := c.keys[key]
+	if !exists {
+		entry = &TranslationKey{Key: key}
+		c.keys[key] = entry
+		c.priority[key] = -1
+	}
+	if !containsString(entry.Sources, source) {
+		entry.Sources = append(entry.Sources, source)
+	}
+
+	priority := sourcePriority[source]
+	if strings.HasPrefix(source, SourceRuleSetPrefix) {
+		priority = 2
+	}
+	if message != "" && priority > c.priority[key] {
+		entry.Message = message
+		entry.Placeholders = Placeholders(message)
+		c.priority[key] = priority
+	}
+}
+
+// Keys returns the collected keys sorted by key
+func (c *KeyCollector) Keys() []TranslationKey {
+	keys := make([]TranslationKey, 0, len(c.keys))
+	for _, entry := range c.keys {
+		keys = append(keys, *entry)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Key < keys[j].Key })
+	return keys
+}
+
+// CollectKeys adds the resolver's catalog messages for rules (all catalog entries when rules is
+// empty) and its custom messages, including those built by message functions.
+func (r *Resolver) CollectKeys(c *KeyCollector, rules []string) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(rules) == 0 {
+		for rule := range r.defaultMessages {
+			rules = append(rules, rule)
+		}
+	}
+	for _, rule := range rules {
+		if msg := r.defaultMessages[rule]; msg != "" {
+			c.Add(rule, msg, SourceCatalog)
+		}
+	}
+	for key, msg := range r.customMessages {
+		c.Add(key, msg, SourceCustom)
+	}
+	for key := range r.messageFuncs {
+		c.Add(key, "", SourceCustom)
+	}
+}
+
+// Placeholders returns the distinct placeholders of message in order of appearance: colon
+// placeholders (":attribute"), template fields (".Attribute") and ICU arguments ("{0}").
+func Placeholders(message string) []string {
+	var placeholders []string
+	for i, pattern := range placeholderPatterns {
+		for _, match := range pattern.FindAllStringSubmatch(message, -1) {
+			placeholder := match[0]
+			switch i {
+			case 1:
+				placeholder = "." + match[1]
+			case 2:
+				placeholder = "{" + match[1] + "}"
+			}
+			if !containsString(placeholders, placeholder) {
+				placeholders = append(placeholders, placeholder)
+			}
+		}
+	}
+	return placeholders
+}
+
+// WritePOT writes keys as a gettext .pot template: msgid is the key, the source text and
+// placeholders are extracted comments for translators.
+func WritePOT(w io.Writer, keys []TranslationKey) error {
+	var out strings.Builder
+	out.WriteString("msgid \"\"\nmsgstr \"\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n")
+	for _, key := range keys {
+		out.WriteString("\n")
+		if key.Message != "" {
+			fmt.Fprintf(&out, "#. %s\n", strings.ReplaceAll(key.Message, "\n", " "))
+		}
+		if len(key.Placeholders) > 0 {
+			fmt.Fprintf(&out, "#. placeholders: %s\n", strings.Join(key.Placeholders, " "))
+		}
+		fmt.Fprintf(&out, "#: %s\n", strings.Join(key.Sources, " "))
+		fmt.Fprintf(&out, "msgid %s\nmsgstr \"\"\n", strconv.Quote(key.Key))
+	}
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+// WriteKeysJSON writes keys as an indented JSON array
+func WriteKeysJSON(w io.Writer, keys []TranslationKey) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(keys)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package message
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPlaceholders(t *testing.T) {
+	tests := []struct {
+		message string
+		want    []string
+	}{
+		{"The :attribute must be between :param0 and :param1", []string{":attribute", ":param0", ":param1"}},
+		{"{{.Attribute}} has {{len .Value}} items, {{- .Attribute}}", []string{".Attribute"}},
+		{"{attribute} may have {0, plural, one {# item} other {# items}}", []string{"{attribute}", "{0}"}},
+		{"No placeholders", nil},
+	}
+	for _, tt := range tests {
+		if got := Placeholders(tt.message); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Placeholders(%q) = %v, want %v", tt.message, got, tt.want)
+		}
+	}
+}
+
+func TestKeyCollector(t *testing.T) {
+	c := NewKeyCollector()
+	c.Add("min", "The :attribute must be at least :param0", SourceCatalog)
+	c.Add("min", "Custom :attribute", SourceCustom)
+	c.Add("min", "the :attribute rule message", SourceRule)
+	c.Add("alpha", "", SourceRule)
+
+	keys := c.Keys()
+	if len(keys) != 2 || keys[0].Key != "alpha" || keys[1].Key != "min" {
+		t.Fatalf("expected keys sorted by key, got %#v", keys)
+	}
+	wantSources := []string{SourceCatalog, SourceCustom, SourceRule}
+	if keys[1].Message != "Custom :attribute" || !reflect.DeepEqual(keys[1].Sources, wantSources) {
+		t.Fatalf("unexpected merged key: %#v", keys[1])
+	}
+}
+
+func TestWritePOTAndJSON(t *testing.T) {
+	keys := []TranslationKey{{
+		Key:          "min",
+		Message:      "The :attribute must be at least :param0",
+		Placeholders: []string{":attribute", ":param0"},
+		Sources:      []string{SourceCatalog},
+	}}
+
+	var pot bytes.Buffer
+	if err := WritePOT(&pot, keys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	template := pot.String()
+	wants := []string{"#. placeholders: :attribute :param0\n", "#: catalog\n", "msgid \"min\"\nmsgstr \"\"\n"}
+	for _, want := range wants {
+		if !strings.Contains(template, want) {
+			t.Errorf("expected %q in template:\n%s", want, template)
+		}
+	}
+	catalog, err := ParsePO(strings.NewReader(template))
+	if err != nil || len(catalog.Messages) != 0 {
+		t.Fatalf("expected a valid template without translations, got %#v, %v", catalog.Messages, err)
+	}
+
+	var out bytes.Buffer
+	if err := WriteKeysJSON(&out, keys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), `"placeholders": [`) {
+		t.Errorf("unexpected JSON: %s", out.String())
+	}
+}
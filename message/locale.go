@@ -0,0 +1,92 @@
+package message
+
+import "strings"
+
+// LocaleChain returns the BCP 47 fallback chain of locale followed by the chains of fallbacks,
+// without duplicates: LocaleChain("de-AT", "en") is ["de-at", "de", "en"]. Tags are normalized
+// to lower case with "-" separators, so "pt_BR" and "pt-br" match the same catalog.
+func LocaleChain(locale string, fallbacks ...string) []string {
+	var chain []string
+	seen := make(map[string]bool)
+	for _, tag := range append([]string{locale}, fallbacks...) {
+		tag = normalizeLocale(tag)
+		for tag != "" {
+			if !seen[tag] {
+				seen[tag] = true
+				chain = append(chain, tag)
+			}
+			cut := strings.LastIndex(tag, "-")
+			if cut < 0 {
+				break
+			}
+			tag = tag[:cut]
+		}
+	}
+	return chain
+}
+
+// normalizeLocale lower-cases a locale tag and uses "-" as the subtag separator
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// LoadLocaleCatalog adds messages by rule name for a locale ("de", "de-AT"). Messages are picked
+// along the resolver's locale chain (see SetLocale), then from the built-in English catalog.
+func (r *Resolver) LoadLocaleCatalog(locale string, messages map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tag := normalizeLocale(locale)
+	catalog := r.catalogs[tag]
+	if catalog == nil {
+		catalog = make(map[string]string, len(messages))
+		r.catalogs[tag] = catalog
+	}
+	for rule, msg := range messages {
+		catalog[rule] = msg
+	}
+}
+
+// SetLocale selects the locale whose catalog is used, degrading along its BCP 47 parents and then
+// the fallbacks: SetLocale("de-AT", "en") looks in "de-at", "de" and "en" before the built-in
+// messages, so a partial translation never drops a rule to the generic message.
+func (r *Resolver) SetLocale(locale string, fallbacks ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.localeChain = LocaleChain(locale, fallbacks...)
+}
+
+// SetLocaleAttribute sets the attribute name of field for a locale, e.g.
+// SetLocaleAttribute("email", "de", "E-Mail-Adresse"). It takes precedence over SetCustomAttribute
+// while the locale is on the resolver's locale chain.
+func (r *Resolver) SetLocaleAttribute(field, locale, attribute string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tag := normalizeLocale(locale)
+	attributes := r.localeAttributes[tag]
+	if attributes == nil {
+		attributes = make(map[string]string)
+		r.localeAttributes[tag] = attributes
+	}
+	attributes[field] = attribute
+}
+
+// copyLocaleMaps deep-copies per-locale maps from src into dst
+func copyLocaleMaps(dst, src map[string]map[string]string) {
+	for tag, entries := range src {
+		dst[tag] = make(map[string]string, len(entries))
+		for k, v := range entries {
+			dst[tag][k] = v
+		}
+	}
+}
+
+// catalogMessage returns the message for rule from the first catalog of the locale chain that
+// has it, or from the built-in catalog
+func (r *Resolver) catalogMessage(rule string) string {
+	for _, tag := range r.localeChain {
+		if msg := r.catalogs[tag][rule]; msg != "" {
+			return msg
+		}
+	}
+	return r.defaultMessages[rule]
+}
@@ -0,0 +1,72 @@
+package message
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLocaleChain(t *testing.T) {
+	tests := []struct {
+		locale    string
+		fallbacks []string
+		want      []string
+	}{
+		{"de-AT", []string{"en"}, []string{"de-at", "de", "en"}},
+		{"zh_Hant_TW", nil, []string{"zh-hant-tw", "zh-hant", "zh"}},
+		{"en-GB", []string{"en-US", "en"}, []string{"en-gb", "en", "en-us"}},
+		{"", []string{"fr"}, []string{"fr"}},
+	}
+	for _, tt := range tests {
+		if got := LocaleChain(tt.locale, tt.fallbacks...); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("LocaleChain(%q, %v) = %v, want %v", tt.locale, tt.fallbacks, got, tt.want)
+		}
+	}
+}
+
+func TestResolver_LocaleFallback(t *testing.T) {
+	r := NewResolver()
+	r.LoadLocaleCatalog("de", map[string]string{
+		"required": "Das Feld :attribute ist erforderlich",
+		"email":    "Das Feld :attribute muss eine E-Mail-Adresse sein",
+	})
+	r.LoadLocaleCatalog("de-AT", map[string]string{"required": "Das Feld :attribute ist auszufüllen"})
+	r.SetLocale("de_AT", "en")
+
+	tests := []struct {
+		rule string
+		want string
+	}{
+		{"required", "Das Feld name ist auszufüllen"},
+		{"email", "Das Feld name muss eine E-Mail-Adresse sein"},
+		{"alpha", "The name may only contain letters"},
+	}
+	for _, tt := range tests {
+		if msg := r.Clone().Resolve(tt.rule, "name", nil); msg != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.rule, msg, tt.want)
+		}
+	}
+
+	r.SetLocale("en")
+	if msg := r.Resolve("required", "name", nil); msg != "The name field is required" {
+		t.Fatalf("expected built-in message for en, got %q", msg)
+	}
+}
+
+func TestResolver_LocaleAttributes(t *testing.T) {
+	r := NewResolver()
+	r.LoadLocaleCatalog("de", map[string]string{"required": "Das Feld :attribute ist erforderlich"})
+	r.SetCustomAttribute("email", "email address")
+	r.SetLocaleAttribute("email", "de", "E-Mail-Adresse")
+
+	if msg := r.Resolve("required", "email", nil); msg != "The email address field is required" {
+		t.Fatalf("expected the generic attribute without a locale, got %q", msg)
+	}
+
+	r.SetLocale("de-AT", "en")
+	if msg := r.Clone().Resolve("required", "email", nil); msg != "Das Feld E-Mail-Adresse ist erforderlich" {
+		t.Fatalf("expected the German attribute, got %q", msg)
+	}
+	if msg := r.Resolve("required", "name", nil); msg != "Das Feld name ist erforderlich" {
+		t.Fatalf("expected the field name for untranslated attributes, got %q", msg)
+	}
+}
@@ -0,0 +1,104 @@
+package message
+
+import (
+	"math"
+	"strings"
+	"sync"
+)
+
+// Plural categories used by ICU plural and selectordinal arguments
+const (
+	PluralZero  = "zero"
+	PluralOne   = "one"
+	PluralTwo   = "two"
+	PluralFew   = "few"
+	PluralMany  = "many"
+	PluralOther = "other"
+)
+
+// PluralRule returns the plural category ("one", "few", "other", ...) of n for a language.
+// ordinal selects the ordinal categories used by selectordinal ("1st", "2nd", "3rd").
+type PluralRule func(n float64, ordinal bool) string
+
+var (
+	pluralRulesMu sync.RWMutex
+	pluralRules   = map[string]PluralRule{
+		"en": englishPluralRule,
+		"de": germanicPluralRule,
+		"nl": germanicPluralRule,
+		"fr": frenchPluralRule,
+	}
+)
+
+// RegisterPluralRule registers the plural rule of a language ("pl", "ru") used by ICU messages.
+// This is intended to be called during application startup.
+func RegisterPluralRule(language string, rule PluralRule) {
+	if rule == nil {
+		panic("message: RegisterPluralRule rule is nil")
+	}
+	pluralRulesMu.Lock()
+	defer pluralRulesMu.Unlock()
+	pluralRules[strings.ToLower(language)] = rule
+}
+
+// FindPluralRule returns the plural rule for a locale such as "fr-CA", falling back to its
+// language and then to English.
+func FindPluralRule(locale string) PluralRule {
+	pluralRulesMu.RLock()
+	defer pluralRulesMu.RUnlock()
+
+	tag := strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if rule, ok := pluralRules[tag]; ok {
+		return rule
+	}
+	language, _, _ := strings.Cut(tag, "-")
+	if rule, ok := pluralRules[language]; ok {
+		return rule
+	}
+	return englishPluralRule
+}
+
+// isInteger reports whether n has no fractional part
+func isInteger(n float64) bool {
+	return n == math.Trunc(n)
+}
+
+func englishPluralRule(n float64, ordinal bool) string {
+	if !ordinal {
+		return germanicPluralRule(n, false)
+	}
+	if !isInteger(n) {
+		return PluralOther
+	}
+	mod10, mod100 := math.Mod(math.Abs(n), 10), math.Mod(math.Abs(n), 100)
+	switch {
+	case mod10 == 1 && mod100 != 11:
+		return PluralOne
+	case mod10 == 2 && mod100 != 12:
+		return PluralTwo
+	case mod10 == 3 && mod100 != 13:
+		return PluralFew
+	default:
+		return PluralOther
+	}
+}
+
+func germanicPluralRule(n float64, ordinal bool) string {
+	if !ordinal && n == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func frenchPluralRule(n float64, ordinal bool) string {
+	if ordinal {
+		if n == 1 {
+			return PluralOne
+		}
+		return PluralOther
+	}
+	if n >= 0 && n < 2 {
+		return PluralOne
+	}
+	return PluralOther
+}
@@ -1,6 +1,8 @@
 package message
 
 import (
+	"fmt"
+	"html"
 	"strings"
 	"sync"
 
@@ -8,21 +10,53 @@ import (
 	"github.com/next-trace/scg-validator/utils"
 )
 
+// Replacer rewrites the placeholders of a rule's message before the generic
+// :attribute and :paramN substitution runs. It receives the raw message, the
+// resolved attribute name and the rule parameters.
+type Replacer func(message, attribute string, params []string) string
+
+// FallbackFunc returns the generic message template used when a rule has no
+// custom, catalog or rule-provided message.
+type FallbackFunc func(rule string) string
+
+const (
+	// defaultFallbackMessage is the generic template used when nothing else matches
+	defaultFallbackMessage = "The :attribute field is invalid"
+	// negationPrefix marks negated rules such as "!in"
+	negationPrefix = "!"
+)
+
 // Resolver implements the MessageResolver interface
 // It provides request-scoped custom message and attribute resolution
 type Resolver struct {
 	customMessages   map[string]string
+	messageFuncs     map[string]contract.MessageFunc
 	customAttributes map[string]string
+	localeAttributes map[string]map[string]string
 	defaultMessages  map[string]string
+	catalogs         map[string]map[string]string
+	localeChain      []string
+	formatter        Formatter
+	replacers        map[string]Replacer
+	fallback         FallbackFunc
+	escapeInput      bool
+	icuLocale        string
 	mu               sync.RWMutex
 }
 
+var _ contract.RuleMessageResolver = (*Resolver)(nil)
+
 // NewResolver creates a new message resolver instance
 func NewResolver() *Resolver {
 	return &Resolver{
 		customMessages:   make(map[string]string),
+		messageFuncs:     make(map[string]contract.MessageFunc),
 		customAttributes: make(map[string]string),
+		localeAttributes: make(map[string]map[string]string),
 		defaultMessages:  getDefaultMessages(),
+		catalogs:         make(map[string]map[string]string),
+		formatter:        LocaleFormatter{},
+		replacers:        make(map[string]Replacer),
 	}
 }
 
@@ -34,27 +68,109 @@ func NewRequestScopedResolver() *Resolver {
 
 // Resolve creates a validation error message for the given rule, field, and parameters
 func (r *Resolver) Resolve(rule string, field string, parameters []string) string {
+	return r.resolve(rule, contract.NewValidationContext(field, nil, parameters, nil), "", "")
+}
+
+// ResolveRule creates a validation error message for a rule evaluated in ctx.
+// Resolution order: custom message, field-specific custom message, default catalog,
+// the provided fallback (typically the rule's own Message()), and finally the generic fallback.
+func (r *Resolver) ResolveRule(rule string, ctx contract.RuleContext, fallback string) string {
+	return r.resolve(rule, ctx, inputString(ctx.Value()), fallback)
+}
+
+// inputString renders a validated value for the :input placeholder
+func inputString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(v)
+	}
+	return fmt.Sprint(value)
+}
+
+// messageValue returns the value exposed to templates and ICU messages: the escaped
+// input text when escaping is on, so no formatted value reaches a message unescaped
+func (r *Resolver) messageValue(value any, input string) any {
+	if r.escapeInput && value != nil {
+		return input
+	}
+	return value
+}
+
+// resolve walks the message fallback chain and formats the first non-empty template
+func (r *Resolver) resolve(rule string, ctx contract.RuleContext, input, fallback string) string {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
+	if r.escapeInput {
+		input = html.EscapeString(input)
+	}
+
 	// Try to get custom message first
-	if customMsg, exists := r.customMessages[rule]; exists {
-		return r.formatMessage(customMsg, field, parameters)
+	if customMsg := r.customMessage(rule, ctx); customMsg != "" {
+		return r.formatMessage(rule, customMsg, ctx, input)
 	}
 
 	// Try to get field-specific custom message (rule.field format)
-	fieldSpecificKey := rule + "." + field
-	if customMsg, exists := r.customMessages[fieldSpecificKey]; exists {
-		return r.formatMessage(customMsg, field, parameters)
+	fieldSpecificKey := rule + "." + ctx.Field()
+	if customMsg := r.customMessage(fieldSpecificKey, ctx); customMsg != "" {
+		return r.formatMessage(rule, customMsg, ctx, input)
 	}
 
 	// Fall back to default message
-	if defaultMsg, exists := r.defaultMessages[rule]; exists {
-		return r.formatMessage(defaultMsg, field, parameters)
+	if defaultMsg := r.defaultMessage(rule); defaultMsg != "" {
+		return r.formatMessage(rule, defaultMsg, ctx, input)
+	}
+
+	// Use the message provided by the rule itself
+	if fallback != "" {
+		return r.formatMessage(rule, fallback, ctx, input)
 	}
 
 	// Ultimate fallback
-	return r.formatMessage("The :attribute field is invalid", field, parameters)
+	return r.formatMessage(rule, r.fallbackMessage(rule), ctx, input)
+}
+
+// customMessage returns the custom message registered under key, calling its MessageFunc if any
+func (r *Resolver) customMessage(key string, ctx contract.RuleContext) string {
+	if messageFunc := r.messageFuncs[key]; messageFunc != nil {
+		return messageFunc(ctx)
+	}
+	return r.customMessages[key]
+}
+
+// defaultMessage returns the catalog message for rule. Negated rules ("!in") use the
+// catalog entry of their "not_" twin when present, or a generated negated message.
+func (r *Resolver) defaultMessage(rule string) string {
+	if msg := r.catalogMessage(rule); msg != "" {
+		return msg
+	}
+	base, negated := strings.CutPrefix(rule, negationPrefix)
+	if !negated || base == "" {
+		return ""
+	}
+	if msg := r.catalogMessage("not_" + base); msg != "" {
+		return msg
+	}
+	return "The :attribute must not satisfy the " + base + " rule"
+}
+
+// SetFallback customizes the generic message used when no other message is available.
+func (r *Resolver) SetFallback(fallback FallbackFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fallback = fallback
+}
+
+// fallbackMessage returns the generic template, honoring a custom fallback hook
+func (r *Resolver) fallbackMessage(rule string) string {
+	if r.fallback != nil {
+		if msg := r.fallback(rule); msg != "" {
+			return msg
+		}
+	}
+	return defaultFallbackMessage
 }
 
 // SetCustomMessage sets a custom message for a rule
@@ -62,6 +178,16 @@ func (r *Resolver) SetCustomMessage(rule string, message string) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.customMessages[rule] = message
+	delete(r.messageFuncs, rule)
+}
+
+// SetCustomMessageFunc sets a custom message built for each failure of a rule, keyed like
+// SetCustomMessage ("max" or "max.title"). It replaces any custom message of the same key.
+func (r *Resolver) SetCustomMessageFunc(rule string, messageFunc contract.MessageFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messageFuncs[rule] = messageFunc
+	delete(r.customMessages, rule)
 }
 
 // SetCustomAttribute sets a custom attribute name for a field
@@ -71,25 +197,77 @@ func (r *Resolver) SetCustomAttribute(field string, attribute string) {
 	r.customAttributes[field] = attribute
 }
 
-// formatMessage formats the message by replacing placeholders
-func (r *Resolver) formatMessage(message string, field string, parameters []string) string {
+// SetEscapeInput enables HTML-escaping of the user-provided value interpolated
+// through the :input placeholder, for messages rendered in web templates.
+func (r *Resolver) SetEscapeInput(escape bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.escapeInput = escape
+}
+
+// RegisterReplacer registers a placeholder replacer for a rule.
+// Use it to render parameters in a human-friendly way (dates, byte sizes, value lists).
+func (r *Resolver) RegisterReplacer(rule string, replacer Replacer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.replacers[rule] = replacer
+}
+
+// formatMessage formats the message by rendering its template and replacing placeholders
+func (r *Resolver) formatMessage(rule, message string, ctx contract.RuleContext, input string) string {
+	field, parameters := ctx.Field(), ctx.Parameters()
+
 	// Replace :attribute with custom attribute name or field name
-	attributeName := field
-	if customAttr, exists := r.customAttributes[field]; exists {
-		attributeName = customAttr
+	attributeName := r.attributeName(field)
+	// ICU mode takes precedence, since nested ICU arguments ("{{0}}") look like templates
+	if r.icuLocale != "" && isICU(message) {
+		if rendered, ok := r.renderICU(message, attributeName, ctx, input); ok {
+			return rendered
+		}
+	} else if isTemplate(message) {
+		if rendered, ok := renderTemplate(message, r.templateData(attributeName, ctx, input)); ok {
+			return rendered
+		}
+	}
+
+	// Let a rule-specific replacer format its own placeholders first
+	if replacer, exists := r.replacers[rule]; exists {
+		message = replacer(message, attributeName, parameters)
 	}
 
 	message = strings.ReplaceAll(message, ":attribute", attributeName)
 	message = strings.ReplaceAll(message, ":field", field)
 
-	// Replace parameter placeholders
-	for i, param := range parameters {
+	// Replace parameter placeholders, formatted for the active locale
+	display := r.displayParams(rule, parameters)
+	message = strings.ReplaceAll(message, ":values", r.valuesList(message, display))
+	if len(parameters) > 0 {
+		message = strings.ReplaceAll(message, ":other", r.attributeName(parameters[0]))
+	}
+	for i, param := range display {
 		message = utils.ReplacePlaceholder(message, i, param)
 	}
 
+	// Replace :input last so user-provided content is never re-interpreted as a placeholder
+	message = strings.ReplaceAll(message, ":input", input)
+
 	return message
 }
 
+// attributeName returns the attribute name of field along the locale chain, then its custom
+// attribute name, or the field itself
+func (r *Resolver) attributeName(field string) string {
+	for _, tag := range r.localeChain {
+		if localized, exists := r.localeAttributes[tag][field]; exists {
+			return localized
+		}
+	}
+	if customAttr, exists := r.customAttributes[field]; exists {
+		return customAttr
+	}
+	return field
+}
+
 // Clone creates a copy of the resolver for request isolation
 func (r *Resolver) Clone() contract.MessageResolver {
 	r.mu.RLock()
@@ -102,11 +280,31 @@ func (r *Resolver) Clone() contract.MessageResolver {
 		newResolver.customMessages[k] = v
 	}
 
+	// Copy message functions
+	for k, v := range r.messageFuncs {
+		newResolver.messageFuncs[k] = v
+	}
+
 	// Copy custom attributes
 	for k, v := range r.customAttributes {
 		newResolver.customAttributes[k] = v
 	}
 
+	// Copy replacers
+	for k, v := range r.replacers {
+		newResolver.replacers[k] = v
+	}
+	newResolver.fallback = r.fallback
+	newResolver.escapeInput = r.escapeInput
+	newResolver.icuLocale = r.icuLocale
+	newResolver.localeChain = r.localeChain
+	newResolver.formatter = r.formatter
+	copyLocaleMaps(newResolver.catalogs, r.catalogs)
+	copyLocaleMaps(newResolver.localeAttributes, r.localeAttributes)
+	for k, v := range r.defaultMessages {
+		newResolver.defaultMessages[k] = v
+	}
+
 	return newResolver
 }
 
@@ -129,6 +327,8 @@ func getDefaultMessages() map[string]string {
 		"ends_with":            "The :attribute must end with one of the following: :param0",
 		"bail":                 "Stop validation on first failure",
 		"exists":               "The selected :attribute is invalid",
+		"unique":               "The :attribute has already been taken",
+		"expr":                 "The :attribute must satisfy :param0",
 		"date":                 "The :attribute is not a valid date",
 		"after":                "The :attribute must be a date after :param0",
 		"after_or_equal":       "The :attribute must be a date after or equal to :param0",
@@ -139,10 +339,12 @@ func getDefaultMessages() map[string]string {
 		"decimal":              "The :attribute must have :param0 decimal places",
 		"active_url":           "The :attribute must be a valid URL",
 		"confirmed":            "The :attribute confirmation does not match",
+		"confirmed_by":         "The :attribute confirmation does not match",
 		"alpha":                "The :attribute may only contain letters",
 		"alphanum":             "The :attribute may only contain letters and numbers",
 		"alpha_dash":           "The :attribute may only contain letters, numbers, dashes and underscores",
 		"email":                "The :attribute must be a valid email address",
+		"email_not_disposable": "The :attribute must not be a disposable email address",
 		"ascii":                "The :attribute must only contain ASCII characters",
 		"current_password":     "The :attribute is incorrect",
 		"doesnt_start_with":    "The :attribute must not start with one of the following: :param0",
@@ -154,10 +356,12 @@ func getDefaultMessages() map[string]string {
 		"required_without":     "The :attribute field is required when :param0 is not present",
 		"required_with_all":    "The :attribute field is required when :param0 are present",
 		"required_without_all": "The :attribute field is required when none of :param0 are present",
+		"required_together":    "The :attribute field must be provided together with :param0",
 		"prohibited":           "The :attribute field is prohibited",
 		"prohibited_if":        "The :attribute field is prohibited when :param0 is :param1",
 		"prohibited_unless":    "The :attribute field is prohibited unless :param0 is :param1",
 		"prohibits":            "The :attribute field prohibits :param0 from being present",
+		"prohibited_keys":      "The :attribute field must not contain the keys :values",
 		"filled":               "The :attribute field must have a value",
 		"present":              "The :attribute field must be present",
 		"sometimes":            "The :attribute field is sometimes required",
@@ -165,13 +369,37 @@ func getDefaultMessages() map[string]string {
 		"numeric":              "The :attribute must be a number",
 		"integer":              "The :attribute must be an integer",
 		"multiple_of":          "The :attribute must be a multiple of :param0",
+		"money":                "The :attribute must be a valid amount",
 		"lowercase":            "The :attribute must be lowercase",
 		"uppercase":            "The :attribute must be uppercase",
 		"ulid":                 "The :attribute must be a valid ULID",
+		"upc_a":                "The :attribute must be a valid UPC-A code",
+		"gtin14":               "The :attribute must be a valid GTIN-14 code",
+		"color":                "The :attribute must be a valid color",
+		"md5":                  "The :attribute must be a valid MD5 hash",
+		"sha1":                 "The :attribute must be a valid SHA-1 hash",
+		"sha256":               "The :attribute must be a valid SHA-256 hash",
+		"sha512":               "The :attribute must be a valid SHA-512 hash",
+		"hash":                 "The :attribute must be a valid :param0 hash",
+		"git_sha":              "The :attribute must be a git commit SHA",
+		"git_ref":              "The :attribute must be a valid git branch or tag name",
+		"dns1123_label":        "The :attribute must be a lowercase DNS label, at most 63 characters",
+		"dns1123_subdomain":    "The :attribute must be a lowercase DNS subdomain, at most 253 characters",
+		"k8s_qualified_name":   "The :attribute must be a qualified name such as example.com/my-name",
+		"arn":                  "The :attribute must be a valid ARN",
+		"s3_bucket":            "The :attribute must be a valid S3 bucket name",
+		"locale":               "The :attribute must be a valid locale",
+		"mime_type":            "The :attribute must be a valid MIME type",
+		"header_name":          "The :attribute must be a valid HTTP header name",
+		"header_value":         "The :attribute must be a valid HTTP header value",
+		"cookie_name":          "The :attribute must be a valid cookie name",
+		"cookie_value":         "The :attribute must be a valid cookie value",
 		"slug":                 "The :attribute must be a valid slug",
 		"file":                 "The :attribute must be a file",
 		"image":                "The :attribute must be an image",
 		"mimes":                "The :attribute must be a file of type: :param0",
+		"extensions":           "The :attribute must have one of the following extensions: :values",
+		"filename":             "The :attribute must be a safe filename",
 		"min":                  "The :attribute must be at least :param0",
 		"max":                  "The :attribute may not be greater than :param0",
 		"size":                 "The :attribute must be :param0",
@@ -180,5 +408,11 @@ func getDefaultMessages() map[string]string {
 		"gte":                  "The :attribute must be greater than or equal to :param0",
 		"lte":                  "The :attribute must be less than or equal to :param0",
 		"same":                 "The :attribute and :param0 must match",
+		"in":                   "The selected :attribute is invalid",
+		"not_in":               "The selected :attribute is invalid",
+		"not_in_wordlist":      "The :attribute contains a disallowed word",
+		"distinct":             "The :attribute field has a duplicate value",
+		"regex":                "The :attribute format is invalid",
+		"not_regex":            "The :attribute format is invalid",
 	}
 }
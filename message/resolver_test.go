@@ -3,6 +3,8 @@ package message
 import (
 	"strings"
 	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
 )
 
 func TestResolver_DefaultAndCustomMessages(t *testing.T) {
@@ -66,3 +68,93 @@ func TestResolver_CloneIsolation(t *testing.T) {
 		t.Fatalf("attributes not isolated: orig=%q clone=%q", origMsg, clMsg)
 	}
 }
+
+func TestResolver_RegisterReplacer(t *testing.T) {
+	r := NewResolver()
+	r.RegisterReplacer("between", func(msg, attribute string, params []string) string {
+		return strings.ReplaceAll(msg, ":param1", params[1]+" KB")
+	})
+
+	msg := r.Resolve("between", "avatar", []string{"1", "512"})
+	if msg != "The avatar must be between 1 and 512 KB" {
+		t.Fatalf("unexpected replaced message: %q", msg)
+	}
+
+	// other rules are untouched and clones keep the replacer
+	if msg := r.Resolve("max", "avatar", []string{"512"}); strings.Contains(msg, "KB") {
+		t.Fatalf("replacer leaked into another rule: %q", msg)
+	}
+	clone := r.Clone().(*Resolver)
+	if msg := clone.Resolve("between", "avatar", []string{"1", "2"}); !strings.HasSuffix(msg, "2 KB") {
+		t.Fatalf("clone lost replacer: %q", msg)
+	}
+}
+
+func TestResolver_FallbackChain(t *testing.T) {
+	r := NewResolver()
+	ctx := contract.NewValidationContext("code", "x", []string{"3"}, nil)
+
+	// rule-provided message is used when no custom or catalog message exists
+	if msg := r.ResolveRule("custom_code", ctx, "The :attribute needs :param0 digits"); msg != "The code needs 3 digits" {
+		t.Fatalf("unexpected rule message: %q", msg)
+	}
+
+	// empty custom message does not produce an empty string
+	r.SetCustomMessage("custom_code", "")
+	if msg := r.ResolveRule("custom_code", ctx, ""); msg != "The code field is invalid" {
+		t.Fatalf("unexpected generic fallback: %q", msg)
+	}
+
+	// catalog messages win over the rule-provided message
+	if msg := r.ResolveRule("required", ctx, "ignored"); msg != "The code field is required" {
+		t.Fatalf("catalog message not preferred: %q", msg)
+	}
+
+	r.SetFallback(func(rule string) string { return "The :attribute failed " + rule })
+	clone := r.Clone().(contract.RuleMessageResolver)
+	if msg := clone.ResolveRule("custom_code", ctx, ""); msg != "The code failed custom_code" {
+		t.Fatalf("custom fallback not applied on clone: %q", msg)
+	}
+}
+
+func TestResolver_InputPlaceholder(t *testing.T) {
+	r := NewResolver()
+	r.SetCustomMessage("email", "The value :input is not a valid :attribute")
+	ctx := contract.NewValidationContext("email", "<script>:attribute</script>", nil, nil)
+
+	if msg := r.ResolveRule("email", ctx, ""); msg != "The value <script>:attribute</script> is not a valid email" {
+		t.Fatalf("unexpected raw message: %q", msg)
+	}
+
+	r.SetEscapeInput(true)
+	want := "The value &lt;script&gt;:attribute&lt;/script&gt; is not a valid email"
+	if msg := r.Clone().(contract.RuleMessageResolver).ResolveRule("email", ctx, ""); msg != want {
+		t.Fatalf("unexpected escaped message: %q", msg)
+	}
+}
+
+func TestResolver_CustomMessageFunc(t *testing.T) {
+	r := NewResolver()
+	r.SetCustomMessageFunc("min", func(ctx contract.RuleContext) string {
+		if s, ok := ctx.Value().(string); ok && s == "" {
+			return "The :attribute is empty"
+		}
+		return "The :attribute needs :param0 characters"
+	})
+
+	empty := contract.NewValidationContext("name", "", []string{"3"}, nil)
+	if msg := r.ResolveRule("min", empty, ""); msg != "The name is empty" {
+		t.Fatalf("unexpected message for empty value: %q", msg)
+	}
+	short := contract.NewValidationContext("name", "ab", []string{"3"}, nil)
+	clone := r.Clone().(contract.RuleMessageResolver)
+	if msg := clone.ResolveRule("min", short, ""); msg != "The name needs 3 characters" {
+		t.Fatalf("unexpected message for short value: %q", msg)
+	}
+
+	// a string message replaces the function
+	r.SetCustomMessage("min", "Too short")
+	if msg := r.Resolve("min", "name", []string{"3"}); msg != "Too short" {
+		t.Fatalf("expected string message to replace function, got %q", msg)
+	}
+}
@@ -0,0 +1,61 @@
+package message
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// templateDelimiter marks a message as a text/template instead of a plain colon-placeholder message
+const templateDelimiter = "{{"
+
+// TemplateData is the data a text/template message is executed with, e.g.
+// `The {{.Attribute}} must match {{.Other}}` or `{{.Attribute}} has {{len .Value}} items`.
+// Template messages replace colon placeholders; they are not substituted in the rendered text.
+type TemplateData struct {
+	// Attribute is the display name of the field under validation
+	Attribute string
+	// Field is the field path under validation
+	Field string
+	// Value is the value that failed validation
+	Value any
+	// Params holds the rule parameters
+	Params []string
+	// Other is the display name of the field named by the first parameter, for rules such as
+	// same or required_if that compare against another field
+	Other string
+}
+
+// isTemplate reports whether message uses text/template syntax
+func isTemplate(message string) bool {
+	return strings.Contains(message, templateDelimiter)
+}
+
+// templateData builds the template data for a rule evaluated in ctx
+func (r *Resolver) templateData(attribute string, ctx contract.RuleContext, input string) TemplateData {
+	data := TemplateData{
+		Attribute: attribute,
+		Field:     ctx.Field(),
+		Value:     r.messageValue(ctx.Value(), input),
+		Params:    ctx.Parameters(),
+	}
+	if len(data.Params) > 0 {
+		data.Other = r.attributeName(data.Params[0])
+	}
+	return data
+}
+
+// renderTemplate executes message as a text/template. It reports false when the message fails to
+// parse or execute, so the caller can still treat it as a colon-placeholder message.
+func renderTemplate(message string, data TemplateData) (string, bool) {
+	tmpl, err := template.New("message").Parse(message)
+	if err != nil {
+		return "", false
+	}
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", false
+	}
+	return out.String(), true
+}
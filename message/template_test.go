@@ -0,0 +1,54 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func TestResolver_TemplateMessages(t *testing.T) {
+	r := NewResolver()
+	r.SetCustomAttribute("password_confirmation", "password confirmation")
+	r.SetCustomMessage("same", "The {{.Attribute}} must match the {{.Other}}")
+	r.SetCustomMessage("max",
+		"{{.Attribute}} has {{len .Value}} items{{if gt (len .Value) 5}}, far{{end}} over the limit of {{index .Params 0}}")
+
+	ctx := contract.NewValidationContext("password", "x", []string{"password_confirmation"}, nil)
+	if msg := r.ResolveRule("same", ctx, ""); msg != "The password must match the password confirmation" {
+		t.Fatalf("unexpected same message: %q", msg)
+	}
+
+	tests := []struct {
+		value []any
+		want  string
+	}{
+		{value: []any{1, 2, 3, 4}, want: "tags has 4 items over the limit of 3"},
+		{value: []any{1, 2, 3, 4, 5, 6}, want: "tags has 6 items, far over the limit of 3"},
+	}
+	for _, tt := range tests {
+		ctx := contract.NewValidationContext("tags", tt.value, []string{"3"}, nil)
+		if msg := r.Clone().(contract.RuleMessageResolver).ResolveRule("max", ctx, ""); msg != tt.want {
+			t.Errorf("got %q, want %q", msg, tt.want)
+		}
+	}
+}
+
+func TestResolver_TemplateMessageFallsBackToPlaceholders(t *testing.T) {
+	r := NewResolver()
+	r.SetCustomMessage("min", "{{.Unknown}} :attribute needs :param0")
+
+	if msg := r.Resolve("min", "name", []string{"3"}); msg != "{{.Unknown}} name needs 3" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+}
+
+func TestResolver_TemplateMessageEscapesValue(t *testing.T) {
+	r := NewResolver()
+	r.SetEscapeInput(true)
+	r.SetCustomMessage("email", "{{.Value}} is not a valid {{.Attribute}}")
+
+	ctx := contract.NewValidationContext("email", "<b>x</b>", nil, nil)
+	if msg := r.ResolveRule("email", ctx, ""); msg != "&lt;b&gt;x&lt;/b&gt; is not a valid email" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+}
@@ -0,0 +1,9 @@
+// Package ndjson validates newline-delimited JSON (JSON Lines) streams document by document
+// against a rule set, reporting each invalid or malformed line to a callback, for log and
+// event pipeline hygiene checks.
+//
+//	stats, err := ndjson.Validate(v, os.Stdin, set, func(f ndjson.Failure) error {
+//		log.Printf("line %d: %v", f.Line, f.Result.Errors())
+//		return nil
+//	})
+package ndjson
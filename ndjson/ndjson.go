@@ -0,0 +1,93 @@
+package ndjson
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/validator"
+)
+
+// Failure is a line that failed validation (Result is set) or is not a JSON object (Err is set).
+// Line is 1-based and Raw holds the line without its newline.
+type Failure struct {
+	Line   int
+	Raw    []byte
+	Result contract.Result
+	Err    error
+}
+
+// FailureFunc handles one failure; returning an error stops the stream with that error
+type FailureFunc func(Failure) error
+
+// Stats counts the documents of a stream; blank lines are not counted
+type Stats struct {
+	Documents int
+	Valid     int
+	Invalid   int
+	Malformed int
+}
+
+// Validate reads r line by line, validates each JSON object against set with v and calls
+// onFailure for every invalid or malformed line. It returns when r is exhausted, on a read
+// error or when onFailure returns an error.
+func Validate(v *validator.Validator, r io.Reader, set *contract.RuleSet, onFailure FailureFunc) (Stats, error) {
+	var stats Stats
+	reader := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		raw, readErr := reader.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return stats, readErr
+		}
+		raw = bytes.TrimRight(raw, "\r\n")
+
+		if len(bytes.TrimSpace(raw)) > 0 {
+			if err := validateLine(v, set, line, raw, &stats, onFailure); err != nil {
+				return stats, err
+			}
+		}
+		if readErr != nil {
+			return stats, nil
+		}
+	}
+}
+
+// validateLine validates one non-blank line, updating stats and reporting its failure
+func validateLine(
+	v *validator.Validator,
+	set *contract.RuleSet,
+	line int,
+	raw []byte,
+	stats *Stats,
+	onFailure FailureFunc,
+) error {
+	stats.Documents++
+
+	var document map[string]any
+	if err := json.Unmarshal(raw, &document); err != nil || document == nil {
+		stats.Malformed++
+		if err == nil {
+			err = errors.New("ndjson: document is not a JSON object")
+		}
+		return report(onFailure, Failure{Line: line, Raw: raw, Err: fmt.Errorf("line %d: %w", line, err)})
+	}
+
+	result := v.ValidateRuleSet(document, set)
+	if result.IsValid() {
+		stats.Valid++
+		return nil
+	}
+	stats.Invalid++
+	return report(onFailure, Failure{Line: line, Raw: raw, Result: result})
+}
+
+func report(onFailure FailureFunc, failure Failure) error {
+	if onFailure == nil {
+		return nil
+	}
+	return onFailure(failure)
+}
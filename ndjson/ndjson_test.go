@@ -0,0 +1,61 @@
+package ndjson_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/ndjson"
+	"github.com/next-trace/scg-validator/validator"
+)
+
+func eventRules() *contract.RuleSet {
+	set := contract.NewRuleSet()
+	set.Rules["event"] = "required|in:click,view"
+	set.Rules["user.email"] = "required|email"
+	return set
+}
+
+func TestValidate_ReportsFailuresPerLine(t *testing.T) {
+	input := strings.Join([]string{
+		`{"event":"click","user":{"email":"a@b.co"}}`,
+		``,
+		`{"event":"drag","user":{"email":"a@b.co"}}`,
+		`not json`,
+		`[1,2]`,
+		`{"event":"view","user":{"email":"nope"}}`,
+	}, "\n")
+
+	var failures []ndjson.Failure
+	stats, err := ndjson.Validate(validator.New(), strings.NewReader(input), eventRules(), func(f ndjson.Failure) error {
+		failures = append(failures, f)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats != (ndjson.Stats{Documents: 5, Valid: 1, Invalid: 2, Malformed: 2}) {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+	if len(failures) != 4 || failures[0].Line != 3 || !failures[0].Result.HasFieldError("event") {
+		t.Fatalf("unexpected first failure: %+v", failures)
+	}
+	if failures[1].Line != 4 || failures[1].Err == nil || failures[2].Err == nil {
+		t.Fatalf("expected malformed lines to carry an error: %+v", failures[1:3])
+	}
+	if failures[3].Line != 6 || !failures[3].Result.HasFieldError("user.email") || string(failures[3].Raw) == "" {
+		t.Fatalf("unexpected last failure: %+v", failures[3])
+	}
+}
+
+func TestValidate_CallbackStopsStream(t *testing.T) {
+	stop := errors.New("stop")
+	input := "{\"event\":\"x\"}\r\n{\"event\":\"y\"}\r\n"
+	stats, err := ndjson.Validate(validator.New(), strings.NewReader(input), eventRules(), func(ndjson.Failure) error {
+		return stop
+	})
+	if !errors.Is(err, stop) || stats.Documents != 1 {
+		t.Fatalf("expected the callback error to stop after the first document, got %v, %+v", err, stats)
+	}
+}
@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"sort"
+	"strings"
+)
+
+// BailRuleName stops the validation of a field at its first failed rule
+const BailRuleName = "bail"
+
+// AST is the parsed form of a rule map: the rule nodes of each field, e.g. for linters,
+// documentation generators and code generators consuming rule definitions
+type AST map[string][]RuleNode
+
+// RuleNode is a single rule of a field's rule string
+type RuleNode struct {
+	Name    string   // Rule name (e.g., "required", "min", "between")
+	Params  []string // Rule parameters (e.g., ["5"] for "min:5")
+	Negated bool     // Rule was prefixed with "!" and its outcome is inverted
+	Warning bool     // Rule was prefixed with "warn:" and its failures are warnings
+	Bail    bool     // The field's rules stop at the first failure ("bail")
+	Raw     string   // Source text of the rule, e.g. "!in:admin,root"
+}
+
+// Parse parses every rule string of a rule map into an AST. The bail marker is not a node of its
+// own; it sets Bail on every node of its field.
+func Parse(rules map[string]string) AST {
+	ast := make(AST, len(rules))
+	for field, ruleString := range rules {
+		ast[field] = ParseField(ruleString)
+	}
+	return ast
+}
+
+// ParseField parses a single rule string into its rule nodes
+func ParseField(ruleString string) []RuleNode {
+	components := SplitRules(ruleString)
+	parsed := ParseRules(ruleString)
+
+	var nodes []RuleNode
+	bail := false
+	for i, rule := range parsed {
+		if rule.Name == BailRuleName {
+			bail = true
+			continue
+		}
+		nodes = append(nodes, RuleNode{
+			Name:    rule.Name,
+			Params:  rule.Params,
+			Negated: rule.Negated,
+			Warning: rule.Warning,
+			Raw:     components[i],
+		})
+	}
+	for i := range nodes {
+		nodes[i].Bail = bail
+	}
+	return nodes
+}
+
+// Fields returns the fields of the AST, sorted
+func (ast AST) Fields() []string {
+	fields := make([]string, 0, len(ast))
+	for field := range ast {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// Rules returns the distinct rule names used in the AST, sorted
+func (ast AST) Rules() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, nodes := range ast {
+		for _, node := range nodes {
+			if !seen[node.Name] {
+				seen[node.Name] = true
+				names = append(names, node.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// paramEscaper escapes the delimiters of a parameter
+var paramEscaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`, `"`, `\"`, `|`, `\|`)
+
+// String renders the node back into rule syntax, e.g. "warn:!min:3", escaping delimiters
+// inside parameters
+func (n RuleNode) String() string {
+	var out strings.Builder
+	if n.Warning {
+		out.WriteString(WarningPrefix)
+	}
+	if n.Negated {
+		out.WriteString(NegationPrefix)
+	}
+	out.WriteString(n.Name)
+	if len(n.Params) > 0 {
+		out.WriteByte(':')
+		for i, param := range n.Params {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			out.WriteString(paramEscaper.Replace(param))
+		}
+	}
+	return out.String()
+}
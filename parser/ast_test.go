@@ -0,0 +1,113 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	ast := Parse(map[string]string{
+		"email": "bail|required|email",
+		"role":  "warn:!in:admin,root",
+		"tags":  `regex:[a|b]|between:1,"x,y"`,
+	})
+
+	want := AST{
+		"email": {
+			{Name: "required", Bail: true, Raw: "required"},
+			{Name: "email", Bail: true, Raw: "email"},
+		},
+		"role": {
+			{Name: "in", Params: []string{"admin", "root"}, Negated: true, Warning: true, Raw: "warn:!in:admin,root"},
+		},
+		"tags": {
+			{Name: "regex", Params: []string{"[a|b]"}, Raw: "regex:[a|b]"},
+			{Name: "between", Params: []string{"1", "x,y"}, Raw: `between:1,"x,y"`},
+		},
+	}
+	if !reflect.DeepEqual(ast, want) {
+		t.Fatalf("Parse() = %#v, want %#v", ast, want)
+	}
+	if got := ast.Fields(); !reflect.DeepEqual(got, []string{"email", "role", "tags"}) {
+		t.Fatalf("Fields() = %v", got)
+	}
+	if got := ast.Rules(); !reflect.DeepEqual(got, []string{"between", "email", "in", "regex", "required"}) {
+		t.Fatalf("Rules() = %v", got)
+	}
+}
+
+func TestRuleNode_String(t *testing.T) {
+	node := RuleNode{Name: "in", Params: []string{"a,b", `say "hi"`, "x|y"}, Negated: true, Warning: true}
+	rendered := node.String()
+	if rendered != `warn:!in:a\,b,say \"hi\",x\|y` {
+		t.Fatalf("String() = %q", rendered)
+	}
+	parsed := ParseField(rendered)
+	if len(parsed) != 1 || !reflect.DeepEqual(parsed[0].Params, node.Params) || !parsed[0].Negated || !parsed[0].Warning {
+		t.Fatalf("expected the rendered node to parse back, got %#v", parsed)
+	}
+}
+
+func FuzzParseField(f *testing.F) {
+	for _, seed := range []string{
+		"required|email",
+		"bail|min:5|max:10",
+		"warn:!in:admin,root",
+		`regex:[^a|b]|between:"1,2",3`,
+		`not_regex:\d+\|x|in:a\,b`,
+		"regex:[a|required",
+		"|||",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, ruleString string) {
+		nodes := ParseField(ruleString)
+		parsed := ParseRules(ruleString)
+
+		bails := 0
+		for _, rule := range parsed {
+			if rule.Name == BailRuleName {
+				bails++
+			}
+		}
+		if len(nodes)+bails != len(parsed) {
+			t.Fatalf("%q: %d nodes and %d bail markers for %d rules", ruleString, len(nodes), bails, len(parsed))
+		}
+
+		for _, node := range nodes {
+			if node.Bail != (bails > 0) {
+				t.Fatalf("%q: Bail = %v with %d bail markers", ruleString, node.Bail, bails)
+			}
+			if !plainNode(node) {
+				continue
+			}
+			again := ParseField(node.String())
+			if len(again) != 1 || again[0].Name != node.Name || again[0].Negated != node.Negated ||
+				again[0].Warning != node.Warning || !sameParams(again[0].Params, node.Params) {
+				t.Fatalf("%q: node %#v renders as %q and parses back as %#v", ruleString, node, node.String(), again)
+			}
+		}
+	})
+}
+
+// sameParams compares parameters, treating nil and empty alike
+func sameParams(a, b []string) bool {
+	return len(a) == len(b) && (len(a) == 0 || reflect.DeepEqual(a, b))
+}
+
+// plainNode reports whether a node renders back into the same node: bracket sections and
+// surrounding or empty whitespace do not survive a round trip
+func plainNode(node RuleNode) bool {
+	if node.Name == "" || node.Name == BailRuleName || strings.TrimSpace(node.Name) != node.Name ||
+		strings.ContainsAny(node.Name, `[]|\"!:`) || strings.HasPrefix(node.Name, "warn") {
+		return false
+	}
+	for _, param := range node.Params {
+		if param == "" || strings.TrimSpace(param) != param || strings.ContainsAny(param, "[]") {
+			return false
+		}
+	}
+	return true
+}
@@ -1,2 +1,9 @@
 // Package parser includes the rule expression parser and related helpers.
+//
+// Tooling can consume rule definitions through the AST returned by Parse:
+//
+//	ast := parser.Parse(map[string]string{"email": "bail|required|email"})
+//	for _, node := range ast["email"] {
+//		fmt.Println(node.Name, node.Params, node.Bail)
+//	}
 package parser
@@ -6,10 +6,18 @@ import (
 
 // ParsedRule represents a parsed validator rule with its name and parameters
 type ParsedRule struct {
-	Name   string   // Rule name (e.g., "required", "min", "between")
-	Params []string // Rule parameters (e.g., ["5"] for "min:5")
+	Name    string   // Rule name (e.g., "required", "min", "between")
+	Params  []string // Rule parameters (e.g., ["5"] for "min:5")
+	Negated bool     // Rule was prefixed with "!" and its outcome is inverted
+	Warning bool     // Rule was prefixed with "warn:" and its failures are warnings
 }
 
+// NegationPrefix inverts the outcome of the rule it prefixes (e.g. "!in:admin,root")
+const NegationPrefix = "!"
+
+// WarningPrefix reports failures of the rule it prefixes as warnings (e.g. "warn:min:10")
+const WarningPrefix = "warn:"
+
 // ConditionalRule represents a conditional validator rule
 type ConditionalRule struct {
 	Field    string       // Field to check
@@ -23,6 +31,8 @@ type ConditionalRule struct {
 // - Simple rules: "required|email"
 // - Rules with parameters: "min:5|max:10"
 // - Rules with multiple parameters: "between:5,10"
+// - Negated rules: "!in:admin,root"
+// - Warning rules: "warn:min:10"
 func ParseRules(ruleString string) []ParsedRule {
 	if ruleString == "" {
 		return nil
@@ -35,10 +45,18 @@ func ParseRules(ruleString string) []ParsedRule {
 
 	for _, component := range ruleComponents {
 		parsedRule := ParsedRule{}
+		if rest, ok := strings.CutPrefix(component, WarningPrefix); ok {
+			parsedRule.Warning = true
+			component = strings.TrimSpace(rest)
+		}
 
 		// Split rule name and parameters
 		nameAndParams := strings.SplitN(component, ":", 2)
 		parsedRule.Name = strings.TrimSpace(nameAndParams[0])
+		if strings.HasPrefix(parsedRule.Name, NegationPrefix) {
+			parsedRule.Negated = true
+			parsedRule.Name = strings.TrimSpace(strings.TrimPrefix(parsedRule.Name, NegationPrefix))
+		}
 
 		if len(nameAndParams) == 2 {
 			// Handle parameters with commas inside quotes
@@ -52,6 +70,8 @@ func ParseRules(ruleString string) []ParsedRule {
 }
 
 // SplitRules splits a rule strings by pipe character, respecting escaped pipes
+// and pipes inside bracket-quoted parameters (e.g. "regex:[^a|b]|required").
+// A "[" without its "]" is taken literally, so it cannot swallow the rules after it.
 func SplitRules(ruleString string) []string {
 	// Return empty slice for empty strings
 	if ruleString == "" {
@@ -60,19 +80,35 @@ func SplitRules(ruleString string) []string {
 
 	var parts []string
 	var currentPart strings.Builder
+	matched := matchedBrackets(ruleString)
+	depth := 0
 
 	for i := 0; i < len(ruleString); i++ {
 		char := ruleString[i]
 
 		// Handle escape character
-		if char == '\\' && i+1 < len(ruleString) && ruleString[i+1] == '|' {
-			currentPart.WriteByte('|')
-			i++ // Skip the next character (the pipe)
+		if char == '\\' && i+1 < len(ruleString) {
+			if ruleString[i+1] == '|' {
+				currentPart.WriteByte('|')
+			} else {
+				// Keep other escapes for the parameter parser
+				currentPart.WriteByte(char)
+				currentPart.WriteByte(ruleString[i+1])
+			}
+			i++ // Skip the escaped character
 			continue
 		}
 
-		// If we hit a pipe, add part to list and reset
-		if char == '|' {
+		// Track bracket-quoted sections
+		switch {
+		case char == '[' && matched[i]:
+			depth++
+		case char == ']' && depth > 0:
+			depth--
+		}
+
+		// If we hit a pipe outside brackets, add part to list and reset
+		if char == '|' && depth == 0 {
 			parts = append(parts, strings.TrimSpace(currentPart.String()))
 			currentPart.Reset()
 			continue
@@ -90,7 +126,67 @@ func SplitRules(ruleString string) []string {
 	return parts
 }
 
-// parseParameters parses rule parameters, respecting quoted values and escaped characters
+// matchedBrackets returns the positions of the unescaped "[" closed by a later "]"
+func matchedBrackets(ruleString string) map[int]bool {
+	if !strings.Contains(ruleString, "[") {
+		return nil
+	}
+	var open []int
+	matched := make(map[int]bool)
+	for i := 0; i < len(ruleString); i++ {
+		switch ruleString[i] {
+		case '\\':
+			i++
+		case '[':
+			open = append(open, i)
+		case ']':
+			if n := len(open); n > 0 {
+				matched[open[n-1]] = true
+				open = open[:n-1]
+			}
+		}
+	}
+	return matched
+}
+
+// JoinRules joins rules split by SplitRules back into a rule string, escaping
+// pipes outside bracket-quoted parameters
+func JoinRules(rules []string) string {
+	var joined strings.Builder
+	for i, rule := range rules {
+		if i > 0 {
+			joined.WriteByte('|')
+		}
+		depth := 0
+		for j := 0; j < len(rule); j++ {
+			char := rule[j]
+			switch {
+			case char == '\\' && j+1 < len(rule):
+				joined.WriteByte(char)
+				joined.WriteByte(rule[j+1])
+				j++
+				continue
+			case char == '[':
+				depth++
+			case char == ']' && depth > 0:
+				depth--
+			case char == '|' && depth == 0:
+				joined.WriteByte('\\')
+			}
+			joined.WriteByte(char)
+		}
+	}
+	return joined.String()
+}
+
+// isEscapable reports whether a backslash before char is consumed by the parser.
+// Backslashes before any other character are preserved (e.g. "\d" in regex patterns).
+func isEscapable(char byte) bool {
+	return char == ',' || char == '"' || char == '|' || char == '\\'
+}
+
+// parseParameters parses rule parameters, respecting quoted values, bracket-quoted
+// values (e.g. "[a,b]") and escaped characters
 func parseParameters(paramString string) []string {
 	// Return empty slice for empty strings
 	if paramString == "" {
@@ -101,6 +197,7 @@ func parseParameters(paramString string) []string {
 	var currentParam strings.Builder
 	inQuotes := false
 	escaped := false
+	depth := 0
 
 	for i := 0; i < len(paramString); i++ {
 		char := paramString[i]
@@ -111,8 +208,13 @@ func parseParameters(paramString string) []string {
 			continue
 		}
 
+		// Preserve backslashes that do not escape a delimiter
+		if escaped && !isEscapable(char) {
+			currentParam.WriteByte('\\')
+		}
+
 		// Handle quotes
-		if char == '"' && !escaped {
+		if char == '"' && !escaped && depth == 0 {
 			inQuotes = !inQuotes
 			continue
 		}
@@ -124,8 +226,18 @@ func parseParameters(paramString string) []string {
 			continue
 		}
 
-		// If we hit a comma and not escaped, add parameter to list and reset
-		if char == ',' && !escaped {
+		// Track bracket-quoted sections
+		if !escaped {
+			switch {
+			case char == '[':
+				depth++
+			case char == ']' && depth > 0:
+				depth--
+			}
+		}
+
+		// If we hit a comma outside brackets and not escaped, add parameter to list and reset
+		if char == ',' && !escaped && depth == 0 {
 			params = append(params, strings.TrimSpace(currentParam.String()))
 			currentParam.Reset()
 			escaped = false
@@ -137,6 +249,11 @@ func parseParameters(paramString string) []string {
 		escaped = false
 	}
 
+	// Keep a trailing lone backslash
+	if escaped {
+		currentParam.WriteByte('\\')
+	}
+
 	// Add the last parameter
 	if currentParam.Len() > 0 {
 		params = append(params, strings.TrimSpace(currentParam.String()))
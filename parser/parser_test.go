@@ -52,6 +52,21 @@ func TestParseParameters(t *testing.T) {
 			input:    `value1,\"quoted\",value2`,
 			expected: []string{"value1", "\"quoted\"", "value2"},
 		},
+		{
+			name:     "backslash before regular character is preserved",
+			input:    `^\d+\.\d{2}$`,
+			expected: []string{`^\d+\.\d{2}$`},
+		},
+		{
+			name:     "bracket-quoted parameter keeps commas",
+			input:    `[^a,b]+,x`,
+			expected: []string{"[^a,b]+", "x"},
+		},
+		{
+			name:     "escaped bracket does not open a section",
+			input:    `\[a,b]`,
+			expected: []string{`\[a`, "b]"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -100,6 +115,21 @@ func TestSplitRules(t *testing.T) {
 			input:    "regex:\\|\\|test|required",
 			expected: []string{"regex:||test", "required"},
 		},
+		{
+			name:     "pipe inside brackets",
+			input:    "regex:[^a|b,c$]|required",
+			expected: []string{"regex:[^a|b,c$]", "required"},
+		},
+		{
+			name:     "unbalanced bracket",
+			input:    "regex:[a|required",
+			expected: []string{"regex:[a", "required"},
+		},
+		{
+			name:     "unbalanced bracket before a balanced one",
+			input:    "regex:[a|in:[x|y]|required",
+			expected: []string{"regex:[a", "in:[x|y]", "required"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -178,6 +208,14 @@ func TestParseRules(t *testing.T) {
 				{Name: "required", Params: nil},
 			},
 		},
+		{
+			name:  "bracket-quoted regex with delimiters",
+			input: `regex:[^a|b,c$]|in:a\,b,c`,
+			expected: []ParsedRule{
+				{Name: "regex", Params: []string{"[^a|b,c$]"}},
+				{Name: "in", Params: []string{"a,b", "c"}},
+			},
+		},
 		{
 			name:  "rule with quoted parameters",
 			input: `in:"value1,value2",value3`,
@@ -233,3 +271,40 @@ func TestDebugParseRules(t *testing.T) {
 		}
 	}
 }
+
+func TestParseRules_Negation(t *testing.T) {
+	rules := ParseRules("required|!in:admin,root| ! regex:^x")
+	if len(rules) != 3 {
+		t.Fatalf("expected 3 rules, got %d", len(rules))
+	}
+	if rules[0].Negated || rules[0].Name != "required" {
+		t.Fatalf("unexpected first rule: %#v", rules[0])
+	}
+	if !rules[1].Negated || rules[1].Name != "in" || len(rules[1].Params) != 2 {
+		t.Fatalf("unexpected negated in rule: %#v", rules[1])
+	}
+	if !rules[2].Negated || rules[2].Name != "regex" {
+		t.Fatalf("unexpected negated regex rule: %#v", rules[2])
+	}
+}
+
+func TestParseRules_Warning(t *testing.T) {
+	rules := ParseRules("required|warn:min:10|warn: !in:test")
+	if len(rules) != 3 || rules[0].Warning {
+		t.Fatalf("unexpected rules: %#v", rules)
+	}
+	if !rules[1].Warning || rules[1].Name != "min" || len(rules[1].Params) != 1 || rules[1].Params[0] != "10" {
+		t.Fatalf("unexpected warning rule: %#v", rules[1])
+	}
+	if !rules[2].Warning || !rules[2].Negated || rules[2].Name != "in" {
+		t.Fatalf("unexpected negated warning rule: %#v", rules[2])
+	}
+}
+
+func TestJoinRules(t *testing.T) {
+	for _, ruleString := range []string{`required|in:a\|b`, `regex:[^a|b]|min:1`, `regex:^\d+$|max:3`} {
+		if got := JoinRules(SplitRules(ruleString)); got != ruleString {
+			t.Errorf("JoinRules(SplitRules(%q)) = %q", ruleString, got)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package parser
+
+import (
+	"sort"
+	"strings"
+)
+
+// ScenarioSeparator separates a field from the scenarios its rules are tagged with,
+// e.g. "password@create,update"
+const ScenarioSeparator = "@"
+
+// SelectScenario returns the rules of scenario (e.g. "update"): untagged fields always apply,
+// tagged fields only in one of their scenarios. Rules of the same field are joined, untagged first.
+func SelectScenario(rules map[string]string, scenario string) map[string]string {
+	keys := make([]string, 0, len(rules))
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	selected := make(map[string]string, len(rules))
+	var tagged []string
+	for _, key := range keys {
+		field, scenarios, isTagged := strings.Cut(key, ScenarioSeparator)
+		if !isTagged {
+			selected[field] = joinRules(selected[field], rules[key])
+			continue
+		}
+		if hasScenario(scenarios, scenario) {
+			tagged = append(tagged, key)
+		}
+	}
+	for _, key := range tagged {
+		field, _, _ := strings.Cut(key, ScenarioSeparator)
+		selected[field] = joinRules(selected[field], rules[key])
+	}
+	return selected
+}
+
+// hasScenario reports whether the comma-separated scenario list contains scenario
+func hasScenario(scenarios, scenario string) bool {
+	for _, candidate := range strings.Split(scenarios, ",") {
+		if strings.TrimSpace(candidate) == scenario {
+			return true
+		}
+	}
+	return false
+}
+
+// joinRules concatenates two pipe-separated rule strings
+func joinRules(existing, addition string) string {
+	switch {
+	case existing == "":
+		return addition
+	case addition == "":
+		return existing
+	}
+	return existing + "|" + addition
+}
@@ -0,0 +1,32 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSelectScenario(t *testing.T) {
+	rules := map[string]string{
+		"email":                 "email",
+		"email@create":          "required",
+		"password@create":       "required|min:8",
+		"password@update":       "nullable|min:8",
+		"role@admin, update":    "in:admin,editor",
+		"nickname@create,admin": "",
+	}
+
+	tests := []struct {
+		scenario string
+		want     map[string]string
+	}{
+		{"create", map[string]string{"email": "email|required", "password": "required|min:8", "nickname": ""}},
+		{"update", map[string]string{"email": "email", "password": "nullable|min:8", "role": "in:admin,editor"}},
+		{"unknown", map[string]string{"email": "email"}},
+	}
+
+	for _, tc := range tests {
+		if got := SelectScenario(rules, tc.scenario); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("SelectScenario(%q) = %#v, want %#v", tc.scenario, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry/ruleset"
+	"github.com/next-trace/scg-validator/validator"
+)
+
+// ErrInvalidMessage is wrapped by the errors of messages that fail to unmarshal or validate
+var ErrInvalidMessage = errors.New("invalid message")
+
+// Handler consumes one message payload
+type Handler func(payload []byte) error
+
+// Unmarshaler decodes a payload into the data to validate
+type Unmarshaler func(payload []byte) (map[string]any, error)
+
+// DeadLetter receives the messages that failed validation; its error is returned to the broker
+type DeadLetter func(failure *MessageError) error
+
+// MessageError describes a message that could not be unmarshaled (Err is set) or failed
+// validation (Result is set)
+type MessageError struct {
+	Subject string
+	Payload []byte
+	Result  contract.Result
+	Err     error
+}
+
+// Error describes the failure with the first validation message
+func (e *MessageError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", ErrInvalidMessage, e.Subject, e.Err)
+	}
+	return fmt.Sprintf("%s: %s: %s", ErrInvalidMessage, e.Subject, e.Result.FirstError())
+}
+
+// Unwrap returns ErrInvalidMessage and the unmarshal error, if any
+func (e *MessageError) Unwrap() []error {
+	if e.Err != nil {
+		return []error{ErrInvalidMessage, e.Err}
+	}
+	return []error{ErrInvalidMessage}
+}
+
+// Consumer builds validating middleware for message handlers
+type Consumer struct {
+	validator  *validator.Validator
+	schemas    map[string]string
+	unmarshal  Unmarshaler
+	deadLetter DeadLetter
+}
+
+// Option configures a Consumer
+type Option func(*Consumer)
+
+// WithSchema validates the messages of subject against the rule set registered as name.
+// Without it, the rule set registered under the subject itself is used.
+func WithSchema(subject, name string) Option {
+	return func(c *Consumer) {
+		c.schemas[subject] = name
+	}
+}
+
+// WithUnmarshaler replaces the JSON decoding of payloads, e.g. for Avro or Protobuf bodies
+func WithUnmarshaler(unmarshal Unmarshaler) Option {
+	return func(c *Consumer) {
+		c.unmarshal = unmarshal
+	}
+}
+
+// WithDeadLetter routes failing messages to deadLetter instead of returning their error, so
+// the broker acknowledges them
+func WithDeadLetter(deadLetter DeadLetter) Option {
+	return func(c *Consumer) {
+		c.deadLetter = deadLetter
+	}
+}
+
+// NewConsumer creates a Consumer validating with v
+func NewConsumer(v *validator.Validator, options ...Option) *Consumer {
+	c := &Consumer{validator: v, schemas: make(map[string]string), unmarshal: unmarshalJSON}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// Middleware wraps next so it only receives the messages of subject that pass validation.
+// The registered rule set is reused as is for every message. A subject without a registered
+// rule set fails every message with contract.ErrRuleSetNotFound.
+func (c *Consumer) Middleware(subject string, next Handler) Handler {
+	name := subject
+	if schema, ok := c.schemas[subject]; ok {
+		name = schema
+	}
+
+	return func(payload []byte) error {
+		set, ok := ruleset.FindRuleSet(name)
+		if !ok {
+			return fmt.Errorf("%w: %s", contract.ErrRuleSetNotFound, name)
+		}
+
+		data, err := c.unmarshal(payload)
+		if err != nil {
+			return c.fail(&MessageError{Subject: subject, Payload: payload, Err: err})
+		}
+		if result := c.validator.ValidateRuleSet(data, set); !result.IsValid() {
+			return c.fail(&MessageError{Subject: subject, Payload: payload, Result: result})
+		}
+		return next(payload)
+	}
+}
+
+// fail hands failure to the dead-letter callback, or returns it
+func (c *Consumer) fail(failure *MessageError) error {
+	if c.deadLetter != nil {
+		return c.deadLetter(failure)
+	}
+	return failure
+}
+
+func unmarshalJSON(payload []byte) (map[string]any, error) {
+	var data map[string]any
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("payload is not a JSON object")
+	}
+	return data, nil
+}
@@ -0,0 +1,70 @@
+package queue_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/queue"
+	"github.com/next-trace/scg-validator/registry/ruleset"
+	"github.com/next-trace/scg-validator/validator"
+)
+
+func init() {
+	set := contract.NewRuleSet()
+	set.Rules["order_id"] = "required|integer"
+	set.Rules["email"] = "required|email"
+	ruleset.RegisterRuleSet("queue_test.orders", set)
+}
+
+func TestConsumer_RoutesFailuresToDeadLetter(t *testing.T) {
+	var dead []*queue.MessageError
+	consumer := queue.NewConsumer(validator.New(),
+		queue.WithSchema("orders.created", "queue_test.orders"),
+		queue.WithDeadLetter(func(failure *queue.MessageError) error {
+			dead = append(dead, failure)
+			return nil
+		}),
+	)
+
+	var handled int
+	handle := consumer.Middleware("orders.created", func([]byte) error {
+		handled++
+		return nil
+	})
+
+	for _, payload := range []string{
+		`{"order_id": 7, "email": "a@b.co"}`,
+		`{"order_id": "x", "email": "a@b.co"}`,
+		`{broken`,
+	} {
+		if err := handle([]byte(payload)); err != nil {
+			t.Fatalf("expected the dead-letter callback to absorb failures, got %v", err)
+		}
+	}
+
+	if handled != 1 || len(dead) != 2 {
+		t.Fatalf("expected one handled and two dead-lettered messages, got %d and %d", handled, len(dead))
+	}
+	if dead[0].Result == nil || !dead[0].Result.HasFieldError("order_id") || dead[0].Subject != "orders.created" {
+		t.Fatalf("unexpected validation failure: %+v", dead[0])
+	}
+	if dead[1].Err == nil || !errors.Is(dead[1], queue.ErrInvalidMessage) {
+		t.Fatalf("unexpected unmarshal failure: %+v", dead[1])
+	}
+}
+
+func TestConsumer_ReturnsErrorsWithoutDeadLetter(t *testing.T) {
+	consumer := queue.NewConsumer(validator.New())
+
+	err := consumer.Middleware("queue_test.orders", func([]byte) error { return nil })([]byte(`{}`))
+	var failure *queue.MessageError
+	if !errors.As(err, &failure) || !errors.Is(err, queue.ErrInvalidMessage) || failure.Result.IsValid() {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+
+	err = consumer.Middleware("unknown.subject", func([]byte) error { return nil })([]byte(`{}`))
+	if !errors.Is(err, contract.ErrRuleSetNotFound) {
+		t.Fatalf("expected an unknown subject to fail, got %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+// Package queue validates the bodies of queue messages (Kafka, NATS, SQS, ...) before they
+// reach a consumer. Middleware unmarshals each payload, validates it against the rule set
+// registered for its topic or subject, and routes failures to a dead-letter callback.
+//
+//	ruleset.RegisterRuleSet("orders.created", set)
+//	consumer := queue.NewConsumer(validator.New(), queue.WithDeadLetter(publishToDLQ))
+//	handle := consumer.Middleware("orders.created", processOrder)
+package queue
@@ -0,0 +1,42 @@
+package registry
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Comparator reports whether a, a value of the registered type, equals b.
+// b may be of another type, e.g. the string parameter of an "in" rule.
+type Comparator func(a, b any) bool
+
+var (
+	comparators    = make(map[reflect.Type]Comparator)
+	comparatorLock = &sync.RWMutex{}
+)
+
+// RegisterComparator registers the equality used by same, different, confirmed, in, not_in
+// and distinct for values of type t (e.g. UUID structs or money types).
+// This is intended to be called during application startup.
+func RegisterComparator(t reflect.Type, comparator func(a, b any) bool) {
+	comparatorLock.Lock()
+	defer comparatorLock.Unlock()
+
+	if t == nil || comparator == nil {
+		panic("nil comparator registered")
+	}
+	comparators[t] = comparator
+}
+
+// FindComparator finds the comparator registered for the dynamic type of value.
+// It returns the comparator and true if found, otherwise nil and false.
+func FindComparator(value any) (Comparator, bool) {
+	if value == nil {
+		return nil, false
+	}
+
+	comparatorLock.RLock()
+	defer comparatorLock.RUnlock()
+
+	comparator, ok := comparators[reflect.TypeOf(value)]
+	return comparator, ok
+}
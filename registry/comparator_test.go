@@ -0,0 +1,30 @@
+package registry
+
+import (
+	"reflect"
+	"testing"
+)
+
+type accountID struct{ hi, lo uint64 }
+
+func TestComparatorRegistry(t *testing.T) {
+	RegisterComparator(reflect.TypeOf(accountID{}), func(a, b any) bool {
+		other, ok := b.(accountID)
+		return ok && a.(accountID).lo == other.lo
+	})
+
+	comparator, ok := FindComparator(accountID{hi: 1, lo: 2})
+	if !ok {
+		t.Fatal("expected registered comparator")
+	}
+	if !comparator(accountID{hi: 1, lo: 2}, accountID{hi: 9, lo: 2}) {
+		t.Fatal("expected comparator to be used")
+	}
+
+	if _, ok := FindComparator(&accountID{}); ok {
+		t.Fatal("unexpected comparator for pointer type")
+	}
+	if _, ok := FindComparator(nil); ok {
+		t.Fatal("unexpected comparator for nil")
+	}
+}
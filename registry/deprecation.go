@@ -0,0 +1,80 @@
+package registry
+
+import (
+	"log"
+	"sort"
+	"sync"
+)
+
+// DeprecationHandler is notified the first time a deprecated rule name is used, e.g. to log
+// it or increment a metric
+type DeprecationHandler func(rule, replacement string)
+
+var (
+	deprecations       = make(map[string]string)
+	reportedDeprecated = make(map[string]bool)
+	deprecationHandler = DeprecationHandler(logDeprecation)
+	deprecationLock    = &sync.RWMutex{}
+)
+
+// Deprecate renames rule to replacement while keeping rule working: rule strings using the old
+// name validate with replacement, the first use is reported to the DeprecationHandler, and
+// rule plans (Explain) carry the replacement.
+// This is intended to be called during application startup.
+func Deprecate(rule, replacement string) {
+	deprecationLock.Lock()
+	defer deprecationLock.Unlock()
+	if rule == "" || replacement == "" {
+		panic("empty rule name deprecated")
+	}
+	deprecations[rule] = replacement
+}
+
+// FindDeprecation finds the replacement of a deprecated rule name
+func FindDeprecation(rule string) (string, bool) {
+	deprecationLock.RLock()
+	defer deprecationLock.RUnlock()
+	replacement, ok := deprecations[rule]
+	return replacement, ok
+}
+
+// Deprecations returns the deprecated rule names, sorted
+func Deprecations() []string {
+	deprecationLock.RLock()
+	defer deprecationLock.RUnlock()
+	names := make([]string, 0, len(deprecations))
+	for name := range deprecations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetDeprecationHandler replaces how first uses of deprecated rules are reported (a log line by
+// default); nil silences them
+func SetDeprecationHandler(handler DeprecationHandler) {
+	deprecationLock.Lock()
+	defer deprecationLock.Unlock()
+	deprecationHandler = handler
+}
+
+// ReportDeprecated notifies the DeprecationHandler of a use of the deprecated rule, once per rule
+// name for the lifetime of the process
+func ReportDeprecated(rule string) {
+	deprecationLock.Lock()
+	replacement, deprecated := deprecations[rule]
+	first := deprecated && !reportedDeprecated[rule]
+	if first {
+		reportedDeprecated[rule] = true
+	}
+	handler := deprecationHandler
+	deprecationLock.Unlock()
+
+	if first && handler != nil {
+		handler(rule, replacement)
+	}
+}
+
+func logDeprecation(rule, replacement string) {
+	log.Printf("scg-validator: rule %q is deprecated, use %q instead", rule, replacement)
+}
@@ -0,0 +1,28 @@
+package registry
+
+import (
+	"sync"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+var (
+	disposableProvider     contract.DisposableEmailProvider
+	disposableProviderLock = &sync.RWMutex{}
+)
+
+// SetDisposableEmailProvider replaces the provider the email_not_disposable rule consults, e.g. a
+// client of a reputation API; nil restores the bundled blocklist (format.DisposableDomains).
+// This is intended to be called during application startup.
+func SetDisposableEmailProvider(provider contract.DisposableEmailProvider) {
+	disposableProviderLock.Lock()
+	defer disposableProviderLock.Unlock()
+	disposableProvider = provider
+}
+
+// FindDisposableEmailProvider returns the provider set with SetDisposableEmailProvider
+func FindDisposableEmailProvider() (contract.DisposableEmailProvider, bool) {
+	disposableProviderLock.RLock()
+	defer disposableProviderLock.RUnlock()
+	return disposableProvider, disposableProvider != nil
+}
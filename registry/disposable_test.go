@@ -0,0 +1,28 @@
+package registry
+
+import (
+	"context"
+	"testing"
+)
+
+type fakeDisposableProvider struct{}
+
+func (fakeDisposableProvider) IsDisposable(_ context.Context, domain string) (bool, error) {
+	return domain == "burner.example", nil
+}
+
+func TestDisposableEmailProviderRegistry(t *testing.T) {
+	if _, ok := FindDisposableEmailProvider(); ok {
+		t.Fatal("unexpected provider by default")
+	}
+	SetDisposableEmailProvider(fakeDisposableProvider{})
+	defer SetDisposableEmailProvider(nil)
+
+	provider, ok := FindDisposableEmailProvider()
+	if !ok {
+		t.Fatal("expected the registered provider")
+	}
+	if disposable, _ := provider.IsDisposable(context.Background(), "burner.example"); !disposable {
+		t.Fatal("expected the registered provider to be used")
+	}
+}
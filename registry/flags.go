@@ -0,0 +1,62 @@
+package registry
+
+import (
+	"sort"
+	"sync"
+)
+
+// DisabledBehavior is the outcome of a rule disabled with Disable
+type DisabledBehavior int
+
+const (
+	// DisabledPass lets the disabled rule pass without evaluating it
+	DisabledPass DisabledBehavior = iota
+	// DisabledFail fails the disabled rule with its usual message
+	DisabledFail
+	// DisabledWarn reports the disabled rule as a warning, leaving the result valid
+	DisabledWarn
+)
+
+var (
+	disabledRules    = make(map[string]DisabledBehavior)
+	disabledRuleLock = &sync.RWMutex{}
+)
+
+// Disable turns rule off at runtime, e.g. an operationally risky rule such as "active_url",
+// without redeploying. Disabled rules are not evaluated; behavior selects their outcome
+// (DisabledPass when omitted). It is safe to call while validations run.
+func Disable(rule string, behavior ...DisabledBehavior) {
+	disabledRuleLock.Lock()
+	defer disabledRuleLock.Unlock()
+	disabledRules[rule] = DisabledPass
+	if len(behavior) > 0 {
+		disabledRules[rule] = behavior[0]
+	}
+}
+
+// Enable turns a rule disabled with Disable back on
+func Enable(rule string) {
+	disabledRuleLock.Lock()
+	defer disabledRuleLock.Unlock()
+	delete(disabledRules, rule)
+}
+
+// FindDisabled reports whether rule is disabled and with which behavior
+func FindDisabled(rule string) (DisabledBehavior, bool) {
+	disabledRuleLock.RLock()
+	defer disabledRuleLock.RUnlock()
+	behavior, ok := disabledRules[rule]
+	return behavior, ok
+}
+
+// DisabledRules returns the names of the disabled rules, sorted
+func DisabledRules() []string {
+	disabledRuleLock.RLock()
+	defer disabledRuleLock.RUnlock()
+	names := make([]string, 0, len(disabledRules))
+	for name := range disabledRules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,28 @@
+package registry
+
+import (
+	"sync"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+var (
+	mxResolver     = defaultMXResolver()
+	mxResolverLock = &sync.RWMutex{}
+)
+
+// SetMXResolver replaces the resolver the email rule's dns mode checks domains with: the system
+// resolver by default, none in builds with the scgcore or tinygo tag. nil removes it, failing
+// dns validations. This is intended to be called during application startup.
+func SetMXResolver(resolver contract.MXResolver) {
+	mxResolverLock.Lock()
+	defer mxResolverLock.Unlock()
+	mxResolver = resolver
+}
+
+// FindMXResolver returns the resolver set with SetMXResolver
+func FindMXResolver() (contract.MXResolver, bool) {
+	mxResolverLock.RLock()
+	defer mxResolverLock.RUnlock()
+	return mxResolver, mxResolver != nil
+}
@@ -0,0 +1,10 @@
+//go:build scgcore || tinygo
+
+package registry
+
+import "github.com/next-trace/scg-validator/contract"
+
+// defaultMXResolver returns no resolver in the core build, which does not perform DNS lookups
+func defaultMXResolver() contract.MXResolver {
+	return nil
+}
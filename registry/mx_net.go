@@ -0,0 +1,37 @@
+//go:build !scgcore && !tinygo
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// netMXResolver looks up mail exchangers with the system resolver
+type netMXResolver struct{}
+
+// defaultMXResolver returns the system resolver
+func defaultMXResolver() contract.MXResolver {
+	return netMXResolver{}
+}
+
+// LookupMX returns the MX hosts of domain without their trailing dot; an unknown domain has none
+func (netMXResolver) LookupMX(ctx context.Context, domain string) ([]string, error) {
+	records, err := net.DefaultResolver.LookupMX(ctx, domain)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	hosts := make([]string, 0, len(records))
+	for _, record := range records {
+		hosts = append(hosts, strings.TrimSuffix(record.Host, "."))
+	}
+	return hosts, nil
+}
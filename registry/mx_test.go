@@ -0,0 +1,34 @@
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func TestMXResolverRegistry(t *testing.T) {
+	// The core build has no default resolver, the regular build uses the system resolver
+	original, ok := FindMXResolver()
+	if want := defaultMXResolver() != nil; ok != want {
+		t.Fatalf("expected a default resolver: %v, got %v", want, ok)
+	}
+	defer SetMXResolver(original)
+
+	SetMXResolver(contract.MXResolverFunc(func(_ context.Context, domain string) ([]string, error) {
+		return []string{"mx." + domain}, nil
+	}))
+	resolver, ok := FindMXResolver()
+	if !ok {
+		t.Fatal("expected the registered resolver")
+	}
+	hosts, err := resolver.LookupMX(context.Background(), "example.com")
+	if err != nil || len(hosts) != 1 || hosts[0] != "mx.example.com" {
+		t.Fatalf("unexpected lookup: %v %v", hosts, err)
+	}
+
+	SetMXResolver(nil)
+	if _, ok := FindMXResolver(); ok {
+		t.Fatal("expected no resolver after removing it")
+	}
+}
@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"sync"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// Layered is a registry overlaying a shared base registry, e.g. with the policy of one tenant:
+// overlay rules extend or override the base, and disabled base rules are hidden. Register only
+// writes to the overlay, so the base is never modified.
+type Layered struct {
+	base contract.Registry
+
+	mu          sync.RWMutex
+	overlay     map[string]contract.RuleCreator
+	disabled    map[string]bool
+	includeOnly map[string]bool
+}
+
+// Ensure Layered implements contract.Registry
+var _ contract.Registry = (*Layered)(nil)
+
+// NewLayered creates a registry over base applying config: CustomRules form the overlay,
+// ExcludeRules are disabled and a non-empty IncludeOnly hides every other base rule
+func NewLayered(base contract.Registry, config contract.Config) *Layered {
+	l := &Layered{
+		base:        base,
+		overlay:     make(map[string]contract.RuleCreator, len(config.CustomRules)),
+		disabled:    make(map[string]bool, len(config.ExcludeRules)),
+		includeOnly: make(map[string]bool, len(config.IncludeOnly)),
+	}
+	for name, creator := range config.CustomRules {
+		l.overlay[name] = creator
+	}
+	for name, excluded := range config.ExcludeRules {
+		l.disabled[name] = excluded
+	}
+	for name, included := range config.IncludeOnly {
+		l.includeOnly[name] = included
+	}
+	return l
+}
+
+// Register adds a rule creator to the overlay
+func (l *Layered) Register(name string, creator contract.RuleCreator) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.overlay[name] = creator
+	return nil
+}
+
+// Get retrieves a rule creator from the overlay, then from the base unless it is hidden
+func (l *Layered) Get(name string) (contract.RuleCreator, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if creator, ok := l.overlay[name]; ok {
+		return creator, true
+	}
+	if !l.visible(name) {
+		return nil, false
+	}
+	return l.base.Get(name)
+}
+
+// Has checks if a rule with the given name is available
+func (l *Layered) Has(name string) bool {
+	_, exists := l.Get(name)
+	return exists
+}
+
+// List returns the names of the overlay and visible base rules
+func (l *Layered) List() []string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	names := make([]string, 0, len(l.overlay))
+	for name := range l.overlay {
+		names = append(names, name)
+	}
+	for _, name := range l.base.List() {
+		if _, overridden := l.overlay[name]; !overridden && l.visible(name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Count returns the number of available rules
+func (l *Layered) Count() int {
+	return len(l.List())
+}
+
+// Clone creates a copy of the overlay sharing the same base
+func (l *Layered) Clone() contract.Registry {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return NewLayered(l.base, contract.Config{
+		CustomRules:  l.overlay,
+		ExcludeRules: l.disabled,
+		IncludeOnly:  l.includeOnly,
+	})
+}
+
+// visible reports whether a base rule is neither disabled nor left out by IncludeOnly
+func (l *Layered) visible(name string) bool {
+	if l.disabled[name] {
+		return false
+	}
+	return len(l.includeOnly) == 0 || l.includeOnly[name]
+}
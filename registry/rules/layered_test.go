@@ -0,0 +1,39 @@
+package rules
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func TestLayered_OverlaysBase(t *testing.T) {
+	creator := func(_ []string) (contract.Rule, error) { return dummyRule{}, nil }
+	base := NewRegistry()
+	for _, name := range []string{"email", "url", "min"} {
+		_ = base.Register(name, creator)
+	}
+
+	layered := NewLayered(base, contract.Config{
+		CustomRules:  map[string]contract.RuleCreator{"vat": creator},
+		ExcludeRules: map[string]bool{"url": true},
+	})
+	if !layered.Has("vat") || !layered.Has("email") || layered.Has("url") {
+		t.Fatal("expected the overlay and visible base rules only")
+	}
+	names := layered.List()
+	sort.Strings(names)
+	if len(names) != 3 || names[0] != "email" || names[1] != "min" || names[2] != "vat" || layered.Count() != 3 {
+		t.Fatalf("unexpected rules: %v", names)
+	}
+
+	_ = layered.Clone().Register("extra", creator)
+	if layered.Has("extra") || base.Has("vat") {
+		t.Fatal("expected overlays not to leak into clones or the base")
+	}
+
+	only := NewLayered(base, contract.Config{IncludeOnly: map[string]bool{"min": true}})
+	if only.Has("email") || !only.Has("min") {
+		t.Fatal("expected IncludeOnly to hide the other base rules")
+	}
+}
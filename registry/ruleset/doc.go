@@ -0,0 +1,2 @@
+// Package ruleset holds named, reusable rule sets shared across handlers and tests.
+package ruleset
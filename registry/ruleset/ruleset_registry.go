@@ -0,0 +1,87 @@
+package ruleset
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// Unversioned is the version RegisterRuleSet registers under
+const Unversioned = 0
+
+var (
+	ruleSets    = make(map[string]map[int]*contract.RuleSet)
+	ruleSetLock = &sync.RWMutex{}
+)
+
+// RegisterRuleSet registers a rule set under a name such as "user.create",
+// replacing any unversioned rule set previously registered under that name.
+// This is intended to be called during application startup.
+func RegisterRuleSet(name string, set *contract.RuleSet) {
+	RegisterVersion(name, Unversioned, set)
+}
+
+// RegisterVersion registers version of the rule set name (e.g. version 2 of "user.create"),
+// replacing any rule set previously registered under that name and version.
+// This is intended to be called during application startup.
+func RegisterVersion(name string, version int, set *contract.RuleSet) {
+	ruleSetLock.Lock()
+	defer ruleSetLock.Unlock()
+	if set == nil {
+		panic("nil rule set registered")
+	}
+	if ruleSets[name] == nil {
+		ruleSets[name] = make(map[int]*contract.RuleSet)
+	}
+	ruleSets[name][version] = set
+}
+
+// FindRuleSet finds the latest registered version of a rule set by name.
+func FindRuleSet(name string) (*contract.RuleSet, bool) {
+	ruleSetLock.RLock()
+	defer ruleSetLock.RUnlock()
+	versions := ruleSets[name]
+	if len(versions) == 0 {
+		return nil, false
+	}
+	latest, found := 0, false
+	for version := range versions {
+		if !found || version > latest {
+			latest, found = version, true
+		}
+	}
+	return versions[latest], true
+}
+
+// FindVersion finds a specific version of a registered rule set.
+func FindVersion(name string, version int) (*contract.RuleSet, bool) {
+	ruleSetLock.RLock()
+	defer ruleSetLock.RUnlock()
+	set, ok := ruleSets[name][version]
+	return set, ok
+}
+
+// Versions returns the registered versions of a rule set, sorted.
+func Versions(name string) []int {
+	ruleSetLock.RLock()
+	defer ruleSetLock.RUnlock()
+	versions := make([]int, 0, len(ruleSets[name]))
+	for version := range ruleSets[name] {
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+	return versions
+}
+
+// Names returns the names of all registered rule sets, sorted.
+func Names() []string {
+	ruleSetLock.RLock()
+	defer ruleSetLock.RUnlock()
+	names := make([]string, 0, len(ruleSets))
+	for name := range ruleSets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,52 @@
+package ruleset
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func TestRuleSetRegistry(t *testing.T) {
+	set := contract.NewRuleSet()
+	set.Rules["email"] = "required|email"
+	RegisterRuleSet("user.create", set)
+
+	got, ok := FindRuleSet("user.create")
+	if !ok || got.Rules["email"] != "required|email" {
+		t.Fatalf("expected registered rule set, got %#v", got)
+	}
+	if _, ok := FindRuleSet("missing"); ok {
+		t.Fatal("unexpected ok for missing rule set")
+	}
+	if names := Names(); len(names) == 0 || names[0] != "user.create" {
+		t.Fatalf("unexpected names: %v", names)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for nil rule set")
+		}
+	}()
+	RegisterRuleSet("nil", nil)
+}
+
+func TestRuleSetRegistry_Versions(t *testing.T) {
+	v1, v2 := contract.NewRuleSet(), contract.NewRuleSet()
+	v1.Rules["name"] = "required"
+	v2.Rules["name"] = "required|max:50"
+	RegisterVersion("profile.update", 2, v2)
+	RegisterVersion("profile.update", 1, v1)
+
+	if got, ok := FindVersion("profile.update", 1); !ok || got != v1 {
+		t.Fatalf("expected version 1, got %#v", got)
+	}
+	if got, ok := FindRuleSet("profile.update"); !ok || got != v2 {
+		t.Fatalf("expected latest version, got %#v", got)
+	}
+	if _, ok := FindVersion("profile.update", 3); ok {
+		t.Fatal("unexpected ok for missing version")
+	}
+	if versions := Versions("profile.update"); len(versions) != 2 || versions[0] != 1 || versions[1] != 2 {
+		t.Fatalf("unexpected versions: %v", versions)
+	}
+}
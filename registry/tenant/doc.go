@@ -0,0 +1,3 @@
+// Package tenant holds per-tenant validation policies (custom rules, custom messages and
+// disabled rules) layered over the shared rule registry for multi-tenant applications.
+package tenant
@@ -0,0 +1,46 @@
+package tenant
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+var (
+	tenants    = make(map[string]contract.Config)
+	tenantLock = &sync.RWMutex{}
+)
+
+// RegisterTenant registers the policy of tenant id, replacing any previous one: its CustomRules
+// add or override rules, its CustomMessages override messages (by rule or "<rule>.<field>"),
+// its ExcludeRules disable rules and a non-empty IncludeOnly limits the shared rules.
+// This is intended to be called during application startup.
+func RegisterTenant(id string, config contract.Config) {
+	tenantLock.Lock()
+	defer tenantLock.Unlock()
+	if id == "" {
+		panic("empty tenant id registered")
+	}
+	tenants[id] = config
+}
+
+// FindTenant finds the policy of tenant id
+func FindTenant(id string) (contract.Config, bool) {
+	tenantLock.RLock()
+	defer tenantLock.RUnlock()
+	config, ok := tenants[id]
+	return config, ok
+}
+
+// IDs returns the ids of all registered tenants, sorted
+func IDs() []string {
+	tenantLock.RLock()
+	defer tenantLock.RUnlock()
+	ids := make([]string, 0, len(tenants))
+	for id := range tenants {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,39 @@
+package registry
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+var (
+	typeHandlers    = make(map[reflect.Type]contract.TypeHandler)
+	typeHandlerLock = &sync.RWMutex{}
+)
+
+// RegisterTypeHandler registers how rules read values of type t.
+// This is intended to be called during application startup.
+func RegisterTypeHandler(t reflect.Type, handler contract.TypeHandler) {
+	typeHandlerLock.Lock()
+	defer typeHandlerLock.Unlock()
+
+	if t == nil {
+		panic("type handler registered for nil type")
+	}
+	typeHandlers[t] = handler
+}
+
+// FindTypeHandler finds the handler registered for the dynamic type of value.
+// It returns the handler and true if found, otherwise a zero handler and false.
+func FindTypeHandler(value any) (contract.TypeHandler, bool) {
+	if value == nil {
+		return contract.TypeHandler{}, false
+	}
+
+	typeHandlerLock.RLock()
+	defer typeHandlerLock.RUnlock()
+
+	handler, ok := typeHandlers[reflect.TypeOf(value)]
+	return handler, ok
+}
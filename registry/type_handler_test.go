@@ -0,0 +1,31 @@
+package registry
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+type sku struct{ code string }
+
+func TestTypeHandlerRegistry(t *testing.T) {
+	RegisterTypeHandler(reflect.TypeOf(sku{}), contract.TypeHandler{
+		String: func(value any) (string, bool) { return value.(sku).code, true },
+	})
+
+	handler, ok := FindTypeHandler(sku{code: "A-1"})
+	if !ok || handler.String == nil {
+		t.Fatal("expected registered handler")
+	}
+	if str, _ := handler.String(sku{code: "A-1"}); str != "A-1" {
+		t.Fatalf("unexpected string form %q", str)
+	}
+
+	if _, ok := FindTypeHandler("A-1"); ok {
+		t.Fatal("unexpected handler for string")
+	}
+	if _, ok := FindTypeHandler(nil); ok {
+		t.Fatal("unexpected handler for nil")
+	}
+}
@@ -0,0 +1,3 @@
+// Package wordlist holds the named word lists of the not_in_wordlist rule (profanity, reserved
+// usernames, brand names, ...).
+package wordlist
@@ -0,0 +1,48 @@
+package wordlist
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	wordLists    = make(map[string][]string)
+	wordListLock = &sync.RWMutex{}
+)
+
+// RegisterWordList registers words under a list name such as "profanity", replacing any list
+// previously registered under that name. Words are matched case-insensitively; blank ones are
+// dropped. This is intended to be called during application startup.
+func RegisterWordList(name string, words ...string) {
+	list := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			list = append(list, word)
+		}
+	}
+
+	wordListLock.Lock()
+	defer wordListLock.Unlock()
+	wordLists[name] = list
+}
+
+// FindWordList finds a registered word list by name. Its words are lowercased.
+func FindWordList(name string) ([]string, bool) {
+	wordListLock.RLock()
+	defer wordListLock.RUnlock()
+	words, ok := wordLists[name]
+	return words, ok
+}
+
+// WordLists returns the names of the registered word lists, sorted.
+func WordLists() []string {
+	wordListLock.RLock()
+	defer wordListLock.RUnlock()
+	names := make([]string, 0, len(wordLists))
+	for name := range wordLists {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
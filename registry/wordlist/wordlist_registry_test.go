@@ -0,0 +1,26 @@
+package wordlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWordListRegistry(t *testing.T) {
+	RegisterWordList("reserved", " Admin ", "", "root")
+	words, ok := FindWordList("reserved")
+	if !ok || !reflect.DeepEqual(words, []string{"admin", "root"}) {
+		t.Fatalf("unexpected word list: %v %v", words, ok)
+	}
+
+	RegisterWordList("reserved", "support")
+	if words, _ := FindWordList("reserved"); !reflect.DeepEqual(words, []string{"support"}) {
+		t.Fatalf("expected the list to be replaced, got %v", words)
+	}
+	RegisterWordList("profanity")
+	if names := WordLists(); !reflect.DeepEqual(names, []string{"profanity", "reserved"}) {
+		t.Fatalf("unexpected names: %v", names)
+	}
+	if _, ok := FindWordList("missing"); ok {
+		t.Fatal("unexpected list")
+	}
+}
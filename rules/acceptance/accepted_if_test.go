@@ -65,3 +65,12 @@ func TestAcceptedIfRule_InvalidParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestAcceptedIfRule_NestedCondition(t *testing.T) {
+	rule, _ := NewAcceptedIfRule([]string{"account.status", "active"})
+	data := map[string]any{"account": map[string]any{"status": "active"}}
+
+	if err := rule.Validate(contract.NewValidationContext("terms", "no", nil, data)); err == nil {
+		t.Error("expected the nested condition to require acceptance")
+	}
+}
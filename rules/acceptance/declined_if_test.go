@@ -80,3 +80,12 @@ func TestDeclinedIfRule_InvalidParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestDeclinedIfRule_NestedCondition(t *testing.T) {
+	rule, _ := NewDeclinedIfRule([]string{"account.status", "inactive"})
+	data := map[string]any{"account": map[string]any{"status": "inactive"}}
+
+	if err := rule.Validate(contract.NewValidationContext("newsletter", "yes", nil, data)); err == nil {
+		t.Error("expected the nested condition to require declining")
+	}
+}
@@ -0,0 +1,19 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/next-trace/scg-validator/registry"
+)
+
+// ValuesEqual compares two field values using the comparator registered for the type of
+// either of them (see registry.RegisterComparator), falling back to their %v representations.
+func ValuesEqual(a, b any) bool {
+	if comparator, ok := registry.FindComparator(a); ok {
+		return comparator(a, b)
+	}
+	if comparator, ok := registry.FindComparator(b); ok {
+		return comparator(b, a)
+	}
+	return fmt.Sprintf("%v", a) == fmt.Sprintf("%v", b)
+}
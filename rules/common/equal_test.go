@@ -0,0 +1,43 @@
+package common
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/next-trace/scg-validator/registry"
+)
+
+// caseInsensitiveID compares case-insensitively with other IDs and strings
+type caseInsensitiveID struct{ value string }
+
+func TestValuesEqual(t *testing.T) {
+	registry.RegisterComparator(reflect.TypeOf(caseInsensitiveID{}), func(a, b any) bool {
+		switch other := b.(type) {
+		case caseInsensitiveID:
+			return strings.EqualFold(a.(caseInsensitiveID).value, other.value)
+		case string:
+			return strings.EqualFold(a.(caseInsensitiveID).value, other)
+		}
+		return false
+	})
+
+	tests := []struct {
+		name string
+		a, b any
+		want bool
+	}{
+		{"fallback - same representation", 1, "1", true},
+		{"fallback - different", "a", "b", false},
+		{"comparator - both registered", caseInsensitiveID{"ABC"}, caseInsensitiveID{"abc"}, true},
+		{"comparator - registered on the left", caseInsensitiveID{"ABC"}, "abc", true},
+		{"comparator - registered on the right", "abc", caseInsensitiveID{"ABC"}, true},
+		{"comparator - mismatch", caseInsensitiveID{"ABC"}, 7, false},
+	}
+
+	for _, tc := range tests {
+		if got := ValuesEqual(tc.a, tc.b); got != tc.want {
+			t.Errorf("%s: ValuesEqual(%v, %v) = %v, want %v", tc.name, tc.a, tc.b, got, tc.want)
+		}
+	}
+}
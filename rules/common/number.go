@@ -0,0 +1,114 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// ErrNotExact is returned for numbers that cannot be represented exactly, e.g. a json.Number
+// or decimal string with an exponent too large to compare ("1e5000000")
+var ErrNotExact = errors.New("number cannot be represented exactly")
+
+// ExactNumber returns values that can be represented exactly as rationals: json.Number,
+// contract.ExactNumber, math/big numbers and all integer kinds, so uint64 IDs or 256-bit
+// balances are never rounded through float64. ok reports whether value is such a number;
+// err is set when it is one but holds no usable value (a nil *big.Int, an unparsable
+// json.Number), so numeric rules fail instead of falling back to its string length.
+func ExactNumber(value any) (exact *big.Rat, ok bool, err error) {
+	switch v := value.(type) {
+	case json.Number:
+		if exact, numeric, err := ParseDecimal(v.String()); numeric {
+			return exact, true, err
+		}
+		return nil, true, ErrNotExact
+	case contract.ExactNumber:
+		if rat := v.Rat(); rat != nil {
+			return rat, true, nil
+		}
+		return nil, true, ErrNotExact
+	case *big.Int:
+		if v != nil {
+			return new(big.Rat).SetInt(v), true, nil
+		}
+		return nil, true, ErrNotExact
+	case big.Int:
+		return new(big.Rat).SetInt(&v), true, nil
+	case *big.Float:
+		if v != nil && !v.IsInf() {
+			rat, _ := v.Rat(nil)
+			return rat, true, nil
+		}
+		return nil, true, ErrNotExact
+	case *big.Rat:
+		if v != nil {
+			return v, true, nil
+		}
+		return nil, true, ErrNotExact
+	}
+
+	// Sizers report their own size to the size rules
+	if _, ok := value.(contract.Sizer); ok {
+		return nil, false, nil
+	}
+
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(val.Int()), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(val.Uint())), true, nil
+	}
+	return nil, false, nil
+}
+
+// ParseDecimal parses a decimal string exactly, including strings beyond float64 range
+// (e.g. "1e400"). numeric reports whether str is a decimal at all; err is set for decimals
+// too large to compare (e.g. "1e5000000").
+func ParseDecimal(str string) (exact *big.Rat, numeric bool, err error) {
+	if !IsDecimal(str) {
+		return nil, false, nil
+	}
+	exact, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return nil, true, ErrNotExact
+	}
+	return exact, true, nil
+}
+
+// IsDecimal reports whether str is a decimal number with an optional sign, fraction and
+// exponent ("-1.5e3"). Fractions ("1/3"), hex and digit separators are not.
+func IsDecimal(str string) bool {
+	mantissa, exponent, hasExponent := strings.Cut(strings.ToLower(trimSign(str)), "e")
+	whole, fraction, _ := strings.Cut(mantissa, ".")
+	if whole == "" && fraction == "" || !isDigits(whole) || !isDigits(fraction) {
+		return false
+	}
+	if hasExponent {
+		exponent = trimSign(exponent)
+		return exponent != "" && isDigits(exponent)
+	}
+	return true
+}
+
+// trimSign removes a single leading "+" or "-"
+func trimSign(str string) string {
+	if str != "" && (str[0] == '+' || str[0] == '-') {
+		return str[1:]
+	}
+	return str
+}
+
+// isDigits reports whether str holds ASCII digits only
+func isDigits(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,40 @@
+package common
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+type namedID uint64
+
+func TestExactNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    string
+		exact   bool
+		wantErr bool
+	}{
+		{"json number", json.Number("0.30"), "3/10", true, false},
+		{"json number too large", json.Number("1e5000000"), "", true, true},
+		{"json number not decimal", json.Number("0x10"), "", true, true},
+		{"named uint64", namedID(18446744073709551615), "18446744073709551615/1", true, false},
+		{"big.Int", big.NewInt(-7), "-7/1", true, false},
+		{"nil big.Float", (*big.Float)(nil), "", true, true},
+		{"float64 is not exact", 0.5, "", false, false},
+		{"string is not exact", "5", "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, exact, err := ExactNumber(tt.value)
+			if exact != tt.exact || (err != nil) != tt.wantErr {
+				t.Fatalf("ExactNumber() = %v, %v, %v", got, exact, err)
+			}
+			if tt.want != "" && got.String() != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
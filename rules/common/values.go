@@ -0,0 +1,71 @@
+package common
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry"
+)
+
+// StringValue returns the value of ctx as a string for string rules: strings as they are,
+// registered custom types through their contract.TypeHandler String function and, when the
+// engine coerces strings (engine.WithStringCoercion), encoding.TextMarshaler and fmt.Stringer
+// implementations through MarshalText and String.
+func StringValue(ctx contract.RuleContext) (string, bool) {
+	value := ctx.Value()
+	if str, ok := value.(string); ok {
+		return str, true
+	}
+	if handler, ok := registry.FindTypeHandler(value); ok && handler.String != nil {
+		return handler.String(value)
+	}
+	if coercer, ok := ctx.(interface{ CoercesStrings() bool }); !ok || !coercer.CoercesStrings() {
+		return "", false
+	}
+	if val := reflect.ValueOf(value); val.Kind() == reflect.Ptr && val.IsNil() {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		if err != nil {
+			return "", false
+		}
+		return string(text), true
+	case fmt.Stringer:
+		return v.String(), true
+	}
+	return "", false
+}
+
+// HandledEmpty reports whether a registered custom type counts as empty.
+// handled is false when no Empty function is registered for the type of value.
+func HandledEmpty(value any) (empty, handled bool) {
+	if handler, ok := registry.FindTypeHandler(value); ok && handler.Empty != nil {
+		return handler.Empty(value), true
+	}
+	return false, false
+}
+
+// HandledSize returns the size of a registered custom type for size rules: its numeric
+// value when a Number function is registered, its length otherwise.
+func HandledSize(value any) (float64, bool) {
+	handler, ok := registry.FindTypeHandler(value)
+	if !ok {
+		return 0, false
+	}
+	if handler.Number != nil {
+		if number, ok := handler.Number(value); ok {
+			return number, true
+		}
+	}
+	if handler.Length != nil {
+		if length, ok := handler.Length(value); ok {
+			return float64(length), true
+		}
+	}
+	return 0, false
+}
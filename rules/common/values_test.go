@@ -0,0 +1,33 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry"
+)
+
+type tagList struct{ tags []string }
+
+func TestHandledValues(t *testing.T) {
+	registry.RegisterTypeHandler(reflect.TypeOf(tagList{}), contract.TypeHandler{
+		Length: func(value any) (int, bool) { return len(value.(tagList).tags), true },
+	})
+
+	if size, ok := HandledSize(tagList{tags: []string{"a", "b"}}); !ok || size != 2 {
+		t.Fatalf("expected length fallback of 2, got %v %v", size, ok)
+	}
+	if _, ok := StringValue(contract.NewValidationContext("tags", tagList{}, nil, nil)); ok {
+		t.Fatal("expected no string form without a String handler")
+	}
+	if _, handled := HandledEmpty(tagList{}); handled {
+		t.Fatal("expected emptiness to be left to the default handling")
+	}
+	if str, ok := StringValue(contract.NewValidationContext("tag", "plain", nil, nil)); !ok || str != "plain" {
+		t.Fatalf("expected strings unchanged, got %q %v", str, ok)
+	}
+	if _, ok := HandledSize(42); ok {
+		t.Fatal("expected unregistered types to be left to the default handling")
+	}
+}
@@ -3,6 +3,7 @@ package comparison
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
@@ -23,12 +24,16 @@ const (
 )
 
 // getAsFloat converts various types to a float64 for size comparison.
+// Values implementing contract.Sizer report their own size.
 // For strings, it returns the rune count. For slices, arrays, and maps, it returns the length.
 // For numeric types, it returns the float64 value.
 func getAsFloat(value interface{}) (float64, error) {
 	if value == nil {
 		return 0, nil
 	}
+	if sizer, ok := value.(contract.Sizer); ok {
+		return sizer.ValidationSize(), nil
+	}
 
 	val := reflect.ValueOf(value)
 
@@ -49,6 +54,7 @@ func getAsFloat(value interface{}) (float64, error) {
 }
 
 // getAsComparable converts various types to a float64 for comparison rules.
+// Values implementing contract.Sizer report their own size.
 // It tries to parse strings as numbers first, but falls back to length if not numeric.
 // For collections (slices, maps, arrays), it returns the length.
 // For numeric types, it returns the numeric value.
@@ -56,6 +62,9 @@ func getAsComparable(value interface{}) (float64, error) {
 	if value == nil {
 		return 0, errors.New("cannot convert nil to comparable value")
 	}
+	if sizer, ok := value.(contract.Sizer); ok {
+		return sizer.ValidationSize(), nil
+	}
 
 	val := reflect.ValueOf(value)
 
@@ -89,8 +98,8 @@ func floatToString(f float64) string {
 // BetweenRule validates that a numeric value is between min and max (inclusive).
 type BetweenRule struct {
 	common.BaseRule
-	min float64
-	max float64
+	min *big.Rat
+	max *big.Rat
 }
 
 // NewBetweenRule creates a new BetweenRule with min and max parameters.
@@ -99,11 +108,11 @@ func NewBetweenRule(parameters []string) (contract.Rule, error) {
 		return nil, errors.New(betweenRuleParamErr)
 	}
 
-	minVal, err := strconv.ParseFloat(parameters[0], 64)
+	minVal, err := parseBound(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf(betweenRuleMinParseFail, err)
 	}
-	maxVal, err := strconv.ParseFloat(parameters[1], 64)
+	maxVal, err := parseBound(parameters[1])
 	if err != nil {
 		return nil, fmt.Errorf(betweenRuleMaxParseFail, err)
 	}
@@ -123,17 +132,17 @@ func (r *BetweenRule) Validate(ctx contract.RuleContext) error {
 	}
 
 	// Convert value to float64
-	value, err := getAsFloat(ctx.Value())
+	value, err := sizeOf(ctx.Value())
 	if err != nil {
 		return errors.New(betweenRuleTypeErrorMsg)
 	}
 
 	// Check if value is within the valid range
-	if value >= r.min && value <= r.max {
+	if value.Cmp(r.min) >= 0 && value.Cmp(r.max) <= 0 {
 		return nil
 	}
 
-	return fmt.Errorf(betweenRuleFailed, r.min, r.max)
+	return fmt.Errorf(betweenRuleFailed, ratString(r.min), ratString(r.max))
 }
 
 func (r *BetweenRule) Name() string {
@@ -2,32 +2,65 @@ package comparison
 
 import (
 	"errors"
-	"fmt"
+	"strings"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
 	confirmedRuleName                       = "confirmed"
+	confirmedByRuleName                     = "confirmed_by"
 	confirmedRuleDefaultMsg                 = "the :attribute confirmation does not match"
 	confirmedRuleConfirmationFieldMissedMsg = "the :attribute confirmation field is missing"
 	confirmedRuleDataNotProvidedMsg         = "the :attribute has not provided data for confirmation validation"
+	confirmedByRuleFieldMissingMsg          = "confirmed_by rule requires the confirmation field"
+
+	// DefaultConfirmationSuffix is appended to the field name to find its confirmation field
+	DefaultConfirmationSuffix = "_confirmation"
 )
 
-// ConfirmedRule validates that a field matches its <field>_confirmation field.
+// ConfirmedRule validates that a field matches its confirmation field: by default
+// <field>_confirmation, <field><suffix> with a suffix parameter ("confirmed:Repeat" checks
+// passwordRepeat against password), or the field named by confirmed_by ("confirmed_by:email_verify").
 type ConfirmedRule struct {
 	common.BaseRule
+	name   string
+	suffix string
+	field  string
 }
 
-// NewConfirmedRule creates a new instance of the ConfirmedRule.
-func NewConfirmedRule() (contract.Rule, error) {
+// NewConfirmedRule creates a new instance of the ConfirmedRule. The optional parameter replaces
+// the DefaultConfirmationSuffix.
+func NewConfirmedRule(parameters ...string) (contract.Rule, error) {
+	suffix := DefaultConfirmationSuffix
+	if len(parameters) > 0 && strings.TrimSpace(parameters[0]) != "" {
+		suffix = strings.TrimSpace(parameters[0])
+	}
+
 	return &ConfirmedRule{
-		BaseRule: common.NewBaseRule(confirmedRuleName, confirmedRuleDefaultMsg, []string{}),
+		BaseRule: common.NewBaseRule(confirmedRuleName, confirmedRuleDefaultMsg, parameters),
+		name:     confirmedRuleName,
+		suffix:   suffix,
 	}, nil
 }
 
-// Validate ensures the field matches the <field>_confirmation value in the input data.
+// NewConfirmedByRule creates a ConfirmedRule comparing the field with the confirmation field
+// given as parameter
+func NewConfirmedByRule(parameters []string) (contract.Rule, error) {
+	if len(parameters) == 0 || strings.TrimSpace(parameters[0]) == "" {
+		return nil, errors.New(confirmedByRuleFieldMissingMsg)
+	}
+
+	return &ConfirmedRule{
+		BaseRule: common.NewBaseRule(confirmedByRuleName, confirmedRuleDefaultMsg, parameters),
+		name:     confirmedByRuleName,
+		field:    strings.TrimSpace(parameters[0]),
+	}, nil
+}
+
+// Validate ensures the field matches the value of its confirmation field in the input data.
 func (r *ConfirmedRule) Validate(ctx contract.RuleContext) error {
 	// Skip validation if the value is nil
 	if r.ShouldSkipValidation(ctx.Value()) {
@@ -40,21 +73,34 @@ func (r *ConfirmedRule) Validate(ctx contract.RuleContext) error {
 		return errors.New(confirmedRuleDataNotProvidedMsg)
 	}
 
-	// Create the confirmation field name by appending "_confirmation" to the original field name
-	confirmationField := ctx.Field() + "_confirmation"
-	confirmationValue, exists := data[confirmationField]
+	confirmationValue, exists := r.confirmationValue(data, ctx.Field())
 	if !exists {
 		return errors.New(confirmedRuleConfirmationFieldMissedMsg)
 	}
 
 	// Compare the field's value with the confirmation value
-	if fmt.Sprintf("%v", ctx.Value()) != fmt.Sprintf("%v", confirmationValue) {
+	if !common.ValuesEqual(ctx.Value(), confirmationValue) {
 		return errors.New(confirmedRuleDefaultMsg)
 	}
 
 	return nil
 }
 
+// confirmationValue returns the value of the field holding the confirmation of field. The
+// confirmation of a nested field ("user.password") is its sibling ("user.password_confirmation");
+// a confirmed_by field is looked up next to field first and as a path from the root otherwise.
+func (r *ConfirmedRule) confirmationValue(data map[string]any, field string) (any, bool) {
+	if r.field == "" {
+		return utils.GetPath(data, field+r.suffix)
+	}
+	if i := strings.LastIndex(field, "."); i >= 0 {
+		if value, ok := utils.GetPath(data, field[:i+1]+r.field); ok {
+			return value, true
+		}
+	}
+	return utils.GetPath(data, r.field)
+}
+
 func (r *ConfirmedRule) Name() string {
-	return confirmedRuleName
+	return r.name
 }
@@ -92,3 +92,127 @@ func TestConfirmedRule(t *testing.T) {
 		})
 	}
 }
+
+func TestConfirmedRule_Conventions(t *testing.T) {
+	suffixed, err := comparison.NewConfirmedRule("Repeat")
+	if err != nil {
+		t.Fatalf("Failed to create ConfirmedRule: %v", err)
+	}
+	by, err := comparison.NewConfirmedByRule([]string{"email_verify"})
+	if err != nil {
+		t.Fatalf("Failed to create confirmed_by rule: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		rule       contract.Rule
+		fieldName  string
+		value      interface{}
+		data       map[string]any
+		shouldPass bool
+	}{
+		{
+			name:       "custom suffix matches",
+			rule:       suffixed,
+			fieldName:  "password",
+			value:      "secret123",
+			data:       map[string]any{"password": "secret123", "passwordRepeat": "secret123"},
+			shouldPass: true,
+		},
+		{
+			name:       "custom suffix differs",
+			rule:       suffixed,
+			fieldName:  "password",
+			value:      "secret123",
+			data:       map[string]any{"password": "secret123", "passwordRepeat": "other"},
+			shouldPass: false,
+		},
+		{
+			name:       "custom suffix ignores the default field",
+			rule:       suffixed,
+			fieldName:  "password",
+			value:      "secret123",
+			data:       map[string]any{"password": "secret123", "password_confirmation": "secret123"},
+			shouldPass: false,
+		},
+		{
+			name:       "confirmed_by matches",
+			rule:       by,
+			fieldName:  "email",
+			value:      "jane@example.com",
+			data:       map[string]any{"email": "jane@example.com", "email_verify": "jane@example.com"},
+			shouldPass: true,
+		},
+		{
+			name:       "confirmed_by differs",
+			rule:       by,
+			fieldName:  "email",
+			value:      "jane@example.com",
+			data:       map[string]any{"email": "jane@example.com", "email_verify": "joe@example.com"},
+			shouldPass: false,
+		},
+		{
+			name:       "confirmed_by field missing",
+			rule:       by,
+			fieldName:  "email",
+			value:      "jane@example.com",
+			data:       map[string]any{"email": "jane@example.com"},
+			shouldPass: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate(contract.NewValidationContext(tt.fieldName, tt.value, nil, tt.data))
+			if tt.shouldPass && err != nil {
+				t.Errorf("%s: expected validation to pass, but got error: %v", tt.name, err)
+			}
+			if !tt.shouldPass && err == nil {
+				t.Errorf("%s: expected validation to fail, but passed", tt.name)
+			}
+		})
+	}
+
+	if by.Name() != "confirmed_by" {
+		t.Errorf("unexpected name %q", by.Name())
+	}
+	if _, err := comparison.NewConfirmedByRule(nil); err == nil {
+		t.Error("expected an error without a confirmation field")
+	}
+}
+
+func TestConfirmedRule_NestedFields(t *testing.T) {
+	confirmed, _ := comparison.NewConfirmedRule()
+	confirmedBy, _ := comparison.NewConfirmedByRule([]string{"email_verify"})
+	confirmedByPath, _ := comparison.NewConfirmedByRule([]string{"verify.email"})
+	data := map[string]any{
+		"user":   map[string]any{"password": "secret", "password_confirmation": "secret", "email_verify": "a@b.co"},
+		"verify": map[string]any{"email": "c@d.co"},
+	}
+
+	tests := []struct {
+		name       string
+		rule       contract.Rule
+		field      string
+		value      any
+		shouldPass bool
+	}{
+		{"confirmed sibling matches", confirmed, "user.password", "secret", true},
+		{"confirmed sibling differs", confirmed, "user.password", "other", false},
+		{"confirmed_by sibling matches", confirmedBy, "user.email", "a@b.co", true},
+		{"confirmed_by sibling differs", confirmedBy, "user.email", "c@d.co", false},
+		{"confirmed_by root path matches", confirmedByPath, "user.email", "c@d.co", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate(contract.NewValidationContext(tt.field, tt.value, nil, data))
+			if tt.shouldPass && err != nil {
+				t.Errorf("expected pass but got error: %v", err)
+			}
+			if !tt.shouldPass && err == nil {
+				t.Error("expected failure but got none")
+			}
+		})
+	}
+}
@@ -2,10 +2,10 @@ package comparison
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -39,13 +39,13 @@ func (r *DifferentRule) Validate(ctx contract.RuleContext) error {
 	}
 
 	// Retrieve the value of the other field to compare against
-	otherVal, ok := ctx.Data()[r.otherField]
+	otherVal, ok := utils.GetPath(ctx.Data(), r.otherField)
 	if !ok {
 		return errors.New(differentRuleParamError)
 	}
 
 	// Compare the values, return error if they are equal
-	if fmt.Sprintf("%v", ctx.Value()) == fmt.Sprintf("%v", otherVal) {
+	if common.ValuesEqual(ctx.Value(), otherVal) {
 		return errors.New(differentRuleDefaultMsg)
 	}
 
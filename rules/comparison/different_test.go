@@ -65,3 +65,15 @@ func TestDifferentRule(t *testing.T) {
 		})
 	}
 }
+
+func TestDifferentRule_NestedFields(t *testing.T) {
+	rule, _ := comparison.NewDifferentRule([]string{"items.0.sku"})
+	data := map[string]any{"items": []any{map[string]any{"sku": "AB"}}}
+
+	if err := rule.Validate(contract.NewValidationContext("field", "CD", nil, data)); err != nil {
+		t.Errorf("expected a different nested value to pass, got %v", err)
+	}
+	if err := rule.Validate(contract.NewValidationContext("field", "AB", nil, data)); err == nil {
+		t.Error("expected the same nested value to fail")
+	}
+}
@@ -0,0 +1,78 @@
+package comparison
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+const (
+	exprRuleName          = "expr"
+	exprRuleDefaultMsg    = "the :attribute must satisfy :param0"
+	exprRuleParamErrorMsg = "expr rule requires an expression parameter"
+	exprRuleInvalidMsg    = "invalid expression for expr rule: %w"
+	exprRuleFieldTypeMsg  = "the field %s used in the :attribute expression must be numeric"
+)
+
+// ExprRule validates an arithmetic relation across numeric fields, e.g. "discount + fee <= total".
+// Field names inside the expression are resolved against the validated data, including nested
+// paths such as "order.total".
+type ExprRule struct {
+	common.BaseRule
+	expression *exprComparison
+}
+
+// NewExprRule compiles the expression parameter into an ExprRule.
+// Usage: expr:discount + fee <= total
+func NewExprRule(parameters []string) (contract.Rule, error) {
+	if len(parameters) == 0 || strings.TrimSpace(parameters[0]) == "" {
+		return nil, errors.New(exprRuleParamErrorMsg)
+	}
+
+	// Commas are parameter separators in the rule syntax; keep them part of the expression
+	expression, err := parseExpression(strings.Join(parameters, ","))
+	if err != nil {
+		return nil, fmt.Errorf(exprRuleInvalidMsg, err)
+	}
+
+	return &ExprRule{
+		BaseRule:   common.NewBaseRule(exprRuleName, exprRuleDefaultMsg, parameters),
+		expression: expression,
+	}, nil
+}
+
+// Validate evaluates the expression against the data being validated.
+func (r *ExprRule) Validate(ctx contract.RuleContext) error {
+	data := ctx.Data()
+	lookup := func(name string) (float64, error) {
+		value, ok := utils.GetPath(data, name)
+		if name == ctx.Field() {
+			value, ok = ctx.Value(), true
+		}
+		if !ok {
+			return 0, fmt.Errorf(exprRuleFieldTypeMsg, name)
+		}
+		number, err := utils.GetAsNumeric(value)
+		if err != nil {
+			return 0, fmt.Errorf(exprRuleFieldTypeMsg, name)
+		}
+		return number, nil
+	}
+
+	ok, err := r.expression.evaluate(lookup)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New(exprRuleDefaultMsg)
+	}
+	return nil
+}
+
+func (r *ExprRule) Name() string {
+	return exprRuleName
+}
@@ -0,0 +1,265 @@
+package comparison
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// exprNode is a node of a parsed arithmetic expression.
+type exprNode interface {
+	eval(lookup func(name string) (float64, error)) (float64, error)
+}
+
+type exprNumber float64
+
+type exprField string
+
+type exprUnary struct {
+	operand exprNode
+}
+
+type exprBinary struct {
+	op          byte
+	left, right exprNode
+}
+
+func (n exprNumber) eval(_ func(string) (float64, error)) (float64, error) {
+	return float64(n), nil
+}
+
+func (n exprField) eval(lookup func(string) (float64, error)) (float64, error) {
+	return lookup(string(n))
+}
+
+func (n exprUnary) eval(lookup func(string) (float64, error)) (float64, error) {
+	v, err := n.operand.eval(lookup)
+	return -v, err
+}
+
+func (n exprBinary) eval(lookup func(string) (float64, error)) (float64, error) {
+	left, err := n.left.eval(lookup)
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.right.eval(lookup)
+	if err != nil {
+		return 0, err
+	}
+	switch n.op {
+	case '+':
+		return left + right, nil
+	case '-':
+		return left - right, nil
+	case '*':
+		return left * right, nil
+	default:
+		if right == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return left / right, nil
+	}
+}
+
+// exprComparison is the root of an expression: two arithmetic sides joined by a comparison operator.
+type exprComparison struct {
+	op          string
+	left, right exprNode
+}
+
+// evaluate computes both sides and applies the comparison operator.
+func (c *exprComparison) evaluate(lookup func(name string) (float64, error)) (bool, error) {
+	left, err := c.left.eval(lookup)
+	if err != nil {
+		return false, err
+	}
+	right, err := c.right.eval(lookup)
+	if err != nil {
+		return false, err
+	}
+	switch c.op {
+	case "<":
+		return left < right, nil
+	case "<=":
+		return left <= right, nil
+	case ">":
+		return left > right, nil
+	case ">=":
+		return left >= right, nil
+	case "==":
+		return left == right, nil
+	default:
+		return left != right, nil
+	}
+}
+
+// exprParser is a small recursive-descent parser. It only understands numbers, field names,
+// + - * /, parentheses and a single comparison, so rule strings can never execute arbitrary code.
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+// parseExpression compiles an expression such as "discount + fee <= total".
+func parseExpression(input string) (*exprComparison, error) {
+	tokens, err := tokenizeExpression(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{tokens: tokens}
+
+	left, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	op := p.next()
+	switch op {
+	case "<", "<=", ">", ">=", "==", "!=":
+	case "":
+		return nil, errors.New("expression requires a comparison operator")
+	default:
+		return nil, fmt.Errorf("unexpected token %q", op)
+	}
+	right, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok != "" {
+		return nil, fmt.Errorf("unexpected token %q", tok)
+	}
+
+	return &exprComparison{op: op, left: left, right: right}, nil
+}
+
+func (p *exprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *exprParser) next() string {
+	tok := p.peek()
+	if tok != "" {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *exprParser) parseSum() (exprNode, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok == "+" || tok == "-"; tok = p.peek() {
+		p.next()
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = exprBinary{op: tok[0], left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseTerm() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok == "*" || tok == "/"; tok = p.peek() {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = exprBinary{op: tok[0], left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.peek() == "-" {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return exprUnary{operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.next()
+	switch {
+	case tok == "":
+		return nil, errors.New("unexpected end of expression")
+	case tok == "(":
+		node, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, errors.New("missing closing parenthesis")
+		}
+		return node, nil
+	case unicode.IsDigit(rune(tok[0])) || tok[0] == '.':
+		num, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", tok)
+		}
+		return exprNumber(num), nil
+	case isExprIdentStart(rune(tok[0])):
+		return exprField(tok), nil
+	}
+	return nil, fmt.Errorf("unexpected token %q", tok)
+}
+
+// tokenizeExpression splits an expression into numbers, identifiers, operators and parentheses.
+func tokenizeExpression(input string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(input); {
+		ch := rune(input[i])
+		switch {
+		case unicode.IsSpace(ch):
+			i++
+		case strings.ContainsRune("+-*/()", ch):
+			tokens = append(tokens, string(ch))
+			i++
+		case strings.ContainsRune("<>=!", ch):
+			if i+1 < len(input) && input[i+1] == '=' {
+				tokens = append(tokens, input[i:i+2])
+				i += 2
+				continue
+			}
+			if ch == '=' || ch == '!' {
+				return nil, fmt.Errorf("invalid operator %q", string(ch))
+			}
+			tokens = append(tokens, string(ch))
+			i++
+		case unicode.IsDigit(ch) || ch == '.':
+			start := i
+			for i < len(input) && (unicode.IsDigit(rune(input[i])) || input[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, input[start:i])
+		case isExprIdentStart(ch):
+			start := i
+			for i < len(input) && (isExprIdentStart(rune(input[i])) || unicode.IsDigit(rune(input[i])) ||
+				input[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, input[start:i])
+		default:
+			return nil, fmt.Errorf("invalid character %q", string(ch))
+		}
+	}
+	return tokens, nil
+}
+
+func isExprIdentStart(ch rune) bool {
+	return ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
+}
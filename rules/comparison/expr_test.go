@@ -0,0 +1,60 @@
+package comparison_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/comparison"
+)
+
+func TestExprRule(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		value      any
+		data       map[string]any
+		shouldPass bool
+	}{
+		{"sum within total", "discount + fee <= total", 10, map[string]any{"fee": 5, "total": 20}, true},
+		{"sum exceeds total", "discount + fee <= total", 10, map[string]any{"fee": 15, "total": 20}, false},
+		{"numeric strings", "discount + fee <= total", "10", map[string]any{"fee": "5", "total": "15"}, true},
+		{"precedence and parentheses", "(a + b) * 2 == c - 1", 1, map[string]any{"b": 2, "c": 7}, true},
+		{"unary minus", "-a < 0", 3, map[string]any{}, true},
+		{"not equal", "a != b", 3, map[string]any{"b": 3}, false},
+		{"missing field", "a + missing > 0", 1, map[string]any{}, false},
+		{"non numeric field", "a + b > 0", 1, map[string]any{"b": "x"}, false},
+		{"division by zero", "a / b > 0", 1, map[string]any{"b": 0}, false},
+		{"nested paths", "a + order.fee <= order.lines.0.total", 1,
+			map[string]any{"order": map[string]any{"fee": 2, "lines": []any{map[string]any{"total": 3}}}}, true},
+		{"nested path exceeded", "a + order.fee <= 2", 1, map[string]any{"order": map[string]any{"fee": 2}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := comparison.NewExprRule([]string{tt.expression})
+			if err != nil {
+				t.Fatalf("Failed to create ExprRule: %v", err)
+			}
+			field := "discount"
+			if _, ok := tt.data["fee"]; !ok {
+				field = "a"
+			}
+			ctx := contract.NewValidationContext(field, tt.value, nil, tt.data)
+			err = rule.Validate(ctx)
+			if tt.shouldPass && err != nil {
+				t.Errorf("expected pass but got error: %v", err)
+			}
+			if !tt.shouldPass && err == nil {
+				t.Errorf("expected failure for expression %q", tt.expression)
+			}
+		})
+	}
+}
+
+func TestExprRule_InvalidExpressions(t *testing.T) {
+	for _, expression := range []string{"", "a + b", "a <= ", "a = b", "a <= b)", "a & b > 1", "(a + b <= c"} {
+		if _, err := comparison.NewExprRule([]string{expression}); err == nil {
+			t.Errorf("expected error for expression %q", expression)
+		}
+	}
+}
@@ -3,7 +3,7 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
+	"math/big"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -18,7 +18,7 @@ const (
 // GtRule validates that a numeric value is greater than a specified threshold.
 type GtRule struct {
 	common.BaseRule
-	threshold float64
+	threshold *big.Rat
 }
 
 // NewGtRule creates a new GtRule with a comparison threshold.
@@ -27,7 +27,7 @@ func NewGtRule(parameters []string) (contract.Rule, error) {
 		return nil, errors.New("gt rule requires a value parameter")
 	}
 
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := parseBound(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf("invalid value parameter for gt rule: %w", err)
 	}
@@ -46,13 +46,13 @@ func (r *GtRule) Validate(ctx contract.RuleContext) error {
 	}
 
 	// Convert value to float64
-	value, err := getAsFloat(ctx.Value())
+	value, err := sizeOf(ctx.Value())
 	if err != nil {
 		return errors.New(gtRuleTypeErrorMsg)
 	}
 
 	// Compare the value with the threshold
-	if value <= r.threshold {
+	if value.Cmp(r.threshold) <= 0 {
 		return errors.New(gtRuleDefaultMsg)
 	}
 
@@ -3,7 +3,7 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
+	"math/big"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -22,7 +22,7 @@ const (
 // GteRule validates that the input is >= to the given comparison value.
 type GteRule struct {
 	common.BaseRule
-	comparisonValue float64
+	comparisonValue *big.Rat
 }
 
 // NewGteRule initializes a new GteRule from parameters.
@@ -31,7 +31,7 @@ func NewGteRule(parameters []string) (contract.Rule, error) {
 		return nil, errors.New(gteRuleMissingParamError)
 	}
 
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := parseBound(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf(gteRuleInvalidParamError, err)
 	}
@@ -44,16 +44,16 @@ func NewGteRule(parameters []string) (contract.Rule, error) {
 
 // Validate checks if the input value is greater than or equal to the comparison value.
 func (r *GteRule) Validate(ctx contract.RuleContext) error {
-	value, err := getAsComparable(ctx.Value())
+	value, err := comparableOf(ctx.Value())
 	if err != nil {
 		return errors.New(gteRuleInvalidInputType)
 	}
 
-	if value >= r.comparisonValue {
+	if value.Cmp(r.comparisonValue) >= 0 {
 		return nil
 	}
 
-	return fmt.Errorf(gteRuleValidationFailedFmt, floatToString(r.comparisonValue))
+	return fmt.Errorf(gteRuleValidationFailedFmt, ratString(r.comparisonValue))
 }
 
 func (r *GteRule) Name() string {
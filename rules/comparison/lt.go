@@ -3,11 +3,10 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
+	"math/big"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
-	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -19,7 +18,7 @@ const (
 // LtRule validates that a numeric value is less than the specified comparison value.
 type LtRule struct {
 	common.BaseRule
-	comparisonValue float64
+	comparisonValue *big.Rat
 }
 
 // NewLtRule creates a new instance of LtRule with a comparison threshold.
@@ -29,7 +28,7 @@ func NewLtRule(parameters []string) (contract.Rule, error) {
 	}
 
 	// Parse the comparison value to float
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := parseBound(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf("invalid value parameter for lt rule: %w", err)
 	}
@@ -48,13 +47,13 @@ func (r *LtRule) Validate(ctx contract.RuleContext) error {
 	}
 
 	// Convert the value to a float64
-	value, err := utils.GetAsComparable(ctx.Value())
+	value, err := comparableOf(ctx.Value())
 	if err != nil {
 		return errors.New(ltRuleTypeErrorMsg)
 	}
 
 	// Check if the value is less than the comparison threshold
-	if value < r.comparisonValue {
+	if value.Cmp(r.comparisonValue) < 0 {
 		return nil
 	}
 
@@ -3,7 +3,7 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
+	"math/big"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -21,7 +21,7 @@ const (
 // LteRule checks if a numeric value is <= comparisonValue.
 type LteRule struct {
 	common.BaseRule
-	comparisonValue float64
+	comparisonValue *big.Rat
 }
 
 // NewLteRule constructs a new lteRule instance.
@@ -30,7 +30,7 @@ func NewLteRule(parameters []string) (contract.Rule, error) {
 		return nil, errors.New(lteRuleErrMissingParam)
 	}
 
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := parseBound(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf(lteRuleErrInvalidParam, err)
 	}
@@ -43,16 +43,16 @@ func NewLteRule(parameters []string) (contract.Rule, error) {
 
 // Validate checks if value <= comparisonValue.
 func (r *LteRule) Validate(ctx contract.RuleContext) error {
-	value, err := getAsComparable(ctx.Value())
+	value, err := comparableOf(ctx.Value())
 	if err != nil {
 		return errors.New(lteRuleErrInvalidInputType)
 	}
 
-	if value <= r.comparisonValue {
+	if value.Cmp(r.comparisonValue) <= 0 {
 		return nil
 	}
 
-	return fmt.Errorf(lteRuleErrFailed, floatToString(r.comparisonValue))
+	return fmt.Errorf(lteRuleErrFailed, ratString(r.comparisonValue))
 }
 
 func (r *LteRule) Name() string {
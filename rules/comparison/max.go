@@ -3,7 +3,7 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
+	"math/big"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -18,7 +18,7 @@ const (
 // MaxRule is a validation rule that checks if a numeric value is not greater than a given value.
 type MaxRule struct {
 	common.BaseRule
-	comparisonValue float64
+	comparisonValue *big.Rat
 }
 
 // NewMaxRule creates a new MaxRule with a comparison threshold.
@@ -28,7 +28,7 @@ func NewMaxRule(parameters []string) (contract.Rule, error) {
 	}
 
 	// Parse the threshold value
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := parseBound(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf("invalid value parameter for max rule: %w", err)
 	}
@@ -47,13 +47,13 @@ func (r *MaxRule) Validate(ctx contract.RuleContext) error {
 	}
 
 	// Convert the value to a float
-	value, err := getAsFloat(ctx.Value())
+	value, err := sizeOf(ctx.Value())
 	if err != nil {
 		return errors.New(maxRuleTypeErrorMsg)
 	}
 
 	// Check if the value is less than or equal to the maximum allowed
-	if value <= r.comparisonValue {
+	if value.Cmp(r.comparisonValue) <= 0 {
 		return nil
 	}
 
@@ -3,7 +3,7 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
+	"math/big"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -18,7 +18,7 @@ const (
 // MinRule validates that a numeric value is at least the specified minimum value.
 type MinRule struct {
 	common.BaseRule
-	comparisonValue float64
+	comparisonValue *big.Rat
 }
 
 // NewMinRule creates a new MinRule with a comparison threshold.
@@ -28,7 +28,7 @@ func NewMinRule(parameters []string) (contract.Rule, error) {
 	}
 
 	// Parse the threshold value
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := parseBound(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf("invalid value parameter for min rule: %w", err)
 	}
@@ -47,13 +47,13 @@ func (r *MinRule) Validate(ctx contract.RuleContext) error {
 	}
 
 	// Convert the value to a float
-	value, err := getAsFloat(ctx.Value())
+	value, err := sizeOf(ctx.Value())
 	if err != nil {
 		return errors.New(minRuleTypeErrorMsg)
 	}
 
 	// Check if the value is greater than or equal to the minimum value
-	if value >= r.comparisonValue {
+	if value.Cmp(r.comparisonValue) >= 0 {
 		return nil
 	}
 
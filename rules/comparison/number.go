@@ -0,0 +1,84 @@
+package comparison
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+// errNotExact is returned by parseBound for parameters that are not numbers
+var errNotExact = errors.New("not a number")
+
+// parseBound parses a rule parameter as an exact rational, so boundaries such as
+// "0.1" are not subject to float64 rounding. Only decimal numbers are bounds.
+func parseBound(param string) (*big.Rat, error) {
+	param = strings.TrimSpace(param)
+	if !common.IsDecimal(param) {
+		return nil, errNotExact
+	}
+	bound, ok := new(big.Rat).SetString(param)
+	if !ok {
+		return nil, errNotExact
+	}
+	return bound, nil
+}
+
+// sizeOf returns the size of value for min, max, size, between and gt: registered
+// custom types use their contract.TypeHandler, exact numbers keep their precision,
+// other values follow getAsFloat.
+func sizeOf(value any) (*big.Rat, error) {
+	if size, ok := common.HandledSize(value); ok {
+		return floatRat(size)
+	}
+	if exact, ok, err := common.ExactNumber(value); ok {
+		return exact, err
+	}
+	size, err := getAsFloat(value)
+	if err != nil {
+		return nil, err
+	}
+	return floatRat(size)
+}
+
+// comparableOf returns value for gte, lt and lte: registered custom types use their
+// contract.TypeHandler, exact numbers and numeric strings keep their precision,
+// other values follow getAsComparable.
+func comparableOf(value any) (*big.Rat, error) {
+	if size, ok := common.HandledSize(value); ok {
+		return floatRat(size)
+	}
+	if exact, ok, err := common.ExactNumber(value); ok {
+		return exact, err
+	}
+	if str, ok := value.(string); ok {
+		if exact, numeric, err := common.ParseDecimal(str); numeric {
+			return exact, err
+		}
+	}
+	comparable, err := getAsComparable(value)
+	if err != nil {
+		return nil, err
+	}
+	return floatRat(comparable)
+}
+
+// floatRat converts a finite float64 to a rational
+func floatRat(f float64) (*big.Rat, error) {
+	rat := new(big.Rat).SetFloat64(f)
+	if rat == nil {
+		return nil, errNotExact
+	}
+	return rat, nil
+}
+
+// ratString formats a rational compactly for messages, e.g. "10" or "0.25"
+func ratString(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	f, _ := r.Float64()
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,88 @@
+package comparison_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/comparison"
+)
+
+// fakeDecimal mimics decimal.Decimal-like types exposing an exact rational
+type fakeDecimal struct{ value string }
+
+func (d fakeDecimal) Rat() *big.Rat {
+	r, _ := new(big.Rat).SetString(d.value)
+	return r
+}
+
+func bigInt(s string) *big.Int {
+	i, _ := new(big.Int).SetString(s, 10)
+	return i
+}
+
+func TestExactNumberComparisons(t *testing.T) {
+	tests := []struct {
+		name    string
+		create  func([]string) (contract.Rule, error)
+		params  []string
+		value   any
+		wantErr bool
+	}{
+		{"json number min is numeric, not length", comparison.NewMinRule, []string{"100"}, json.Number("150"), false},
+		{"json number below min", comparison.NewMinRule, []string{"100"}, json.Number("99.99"), true},
+		{"json number at decimal boundary", comparison.NewMaxRule, []string{"0.3"}, json.Number("0.3"), false},
+		{"json number just above boundary", comparison.NewLteRule, []string{"0.3"}, json.Number("0.30000000000000001"), true},
+		{"decimal at between boundary", comparison.NewBetweenRule, []string{"0.1", "0.3"}, fakeDecimal{"0.3"}, false},
+		{"decimal size", comparison.NewSizeRule, []string{"19.99"}, fakeDecimal{"19.99"}, false},
+		{"decimal gt", comparison.NewGtRule, []string{"0.1"}, fakeDecimal{"0.1"}, true},
+		{"decimal gte", comparison.NewGteRule, []string{"0.1"}, fakeDecimal{"0.1"}, false},
+		{"decimal lt", comparison.NewLtRule, []string{"1.01"}, fakeDecimal{"1.009"}, false},
+		{"uint64 above float precision", comparison.NewMaxRule, []string{"18446744073709551614"},
+			uint64(18446744073709551615), true},
+		{"uint64 at max boundary", comparison.NewMaxRule, []string{"18446744073709551615"},
+			uint64(18446744073709551615), false},
+		{"int64 beyond 2^53", comparison.NewGtRule, []string{"9007199254740992"}, int64(9007199254740993), false},
+		{"big.Int token balance", comparison.NewBetweenRule,
+			[]string{"0", "115792089237316195423570985008687907853269984665640564039457584007913129639935"},
+			bigInt("115792089237316195423570985008687907853269984665640564039457584007913129639936"), true},
+		{"big.Float value", comparison.NewMinRule, []string{"0.5"}, big.NewFloat(0.5), false},
+		{"big.Rat value", comparison.NewLteRule, []string{"0.3333"}, big.NewRat(1, 3), true},
+		{"numeric string beyond float precision", comparison.NewLteRule, []string{"9007199254740992"},
+			"9007199254740993", true},
+		{"numeric string beyond float range", comparison.NewGteRule, []string{"1e300"}, "1e400", false},
+		{"numeric string beyond float range above bound", comparison.NewLteRule, []string{"10"}, "1e400", true},
+		{"numeric string too large to compare", comparison.NewLteRule, []string{"10"}, "1e5000000", true},
+		{"json number too large for max", comparison.NewMaxRule, []string{"10"}, json.Number("1e5000000"), true},
+		{"json number too large for size", comparison.NewSizeRule, []string{"9"}, json.Number("1e5000000"), true},
+		{"invalid json number is not its length", comparison.NewMinRule, []string{"1"}, json.Number("abc"), true},
+		{"negative exponent string", comparison.NewLtRule, []string{"1"}, "5e-1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := tt.create(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected creation error: %v", err)
+			}
+			err = rule.Validate(contract.NewValidationContext("amount", tt.value, tt.params, nil))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBoundsMustBeDecimal(t *testing.T) {
+	for _, bound := range []string{"1/3", "0x10", "1_000", "e5", ".", "--1", "1e"} {
+		if _, err := comparison.NewLteRule([]string{bound}); err == nil {
+			t.Errorf("expected bound %q to be rejected", bound)
+		}
+	}
+	for _, bound := range []string{"10", "-1.5", "+2", ".5", "5.", "1e-3", "2E+4"} {
+		if _, err := comparison.NewLteRule([]string{bound}); err != nil {
+			t.Errorf("expected bound %q to be accepted, got %v", bound, err)
+		}
+	}
+}
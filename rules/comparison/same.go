@@ -2,10 +2,10 @@ package comparison
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -35,13 +35,13 @@ func NewSameRule(parameters []string) (contract.Rule, error) {
 
 // Validate checks if the field's value is the same as the other field's value.
 func (r *SameRule) Validate(ctx contract.RuleContext) error {
-	otherValue, ok := ctx.Data()[r.otherField]
+	otherValue, ok := utils.GetPath(ctx.Data(), r.otherField)
 	if !ok {
 		return errors.New(sameRuleFieldForCompareMissedMsg)
 	}
 
-	// Using fmt.Sprintf to handle different types consistently during comparison.
-	if fmt.Sprintf("%v", ctx.Value()) != fmt.Sprintf("%v", otherValue) {
+	// Registered comparators first, %v representations otherwise
+	if !common.ValuesEqual(ctx.Value(), otherValue) {
 		return errors.New(sameRuleDefaultMsg)
 	}
 
@@ -47,3 +47,15 @@ func TestSameRule(t *testing.T) {
 		})
 	}
 }
+
+func TestSameRule_NestedFields(t *testing.T) {
+	rule, _ := comparison.NewSameRule([]string{"address.city"})
+	data := map[string]any{"address": map[string]any{"city": "Oslo"}}
+
+	if err := rule.Validate(contract.NewValidationContext("billing.city", "Oslo", nil, data)); err != nil {
+		t.Errorf("expected the same nested value to pass, got %v", err)
+	}
+	if err := rule.Validate(contract.NewValidationContext("billing.city", "Rome", nil, data)); err == nil {
+		t.Error("expected a different nested value to fail")
+	}
+}
@@ -3,7 +3,7 @@ package comparison
 import (
 	"errors"
 	"fmt"
-	"strconv"
+	"math/big"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -18,7 +18,7 @@ const (
 // SizeRule is a validation rule that checks if a value's size matches a given value.
 type SizeRule struct {
 	common.BaseRule
-	size float64
+	size *big.Rat
 }
 
 // NewSizeRule creates a new SizeRule.
@@ -28,7 +28,7 @@ func NewSizeRule(parameters []string) (contract.Rule, error) {
 	}
 
 	// Parse the provided size parameter
-	val, err := strconv.ParseFloat(parameters[0], 64)
+	val, err := parseBound(parameters[0])
 	if err != nil {
 		return nil, fmt.Errorf("size rule parameter must be numeric: %v", err)
 	}
@@ -47,13 +47,13 @@ func (r *SizeRule) Validate(ctx contract.RuleContext) error {
 	}
 
 	// Get the actual value as a float
-	actualValue, err := getAsFloat(ctx.Value())
+	actualValue, err := sizeOf(ctx.Value())
 	if err != nil {
 		return errors.New(sizeRuleParamError)
 	}
 
 	// Check if the size matches the specified size
-	if actualValue != r.size {
+	if actualValue.Cmp(r.size) != 0 {
 		return errors.New(sizeRuleDefaultMsg)
 	}
 
@@ -0,0 +1,46 @@
+package comparison_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/comparison"
+)
+
+// cents is a money amount that reports its size in whole units
+type cents int64
+
+func (c cents) ValidationSize() float64 {
+	return float64(c) / 100
+}
+
+func TestSizerAwareRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		create  func([]string) (contract.Rule, error)
+		params  []string
+		value   any
+		wantErr bool
+	}{
+		{"min passes", comparison.NewMinRule, []string{"10"}, cents(1500), false},
+		{"min fails", comparison.NewMinRule, []string{"10"}, cents(999), true},
+		{"max passes", comparison.NewMaxRule, []string{"20"}, cents(2000), false},
+		{"size matches", comparison.NewSizeRule, []string{"3"}, cents(300), false},
+		{"between fails", comparison.NewBetweenRule, []string{"1", "5"}, cents(501), true},
+		{"gte passes", comparison.NewGteRule, []string{"2"}, cents(200), false},
+		{"lt fails", comparison.NewLtRule, []string{"2"}, cents(200), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := tt.create(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected creation error: %v", err)
+			}
+			err = rule.Validate(contract.NewValidationContext("amount", tt.value, tt.params, nil))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
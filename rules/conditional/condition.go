@@ -0,0 +1,34 @@
+package conditional
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// fieldEquals reports whether the field at path field is present and renders as expected,
+// with the condition in "field=value" form
+func fieldEquals(ctx contract.RuleContext, field, expected string) (bool, string) {
+	otherValue, exists := utils.GetPath(ctx.Data(), field)
+	return exists && fmt.Sprintf("%v", otherValue) == expected, field + "=" + expected
+}
+
+// presentFields splits fields into those present in and absent from the data of ctx
+func presentFields(ctx contract.RuleContext, fields []string) (present, absent []string) {
+	data := ctx.Data()
+	for _, field := range fields {
+		if _, ok := utils.GetPath(data, field); ok {
+			present = append(present, field)
+		} else {
+			absent = append(absent, field)
+		}
+	}
+	return present, absent
+}
+
+// presenceReason renders a presence condition such as "phone present" or "email, phone absent"
+func presenceReason(fields []string, state string) string {
+	return strings.Join(fields, ", ") + " " + state
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -30,7 +31,7 @@ func (r *prohibitedRule) Name() string {
 
 // Validate returns an error if the field exists in the data, regardless of value.
 func (r *prohibitedRule) Validate(ctx contract.RuleContext) error {
-	if _, exists := ctx.Data()[ctx.Field()]; exists {
+	if _, exists := utils.GetPath(ctx.Data(), ctx.Field()); exists {
 		return errors.New(prohibitedRuleDefaultMsg)
 	}
 	return nil
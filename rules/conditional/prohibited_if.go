@@ -2,10 +2,10 @@ package conditional
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -37,17 +37,21 @@ func (r *prohibitedIfRule) Name() string {
 	return prohibitedIfRuleName
 }
 
+// Applies reports whether the field is prohibited, i.e. the other field has the given value.
+func (r *prohibitedIfRule) Applies(ctx contract.RuleContext) (bool, string) {
+	return fieldEquals(ctx, r.otherField, r.value)
+}
+
 // Validate returns an error if the other field has the expected value and the current field is present.
 func (r *prohibitedIfRule) Validate(ctx contract.RuleContext) error {
 	data := ctx.Data()
 	field := ctx.Field()
 
-	otherValue, ok := data[r.otherField]
-	if !ok || fmt.Sprintf("%v", otherValue) != r.value {
+	if applies, _ := r.Applies(ctx); !applies {
 		return nil // Other field is not present or doesn't match → pass
 	}
 
-	if _, present := data[field]; present {
+	if _, present := utils.GetPath(data, field); present {
 		return errors.New(prohibitedIfRuleDefaultMsg)
 	}
 
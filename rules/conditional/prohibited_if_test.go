@@ -59,3 +59,18 @@ func TestProhibitedIfRule(t *testing.T) {
 		})
 	}
 }
+
+func TestProhibitedIfRule_NestedFields(t *testing.T) {
+	rule, _ := conditional.NewProhibitedIfRule([]string{"plan.type", "free"})
+	data := map[string]any{
+		"plan":    map[string]any{"type": "free"},
+		"address": map[string]any{"vat": "NO123"},
+	}
+
+	if err := rule.Validate(contract.NewValidationContext("address.vat", "NO123", nil, data)); err == nil {
+		t.Error("expected a nested field to be prohibited by a nested condition")
+	}
+	if err := rule.Validate(contract.NewValidationContext("address.zip", nil, nil, data)); err != nil {
+		t.Errorf("expected an absent nested field to pass, got %v", err)
+	}
+}
@@ -59,3 +59,17 @@ func TestProhibitedRule(t *testing.T) {
 		})
 	}
 }
+
+func TestProhibitedRule_NestedFields(t *testing.T) {
+	rule, _ := conditional.NewProhibitedRule()
+	data := map[string]any{
+		"address": map[string]any{"city": "Oslo"},
+		"items":   []any{map[string]any{"sku": "AB"}},
+	}
+
+	for field, want := range map[string]bool{"address.city": false, "items.0.sku": false, "address.zip": true} {
+		if err := rule.Validate(contract.NewValidationContext(field, nil, nil, data)); (err == nil) != want {
+			t.Errorf("%s: expected pass=%v, got %v", field, want, err)
+		}
+	}
+}
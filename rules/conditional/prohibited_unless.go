@@ -2,10 +2,10 @@ package conditional
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -38,18 +38,22 @@ func (r *prohibitedUnlessRule) Name() string {
 	return prohibitedUnlessRuleName
 }
 
+// Applies reports whether the field is prohibited, i.e. the other field does not have the allowed value.
+func (r *prohibitedUnlessRule) Applies(ctx contract.RuleContext) (bool, string) {
+	equal, _ := fieldEquals(ctx, r.otherField, r.value)
+	return !equal, r.otherField + "!=" + r.value
+}
+
 // Validate returns an error if the field is present and the other field does not match the allowed value.
 func (r *prohibitedUnlessRule) Validate(ctx contract.RuleContext) error {
 	data := ctx.Data()
 	field := ctx.Field()
 
-	otherValue, ok := data[r.otherField]
-
-	if ok && fmt.Sprintf("%v", otherValue) == r.value {
+	if applies, _ := r.Applies(ctx); !applies {
 		return nil // allowed: other field has allowed value
 	}
 
-	if _, present := data[field]; present {
+	if _, present := utils.GetPath(data, field); present {
 		return errors.New(prohibitedUnlessRuleDefaultMsg)
 	}
 
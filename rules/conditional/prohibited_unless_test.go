@@ -66,3 +66,16 @@ func TestProhibitedUnlessRule(t *testing.T) {
 		})
 	}
 }
+
+func TestProhibitedUnlessRule_NestedFields(t *testing.T) {
+	rule, _ := conditional.NewProhibitedUnlessRule([]string{"plan.type", "business"})
+	data := map[string]any{"plan": map[string]any{"type": "free"}, "address": map[string]any{"vat": "NO123"}}
+
+	if err := rule.Validate(contract.NewValidationContext("address.vat", "NO123", nil, data)); err == nil {
+		t.Error("expected a nested field to be prohibited")
+	}
+	data["plan"] = map[string]any{"type": "business"}
+	if err := rule.Validate(contract.NewValidationContext("address.vat", "NO123", nil, data)); err != nil {
+		t.Errorf("expected the nested condition to allow the field, got %v", err)
+	}
+}
@@ -71,3 +71,18 @@ func TestProhibitsRule(t *testing.T) {
 		})
 	}
 }
+
+func TestProhibitsRule_NestedFields(t *testing.T) {
+	rule, _ := conditional.NewProhibitsRule([]string{"billing.vat"})
+	data := map[string]any{
+		"company": map[string]any{"name": "Acme"},
+		"billing": map[string]any{"vat": "NO123"},
+	}
+
+	if err := rule.Validate(contract.NewValidationContext("company.name", "Acme", nil, data)); err == nil {
+		t.Error("expected a nested prohibited field to fail")
+	}
+	if err := rule.Validate(contract.NewValidationContext("company.id", nil, nil, data)); err != nil {
+		t.Errorf("expected an absent nested field to pass, got %v", err)
+	}
+}
@@ -29,11 +29,18 @@ func (r *requiredRule) Name() string {
 }
 
 // Validate checks for non-nil and non-empty value, idiomatic for all major Go types.
+// Zero values such as false and 0 fail unless the engine uses contract.RequiredGo.
 func (r *requiredRule) Validate(ctx contract.RuleContext) error {
 	value := ctx.Value()
 	if value == nil {
 		return errors.New(requiredRuleDefaultMsg)
 	}
+	if empty, handled := common.HandledEmpty(value); handled {
+		if empty {
+			return errors.New(requiredRuleDefaultMsg)
+		}
+		return nil
+	}
 
 	val := reflect.ValueOf(value)
 	switch val.Kind() {
@@ -45,6 +52,9 @@ func (r *requiredRule) Validate(ctx contract.RuleContext) error {
 		if val.IsNil() {
 			return errors.New(requiredRuleDefaultMsg)
 		}
+		if zeroIsPresent(ctx) {
+			return nil
+		}
 		// For non-nil pointers, check if the dereferenced value is zero
 		if val.Kind() == reflect.Ptr {
 			elem := val.Elem()
@@ -53,9 +63,17 @@ func (r *requiredRule) Validate(ctx contract.RuleContext) error {
 			}
 		}
 	default:
-		if val.IsZero() {
+		if val.IsZero() && !zeroIsPresent(ctx) {
 			return errors.New(requiredRuleDefaultMsg)
 		}
 	}
 	return nil
 }
+
+// zeroIsPresent reports whether the engine's required preset accepts zero values (contract.RequiredGo)
+func zeroIsPresent(ctx contract.RuleContext) bool {
+	preset, ok := ctx.(interface {
+		RequiredPreset() contract.RequiredPreset
+	})
+	return ok && preset.RequiredPreset() == contract.RequiredGo
+}
@@ -2,7 +2,6 @@ package conditional
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -38,10 +37,14 @@ func (r *requiredIfRule) Name() string {
 	return requiredIfRuleName
 }
 
+// Applies reports whether the field is required, i.e. the other field has the given value.
+func (r *requiredIfRule) Applies(ctx contract.RuleContext) (bool, string) {
+	return fieldEquals(ctx, r.conditionField, r.conditionValue)
+}
+
 // Validate checks if the field is required when the condition is met.
 func (r *requiredIfRule) Validate(ctx contract.RuleContext) error {
-	otherValue, exists := ctx.Data()[r.conditionField]
-	if !exists || fmt.Sprintf("%v", otherValue) != r.conditionValue {
+	if applies, _ := r.Applies(ctx); !applies {
 		return nil // Condition not met → field not required
 	}
 
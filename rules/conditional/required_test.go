@@ -73,3 +73,22 @@ func TestRequired_ErrorMessage(t *testing.T) {
 		}
 	}
 }
+
+func TestRequired_GoPreset(t *testing.T) {
+	rule, _ := NewRequiredRule()
+	goCtx := func(value any) contract.RuleContext {
+		return contract.NewValidationContext("field", value, nil, nil).WithRequiredPreset(contract.RequiredGo)
+	}
+
+	var pNil *int
+	for _, value := range []any{false, 0, 0.0, new(int), struct{}{}} {
+		if err := rule.Validate(goCtx(value)); err != nil {
+			t.Fatalf("expected zero value %#v to pass, got %v", value, err)
+		}
+	}
+	for _, value := range []any{nil, "", []int{}, map[string]int{}, pNil} {
+		if err := rule.Validate(goCtx(value)); err == nil {
+			t.Fatalf("expected empty value %#v to fail", value)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package conditional
+
+import (
+	"errors"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+const (
+	requiredTogetherRuleName          = "required_together"
+	requiredTogetherRuleDefaultMsg    = "the :attribute field must be provided together with :values"
+	requiredTogetherRuleParamErrorMsg = "required_together rule requires at least one other field"
+)
+
+// requiredTogetherRule checks that a group of fields is either fully provided or fully omitted.
+// The group consists of the field under validation and every field listed in the parameters.
+type requiredTogetherRule struct {
+	common.BaseRule
+	otherFields []string
+}
+
+// NewRequiredTogetherRule creates a new instance of requiredTogetherRule.
+// Usage: required_together:city,zip
+func NewRequiredTogetherRule(params []string) (contract.Rule, error) {
+	if len(params) == 0 {
+		return nil, errors.New(requiredTogetherRuleParamErrorMsg)
+	}
+	return &requiredTogetherRule{
+		BaseRule:    common.NewBaseRule(requiredTogetherRuleName, requiredTogetherRuleDefaultMsg, params),
+		otherFields: params,
+	}, nil
+}
+
+func (r *requiredTogetherRule) Name() string {
+	return requiredTogetherRuleName
+}
+
+// Validate passes when all fields of the group are filled or none of them are.
+func (r *requiredTogetherRule) Validate(ctx contract.RuleContext) error {
+	data := ctx.Data()
+	filled := 0
+	if isFilled(ctx.Value()) {
+		filled++
+	}
+	for _, field := range r.otherFields {
+		if value, _ := utils.GetPath(data, field); isFilled(value) {
+			filled++
+		}
+	}
+
+	if filled == 0 || filled == len(r.otherFields)+1 {
+		return nil
+	}
+	return errors.New(requiredTogetherRuleDefaultMsg)
+}
+
+// isFilled reports whether a value counts as provided for group presence checks.
+func isFilled(value any) bool {
+	if value == nil {
+		return false
+	}
+	if s, ok := value.(string); ok && s == "" {
+		return false
+	}
+	return true
+}
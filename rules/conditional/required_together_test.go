@@ -0,0 +1,62 @@
+package conditional_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/conditional"
+)
+
+func TestRequiredTogetherRule(t *testing.T) {
+	rule, err := conditional.NewRequiredTogetherRule([]string{"city", "zip"})
+	if err != nil {
+		t.Fatalf("Failed to create RequiredTogetherRule: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		value      any
+		data       map[string]any
+		shouldPass bool
+	}{
+		{"passes when none are provided", nil, map[string]any{}, true},
+		{"passes when none are filled", "", map[string]any{"city": "", "zip": nil}, true},
+		{"passes when all are provided", "Main St", map[string]any{"city": "Berlin", "zip": "10115"}, true},
+		{"fails when only the field is provided", "Main St", map[string]any{}, false},
+		{"fails when another field is missing", "Main St", map[string]any{"city": "Berlin"}, false},
+		{"fails when the field is missing but others are present", "", map[string]any{"city": "Berlin", "zip": 10115}, false},
+		{"passes when data map is nil", nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contract.NewValidationContext("street", tt.value, nil, tt.data)
+			err := rule.Validate(ctx)
+			if tt.shouldPass && err != nil {
+				t.Errorf("expected pass but got error: %v", err)
+			}
+			if !tt.shouldPass && err == nil {
+				t.Errorf("expected failure, but got pass for value: %#v", tt.value)
+			}
+		})
+	}
+}
+
+func TestRequiredTogetherRule_InvalidParameters(t *testing.T) {
+	if _, err := conditional.NewRequiredTogetherRule(nil); err == nil {
+		t.Error("expected error for no parameters, got nil")
+	}
+}
+
+func TestRequiredTogetherRule_NestedFields(t *testing.T) {
+	rule, _ := conditional.NewRequiredTogetherRule([]string{"address.zip"})
+
+	filled := map[string]any{"address": map[string]any{"street": "Main St", "zip": "10115"}}
+	if err := rule.Validate(contract.NewValidationContext("address.street", "Main St", nil, filled)); err != nil {
+		t.Errorf("expected pass with both nested fields, got %v", err)
+	}
+	missing := map[string]any{"address": map[string]any{"street": "Main St"}}
+	if err := rule.Validate(contract.NewValidationContext("address.street", "Main St", nil, missing)); err == nil {
+		t.Error("expected failure without the nested partner field")
+	}
+}
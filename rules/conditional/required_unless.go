@@ -2,7 +2,6 @@ package conditional
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -38,10 +37,15 @@ func (r *requiredUnlessRule) Name() string {
 	return requiredUnlessRuleName
 }
 
+// Applies reports whether the field is required, i.e. the other field does not have the given value.
+func (r *requiredUnlessRule) Applies(ctx contract.RuleContext) (bool, string) {
+	equal, _ := fieldEquals(ctx, r.conditionField, r.conditionValue)
+	return !equal, r.conditionField + "!=" + r.conditionValue
+}
+
 // Validate checks if the field is required unless the other field has the specified value.
 func (r *requiredUnlessRule) Validate(ctx contract.RuleContext) error {
-	otherValue, exists := ctx.Data()[r.conditionField]
-	if exists && fmt.Sprintf("%v", otherValue) == r.conditionValue {
+	if applies, _ := r.Applies(ctx); !applies {
 		return nil // condition met, field not required
 	}
 
@@ -35,17 +35,15 @@ func (r *requiredWithRule) Name() string {
 	return requiredWithRuleName
 }
 
+// Applies reports whether the field is required, i.e. any of the other fields is present.
+func (r *requiredWithRule) Applies(ctx contract.RuleContext) (bool, string) {
+	present, _ := presentFields(ctx, r.otherFields)
+	return len(present) > 0, presenceReason(present, "present")
+}
+
 // Validate checks if this field is required when any of the other fields are present.
 func (r *requiredWithRule) Validate(ctx contract.RuleContext) error {
-	data := ctx.Data()
-	anyFieldPresent := false
-	for _, field := range r.otherFields {
-		if _, ok := data[field]; ok {
-			anyFieldPresent = true
-			break
-		}
-	}
-	if !anyFieldPresent {
+	if applies, _ := r.Applies(ctx); !applies {
 		return nil // None of the other fields are present; not required
 	}
 
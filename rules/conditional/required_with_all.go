@@ -35,13 +35,16 @@ func (r *requiredWithAllRule) Name() string {
 	return requiredWithAllRuleName
 }
 
+// Applies reports whether the field is required, i.e. all the other fields are present.
+func (r *requiredWithAllRule) Applies(ctx contract.RuleContext) (bool, string) {
+	_, absent := presentFields(ctx, r.otherFields)
+	return len(absent) == 0, presenceReason(r.otherFields, "present")
+}
+
 // Validate checks if this field is required when all the other fields are present.
 func (r *requiredWithAllRule) Validate(ctx contract.RuleContext) error {
-	data := ctx.Data()
-	for _, field := range r.otherFields {
-		if _, ok := data[field]; !ok {
-			return nil // If any required field is missing, this rule passes
-		}
+	if applies, _ := r.Applies(ctx); !applies {
+		return nil // If any required field is missing, this rule passes
 	}
 
 	// All required fields are present; now this field must be non-empty
@@ -36,17 +36,15 @@ func (r *requiredWithoutRule) Name() string {
 	return requiredWithoutRuleName
 }
 
+// Applies reports whether the field is required, i.e. none of the other fields is present.
+func (r *requiredWithoutRule) Applies(ctx contract.RuleContext) (bool, string) {
+	present, _ := presentFields(ctx, r.otherFields)
+	return len(present) == 0, presenceReason(r.otherFields, "absent")
+}
+
 // Validate checks if the field is required when none of the other fields are present.
 func (r *requiredWithoutRule) Validate(ctx contract.RuleContext) error {
-	data := ctx.Data()
-	anyFieldPresent := false
-	for _, field := range r.otherFields {
-		if _, ok := data[field]; ok {
-			anyFieldPresent = true
-			break
-		}
-	}
-	if anyFieldPresent {
+	if applies, _ := r.Applies(ctx); !applies {
 		return nil // Not required if any of the other fields are present
 	}
 
@@ -31,32 +31,26 @@ func NewRequiredWithoutAllRule(params []string) (contract.Rule, error) {
 	}, nil
 }
 
+// Applies reports whether the field is required, i.e. all the other fields are absent.
+func (r *requiredWithoutAllRule) Applies(ctx contract.RuleContext) (bool, string) {
+	present, _ := presentFields(ctx, r.otherFields)
+	return len(present) == 0, presenceReason(r.otherFields, "absent")
+}
+
 // Validate checks if the field is required when all other fields are not present.
 func (r *requiredWithoutAllRule) Validate(ctx contract.RuleContext) error {
-	data := ctx.Data()
-	value := ctx.Value()
-
-	// Count how many of the other fields are present
-	presentCount := 0
-	if data != nil {
-		for _, field := range r.otherFields {
-			if _, ok := data[field]; ok {
-				presentCount++
-			}
-		}
+	// If any other field is present, this field is not required, so it always passes
+	if applies, _ := r.Applies(ctx); !applies {
+		return nil
 	}
 
-	// If ALL other fields are missing (presentCount == 0), then this field is required
-	if presentCount == 0 {
-		// Field is required - check if it has a value
-		if value == nil {
-			return errors.New(requiredWithoutAllRuleInvalidDataMsg)
-		}
-		if s, ok := value.(string); ok && s == "" {
-			return errors.New(requiredWithoutAllRuleInvalidDataMsg)
-		}
+	// All other fields are missing, so this field must have a value
+	value := ctx.Value()
+	if value == nil {
+		return errors.New(requiredWithoutAllRuleInvalidDataMsg)
+	}
+	if s, ok := value.(string); ok && s == "" {
+		return errors.New(requiredWithoutAllRuleInvalidDataMsg)
 	}
-
-	// If any other field is present, this field is not required, so it always passes
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -30,7 +31,7 @@ func (r *filledRule) Name() string {
 
 // Validate ensures the field is filled only if it is present in input data.
 func (r *filledRule) Validate(ctx contract.RuleContext) error {
-	_, fieldPresent := ctx.Data()[ctx.Field()]
+	_, fieldPresent := utils.GetPath(ctx.Data(), ctx.Field())
 	if !fieldPresent {
 		return nil
 	}
@@ -40,6 +41,9 @@ func (r *filledRule) Validate(ctx contract.RuleContext) error {
 		return errors.New(filledRuleEmptyMsg)
 	}
 
+	if empty, handled := common.HandledEmpty(value); handled && empty {
+		return errors.New(filledRuleDefaultMsg)
+	}
 	if str, ok := value.(string); ok && str == "" {
 		return errors.New(filledRuleDefaultMsg)
 	}
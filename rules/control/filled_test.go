@@ -72,3 +72,15 @@ func TestFilledRule(t *testing.T) {
 		})
 	}
 }
+
+func TestFilledRule_NestedFields(t *testing.T) {
+	rule, _ := control.NewFilledRule()
+	data := map[string]any{"items": []any{map[string]any{"sku": ""}, map[string]any{"sku": "AB"}}}
+
+	if err := rule.Validate(contract.NewValidationContext("items.0.sku", "", nil, data)); err == nil {
+		t.Error("expected an empty nested field to fail")
+	}
+	if err := rule.Validate(contract.NewValidationContext("items.1.sku", "AB", nil, data)); err != nil {
+		t.Errorf("expected a filled nested field to pass, got %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
 )
 
 const (
@@ -29,7 +30,7 @@ func (r *presentRule) Name() string {
 
 // Validate fails if the field is not present in the data.
 func (r *presentRule) Validate(ctx contract.RuleContext) error {
-	if _, ok := ctx.Data()[ctx.Field()]; !ok {
+	if _, ok := utils.GetPath(ctx.Data(), ctx.Field()); !ok {
 		return errors.New(presentRuleDefaultMsg)
 	}
 	return nil
@@ -71,3 +71,17 @@ func TestPresentRule(t *testing.T) {
 		})
 	}
 }
+
+func TestPresentRule_NestedFields(t *testing.T) {
+	rule, _ := control.NewPresentRule()
+	data := map[string]any{
+		"address": map[string]any{"city": ""},
+		"items":   []any{map[string]any{"sku": "AB"}, map[string]any{}},
+	}
+
+	for field, want := range map[string]bool{"address.city": true, "items.0.sku": true, "items.1.sku": false} {
+		if err := rule.Validate(contract.NewValidationContext(field, nil, nil, data)); (err == nil) != want {
+			t.Errorf("%s: expected pass=%v, got %v", field, want, err)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+const (
+	constraintExcept       = "except"
+	constraintWhere        = "where"
+	constraintWhereNull    = "where_null"
+	constraintWhereNotNull = "where_not_null"
+
+	constraintInvalidMsg = "invalid presence constraint %q: expected except=column[:field], where=column:value, " +
+		"where_null=column or where_not_null=column"
+	constraintUnsupportedMsg = "the presence verifier for table '%s' does not support constraints; " +
+		"implement contract.ConstrainedPresenceVerifier"
+)
+
+// parsePresenceQuery builds the query of an exists/unique rule from its parameters:
+// table, optional column, then any number of constraints. except=id[:field] excludes the row whose
+// id equals the value of field (defaults to the column name); where=column:value compares
+// column with the value of the field named value, or with value itself when no such field exists.
+func parsePresenceQuery(ctx contract.RuleContext) (contract.PresenceQuery, error) {
+	params := ctx.Parameters()
+	query := contract.PresenceQuery{
+		Context: contract.ContextOf(ctx),
+		Table:   params[0],
+		Column:  presenceColumn(ctx),
+		Value:   ctx.Value(),
+	}
+
+	if len(params) < 2 {
+		return query, nil
+	}
+	for _, param := range params[2:] {
+		key, value, _ := strings.Cut(param, "=")
+		switch strings.TrimSpace(key) {
+		case constraintExcept:
+			column, field, hasField := strings.Cut(value, ":")
+			if column == "" {
+				return query, fmt.Errorf(constraintInvalidMsg, param)
+			}
+			if !hasField {
+				field = column
+			}
+			if exceptValue, exists := utils.GetPath(ctx.Data(), field); exists && exceptValue != nil {
+				query.ExceptColumn = column
+				query.ExceptValue = utils.Unwrap(exceptValue)
+			}
+		case constraintWhere:
+			column, reference, ok := strings.Cut(value, ":")
+			if !ok || column == "" {
+				return query, fmt.Errorf(constraintInvalidMsg, param)
+			}
+			if query.Where == nil {
+				query.Where = make(map[string]any)
+			}
+			query.Where[column] = constraintValue(ctx.Data(), reference)
+		case constraintWhereNull:
+			if value == "" {
+				return query, fmt.Errorf(constraintInvalidMsg, param)
+			}
+			query.WhereNull = append(query.WhereNull, value)
+		case constraintWhereNotNull:
+			if value == "" {
+				return query, fmt.Errorf(constraintInvalidMsg, param)
+			}
+			query.WhereNotNull = append(query.WhereNotNull, value)
+		default:
+			return query, fmt.Errorf(constraintInvalidMsg, param)
+		}
+	}
+
+	return query, nil
+}
+
+// presenceMemoKey identifies the resolved query of ctx, so that repeated values
+// (e.g. "emails.*": "unique:users,email") are only looked up once per validation
+func presenceMemoKey(ctx contract.RuleContext) (string, bool) {
+	query, err := parsePresenceQuery(ctx)
+	if err != nil {
+		return "", false
+	}
+
+	var key strings.Builder
+	fmt.Fprintf(&key, "%s\x00%s\x00%T:%v", query.Table, query.Column, query.Value, query.Value)
+	if query.ExceptColumn != "" {
+		fmt.Fprintf(&key, "\x00except=%s:%T:%v", query.ExceptColumn, query.ExceptValue, query.ExceptValue)
+	}
+	columns := make([]string, 0, len(query.Where))
+	for column := range query.Where {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	for _, column := range columns {
+		fmt.Fprintf(&key, "\x00where=%s:%T:%v", column, query.Where[column], query.Where[column])
+	}
+	fmt.Fprintf(&key, "\x00null=%v\x00not_null=%v", query.WhereNull, query.WhereNotNull)
+	return key.String(), true
+}
+
+// presenceColumn returns the column parameter, defaulting to the last non-numeric segment of
+// the field name when omitted (e.g. "exists:sessions" on "token" or "tokens.2" checks the token
+// or tokens column/key), like Laravel's guessColumnForQuery
+func presenceColumn(ctx contract.RuleContext) string {
+	params := ctx.Parameters()
+	if len(params) > 1 && params[1] != "" {
+		return params[1]
+	}
+	segments := strings.Split(ctx.Field(), ".")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if _, err := strconv.Atoi(segments[i]); err != nil {
+			return segments[i]
+		}
+	}
+	return ctx.Field()
+}
+
+// constraintValue resolves a where value from data, falling back to the literal reference
+func constraintValue(data map[string]any, reference string) any {
+	if value, exists := utils.GetPath(data, reference); exists {
+		return utils.Unwrap(value)
+	}
+	return reference
+}
+
+// existsMatching reports whether rows match query. Constrained verifiers always receive
+// the full query; plain verifiers are only used for queries without constraints.
+func existsMatching(verifier contract.PresenceVerifier, query contract.PresenceQuery) (bool, error) {
+	if constrained, ok := verifier.(contract.ConstrainedPresenceVerifier); ok {
+		count, err := constrained.Count(query)
+		return count > 0, databaseError(err)
+	}
+	if query.HasConstraints() {
+		return false, fmt.Errorf(constraintUnsupportedMsg, query.Table)
+	}
+	found, err := verifier.Exists(query.Table, query.Column, query.Value)
+	return found, databaseError(err)
+}
+
+// uniqueMatching reports whether no row matches query, see existsMatching
+func uniqueMatching(verifier contract.PresenceVerifier, query contract.PresenceQuery) (bool, error) {
+	if constrained, ok := verifier.(contract.ConstrainedPresenceVerifier); ok {
+		count, err := constrained.Count(query)
+		return count == 0, databaseError(err)
+	}
+	if query.HasConstraints() {
+		return false, fmt.Errorf(constraintUnsupportedMsg, query.Table)
+	}
+	unique, err := verifier.Unique(query.Table, query.Column, query.Value)
+	return unique, databaseError(err)
+}
+
+// databaseError marks a verifier error as the database being unavailable
+func databaseError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &contract.ExternalError{Resource: contract.ResourceDatabase, Err: err}
+}
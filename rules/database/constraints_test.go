@@ -0,0 +1,129 @@
+package database_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry/database"
+	databaseRule "github.com/next-trace/scg-validator/rules/database"
+)
+
+// recordingVerifier captures the constrained query it receives
+type recordingVerifier struct {
+	count int64
+	query contract.PresenceQuery
+}
+
+func (v *recordingVerifier) Exists(_, _ string, _ any) (bool, error) { return false, nil }
+func (v *recordingVerifier) Unique(_, _ string, _ any) (bool, error) { return false, nil }
+func (v *recordingVerifier) Count(query contract.PresenceQuery) (int64, error) {
+	v.query = query
+	return v.count, nil
+}
+
+func TestUniqueRule_Constraints(t *testing.T) {
+	verifier := &recordingVerifier{}
+	database.RegisterPresenceVerifier("accounts", verifier)
+
+	rule, _ := databaseRule.NewUniqueRule()
+	params := []string{"accounts", "email", "except=id", "where=tenant_id:tenant", "where=status:active",
+		"where_null=deleted_at", "where_not_null=verified_at"}
+	data := map[string]any{"id": 7, "tenant": "acme", "email": "a@b.co"}
+	if err := rule.Validate(contract.NewValidationContext("email", "a@b.co", params, data)); err != nil {
+		t.Fatalf("expected unique value to pass, got %v", err)
+	}
+
+	want := contract.PresenceQuery{
+		Context: context.Background(),
+		Table:   "accounts", Column: "email", Value: "a@b.co",
+		ExceptColumn: "id", ExceptValue: 7,
+		Where:        map[string]any{"tenant_id": "acme", "status": "active"},
+		WhereNull:    []string{"deleted_at"},
+		WhereNotNull: []string{"verified_at"},
+	}
+	if !reflect.DeepEqual(verifier.query, want) {
+		t.Fatalf("unexpected query:\n got %#v\nwant %#v", verifier.query, want)
+	}
+
+	verifier.count = 1
+	if err := rule.Validate(contract.NewValidationContext("email", "a@b.co", params, data)); err == nil {
+		t.Fatal("expected taken value to fail")
+	}
+}
+
+func TestExistRule_Constraints(t *testing.T) {
+	verifier := &recordingVerifier{count: 1}
+	database.RegisterPresenceVerifier("teams", verifier)
+
+	rule, _ := databaseRule.NewExistRule([]string{"teams"})
+	params := []string{"teams", "id", "except=id:team.current", "where_null=deleted_at"}
+	if err := rule.Validate(contract.NewValidationContext("team_id", 3, params, nil)); err != nil {
+		t.Fatalf("expected existing value to pass, got %v", err)
+	}
+	if verifier.query.ExceptColumn != "" {
+		t.Fatalf("expected missing except field to be ignored, got %#v", verifier.query)
+	}
+
+	params = []string{"teams", "", "where_null=deleted_at"}
+	if err := rule.Validate(contract.NewValidationContext("teams.2", 3, params, nil)); err != nil {
+		t.Fatalf("expected existing value to pass, got %v", err)
+	}
+	if verifier.query.Column != "teams" {
+		t.Fatalf("expected the column of a wildcard field to skip its index, got %q", verifier.query.Column)
+	}
+
+	invalid := contract.NewValidationContext("team_id", 3, []string{"teams", "id", "bogus"}, nil)
+	if err := rule.Validate(invalid); err == nil {
+		t.Fatal("expected invalid constraint to fail")
+	}
+}
+
+func TestPresenceRules_ConstraintsNeedConstrainedVerifier(t *testing.T) {
+	database.RegisterPresenceVerifier("plain", &mockUniquePresenceVerifier{uniqueResult: true})
+
+	rule, _ := databaseRule.NewUniqueRule()
+	ctx := contract.NewValidationContext("email", "x", []string{"plain", "email", "where_null=deleted_at"}, nil)
+	if err := rule.Validate(ctx); err == nil {
+		t.Fatal("expected constraints on a plain verifier to fail")
+	}
+}
+
+func TestPresenceRules_MemoKey(t *testing.T) {
+	rule, _ := databaseRule.NewUniqueRule()
+	memoized, ok := rule.(contract.MemoizedRule)
+	if !ok {
+		t.Fatal("expected unique rule to be memoized")
+	}
+
+	params := []string{"users", "email", "except=id"}
+	key := func(field string, value any, data map[string]any) string {
+		k, ok := memoized.MemoKey(contract.NewValidationContext(field, value, params, data))
+		if !ok {
+			t.Fatalf("expected a memo key for %v", value)
+		}
+		return k
+	}
+
+	base := key("emails.0", "a@b.co", map[string]any{"id": 1})
+	if other := key("emails.3", "a@b.co", map[string]any{"id": 1}); other != base {
+		t.Fatalf("expected identical queries to share a key: %q != %q", other, base)
+	}
+	if other := key("emails.0", "c@d.co", map[string]any{"id": 1}); other == base {
+		t.Fatal("expected different values to use different keys")
+	}
+	if other := key("emails.0", "a@b.co", map[string]any{"id": 2}); other == base {
+		t.Fatal("expected different constraints to use different keys")
+	}
+
+	params = []string{"users"}
+	if key("emails.0", "a@b.co", nil) != key("emails.3", "a@b.co", nil) {
+		t.Fatal("expected wildcard fields without a column to share a key")
+	}
+
+	invalid := contract.NewValidationContext("email", "a@b.co", []string{"users", "email", "bogus"}, nil)
+	if _, ok := memoized.MemoKey(invalid); ok {
+		t.Fatal("expected invalid constraints not to be memoized")
+	}
+}
@@ -11,7 +11,7 @@ import (
 
 const (
 	existRuleName              = "exist"
-	existRuleDefaultMsg        = "exist rule requires table and field parameters: exist:table,field"
+	existRuleDefaultMsg        = "exist rule requires a table parameter: exist:table[,field]"
 	existRuleNotImplementedMsg = "the presence verifier for table '%s' is not implemented; " +
 		"please provide a '%s'PresenceVerifier"
 	existRuleMissingTableMsg = "exist rule requires a table name parameter"
@@ -24,7 +24,7 @@ type existRule struct {
 }
 
 // NewExistRule initializes an existRule instance.
-// Usage: exist:table,field
+// Usage: exist:table[,field][,except=column[:field]][,where=column:value][,where_null=column][,where_not_null=column]
 func NewExistRule(params []string) (contract.Rule, error) {
 	if len(params) < 1 {
 		return nil, errors.New(existRuleMissingTableMsg)
@@ -41,21 +41,41 @@ func (r *existRule) Name() string {
 	return existRuleName
 }
 
+// Async lets the engine overlap presence queries with other I/O-bound rules
+func (r *existRule) Async() bool {
+	return true
+}
+
+// Resource flags the rule as querying the database, so it can be rate limited
+func (r *existRule) Resource() string {
+	return contract.ResourceDatabase
+}
+
+// MemoKey lets the engine reuse the outcome for repeated values within one validation
+func (r *existRule) MemoKey(ctx contract.RuleContext) (string, bool) {
+	return presenceMemoKey(ctx)
+}
+
 func (r *existRule) Validate(ctx contract.RuleContext) error {
 	params := ctx.Parameters()
-	if len(params) < 2 {
+	if len(params) < 1 || params[0] == "" {
 		return errors.New(existRuleDefaultMsg)
 	}
 
 	table := params[0]
-	field := params[1]
+	field := presenceColumn(ctx)
 
 	verifier, ok := database.FindPresenceVerifier(table)
 	if !ok {
 		return fmt.Errorf(existRuleNotImplementedMsg, table, table)
 	}
 
-	found, err := verifier.Exists(table, field, ctx.Value())
+	query, err := parsePresenceQuery(ctx)
+	if err != nil {
+		return err
+	}
+
+	found, err := existsMatching(verifier, query)
 	if err != nil {
 		return err
 	}
@@ -10,7 +10,7 @@ import (
 
 const (
 	uniqueRuleName              = "unique"
-	uniqueRuleDefaultMsg        = "unique rule requires table and field parameters: unique:table,field"
+	uniqueRuleDefaultMsg        = "unique rule requires a table parameter: unique:table[,field]"
 	uniqueRuleNotImplementedMsg = "the presence verifier for table '%s' is not implemented; " +
 		"please provide a '%s'PresenceVerifier"
 	uniqueRuleFailedMsg = "the %s must be unique"
@@ -20,7 +20,8 @@ const (
 type uniqueRule struct{}
 
 // NewUniqueRule constructs a new instance of uniqueRule.
-// Usage: unique:users,email
+// Usage: unique:users,email (the column defaults to the field name)
+// Constraints: unique:users,email,except=id,where=tenant_id:tenant,where_null=deleted_at
 func NewUniqueRule() (contract.Rule, error) {
 	return &uniqueRule{}, nil
 }
@@ -29,21 +30,41 @@ func (r *uniqueRule) Name() string {
 	return uniqueRuleName
 }
 
+// Async lets the engine overlap presence queries with other I/O-bound rules
+func (r *uniqueRule) Async() bool {
+	return true
+}
+
+// Resource flags the rule as querying the database, so it can be rate limited
+func (r *uniqueRule) Resource() string {
+	return contract.ResourceDatabase
+}
+
+// MemoKey lets the engine reuse the outcome for repeated values within one validation
+func (r *uniqueRule) MemoKey(ctx contract.RuleContext) (string, bool) {
+	return presenceMemoKey(ctx)
+}
+
 func (r *uniqueRule) Validate(ctx contract.RuleContext) error {
 	params := ctx.Parameters()
-	if len(params) < 2 {
+	if len(params) < 1 || params[0] == "" {
 		return errors.New(uniqueRuleDefaultMsg)
 	}
 
 	table := params[0]
-	field := params[1]
+	field := presenceColumn(ctx)
 
 	verifier, ok := database.FindPresenceVerifier(table)
 	if !ok {
 		return fmt.Errorf(uniqueRuleNotImplementedMsg, table, table)
 	}
 
-	isUnique, err := verifier.Unique(table, field, ctx.Value())
+	query, err := parsePresenceQuery(ctx)
+	if err != nil {
+		return err
+	}
+
+	isUnique, err := uniqueMatching(verifier, query)
 	if err != nil {
 		return err
 	}
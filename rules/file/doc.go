@@ -1,2 +1,6 @@
 // Package file contains rules for file and MIME validations.
+//
+// The file, image and mimes rules validate mime/multipart uploads and are left out of builds with
+// the scgcore or tinygo tag. The extensions and filename rules also check plain filenames and are
+// part of every build.
 package file
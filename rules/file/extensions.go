@@ -0,0 +1,66 @@
+package file
+
+import (
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	extensionsRuleName          = "extensions"
+	extensionsRuleDefaultMsg    = "the :attribute must have one of the following extensions: :values"
+	extensionsRuleTypeError     = "the :attribute must be a filename or a file"
+	extensionsRuleMissingParams = "extensions rule requires at least one extension"
+)
+
+// ExtensionsRule checks the extension of a filename or of an uploaded file's name against an
+// allow-list, ignoring case and a leading dot ("extensions:jpg,.PNG"). Only the name is looked at,
+// the content is not sniffed.
+type ExtensionsRule struct {
+	common.BaseRule
+	allowed map[string]bool
+}
+
+// NewExtensionsRule creates a new ExtensionsRule
+func NewExtensionsRule(params []string) (contract.Rule, error) {
+	allowed := make(map[string]bool, len(params))
+	for _, param := range params {
+		if ext := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(param), ".")); ext != "" {
+			allowed[ext] = true
+		}
+	}
+	if len(allowed) == 0 {
+		return nil, errors.New(extensionsRuleMissingParams)
+	}
+
+	return &ExtensionsRule{
+		BaseRule: common.NewBaseRule(extensionsRuleName, extensionsRuleDefaultMsg, params),
+		allowed:  allowed,
+	}, nil
+}
+
+// Validate checks the extension of the filename
+func (r *ExtensionsRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	name, ok := uploadFilename(ctx)
+	if !ok {
+		return errors.New(extensionsRuleTypeError)
+	}
+
+	// Windows clients may send full paths as the name of an upload
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+	ext := strings.ToLower(strings.TrimPrefix(path.Ext(name), "."))
+	if ext == "" || !r.allowed[ext] {
+		return errors.New(extensionsRuleDefaultMsg)
+	}
+	return nil
+}
+
+func (r *ExtensionsRule) Name() string {
+	return extensionsRuleName
+}
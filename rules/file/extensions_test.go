@@ -0,0 +1,49 @@
+//go:build !scgcore && !tinygo
+
+package file_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/file"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+func TestExtensionsRule(t *testing.T) {
+	rule, err := file.NewExtensionsRule([]string{"jpg", ".PNG", " pdf "})
+	if err != nil {
+		t.Fatalf("failed to create rule: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		value     any
+		wantValid bool
+	}{
+		{"filename", "photo.jpg", true},
+		{"uppercase extension", "PHOTO.JPG", true},
+		{"extension given with a dot", "logo.png", true},
+		{"extension given with spaces", "invoice.pdf", true},
+		{"windows path", `C:\Users\me\scan.pdf`, true},
+		{"upload", utils.NewFileHeader("avatar.Png"), true},
+		{"other extension", "archive.zip", false},
+		{"double extension", "photo.jpg.exe", false},
+		{"no extension", "jpg", false},
+		{"extension in directory only", "photos.jpg/readme", false},
+		{"upload with other extension", utils.NewFileHeader("script.sh"), false},
+		{"not a filename", 42, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rule.Validate(contract.NewValidationContext("file", tt.value, nil, nil))
+			if (err == nil) != tt.wantValid {
+				t.Errorf("expected valid %v for %v, got %v", tt.wantValid, tt.value, err)
+			}
+		})
+	}
+
+	if _, err := file.NewExtensionsRule(nil); err == nil {
+		t.Error("expected an error without extensions")
+	}
+}
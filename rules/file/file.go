@@ -1,3 +1,5 @@
+//go:build !scgcore && !tinygo
+
 // Package file provides validation rules for file inputs including MIME type checks.
 package file
 
@@ -0,0 +1,92 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	filenameRuleName          = "filename"
+	filenameRuleDefaultMsg    = "the :attribute must be a safe filename"
+	filenameRuleTypeError     = "the :attribute must be a filename or a file"
+	filenameRuleInvalidLength = "filename rule requires a positive maximum length, got %q"
+
+	// filenameMaxLength is the default maximum length in bytes, the limit of most file systems
+	filenameMaxLength = 255
+)
+
+// windowsDeviceNames are reserved on Windows, with or without an extension ("nul.txt")
+var windowsDeviceNames = map[string]bool{
+	"con": true, "prn": true, "aux": true, "nul": true,
+	"com1": true, "com2": true, "com3": true, "com4": true, "com5": true,
+	"com6": true, "com7": true, "com8": true, "com9": true,
+	"lpt1": true, "lpt2": true, "lpt3": true, "lpt4": true, "lpt5": true,
+	"lpt6": true, "lpt7": true, "lpt8": true, "lpt9": true,
+}
+
+// FilenameRule checks user-supplied file names before they are written to disk or used in object
+// storage keys. It rejects path separators, the "." and ".." entries, null bytes and other control
+// characters, reserved Windows device names and trailing dots or spaces, which Windows strips.
+// Names are limited to 255 bytes, or to the length given as parameter ("filename:100").
+type FilenameRule struct {
+	common.BaseRule
+	maxLength int
+}
+
+// NewFilenameRule creates a new FilenameRule
+func NewFilenameRule(params []string) (contract.Rule, error) {
+	maxLength := filenameMaxLength
+	if len(params) > 0 {
+		n, err := strconv.Atoi(strings.TrimSpace(params[0]))
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf(filenameRuleInvalidLength, params[0])
+		}
+		maxLength = n
+	}
+
+	return &FilenameRule{
+		BaseRule:  common.NewBaseRule(filenameRuleName, filenameRuleDefaultMsg, params),
+		maxLength: maxLength,
+	}, nil
+}
+
+// Validate checks the filename
+func (r *FilenameRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	name, ok := uploadFilename(ctx)
+	if !ok {
+		return errors.New(filenameRuleTypeError)
+	}
+	if !isSafeFilename(name, r.maxLength) {
+		return errors.New(filenameRuleDefaultMsg)
+	}
+	return nil
+}
+
+func (r *FilenameRule) Name() string {
+	return filenameRuleName
+}
+
+// isSafeFilename checks a single path segment that is safe on Unix and Windows file systems
+func isSafeFilename(name string, maxLength int) bool {
+	if name == "" || len(name) > maxLength || name == "." || name == ".." {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if c := name[i]; c == '/' || c == '\\' || c < 0x20 || c == 0x7f {
+			return false
+		}
+	}
+	if strings.HasSuffix(name, ".") || strings.HasSuffix(name, " ") {
+		return false
+	}
+	base, _, _ := strings.Cut(name, ".")
+	return !windowsDeviceNames[strings.ToLower(strings.TrimSpace(base))]
+}
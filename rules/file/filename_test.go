@@ -0,0 +1,64 @@
+//go:build !scgcore && !tinygo
+
+package file_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/file"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+func TestFilenameRule(t *testing.T) {
+	rule, err := file.NewFilenameRule(nil)
+	if err != nil {
+		t.Fatalf("failed to create rule: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		value     any
+		wantValid bool
+	}{
+		{"plain name", "report-2024.pdf", true},
+		{"name with spaces and dots", "my..notes v2.txt", true},
+		{"unicode name", "résumé.docx", true},
+		{"dotfile", ".env", true},
+		{"upload", utils.NewFileHeader("avatar.png"), true},
+		{"device-like prefix", "console.log", true},
+		{"slash", "../etc/passwd", false},
+		{"backslash", `..\windows\system.ini`, false},
+		{"parent directory", "..", false},
+		{"current directory", ".", false},
+		{"null byte", "image.png\x00.php", false},
+		{"newline", "a\nb.txt", false},
+		{"device name", "CON", false},
+		{"device name with extension", "nul.txt", false},
+		{"numbered device name", "Com1.log", false},
+		{"trailing dot", "file.", false},
+		{"trailing space", "file.txt ", false},
+		{"overlong", strings.Repeat("a", 256), false},
+		{"not a filename", 42, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rule.Validate(contract.NewValidationContext("name", tt.value, nil, nil))
+			if (err == nil) != tt.wantValid {
+				t.Errorf("expected valid %v for %q, got %v", tt.wantValid, tt.value, err)
+			}
+		})
+	}
+
+	short, err := file.NewFilenameRule([]string{"8"})
+	if err != nil {
+		t.Fatalf("failed to create rule: %v", err)
+	}
+	if err := short.Validate(contract.NewValidationContext("name", "long-name.txt", nil, nil)); err == nil {
+		t.Error("expected the length parameter to apply")
+	}
+	if _, err := file.NewFilenameRule([]string{"0"}); err == nil {
+		t.Error("expected an error for a non-positive length")
+	}
+}
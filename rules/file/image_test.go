@@ -1,3 +1,5 @@
+//go:build !scgcore && !tinygo
+
 package file
 
 import (
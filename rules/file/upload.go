@@ -0,0 +1,18 @@
+//go:build !scgcore && !tinygo
+
+package file
+
+import (
+	"mime/multipart"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+// uploadFilename returns the name of a multipart upload or the value as a filename string
+func uploadFilename(ctx contract.RuleContext) (string, bool) {
+	if file, ok := ctx.Value().(*multipart.FileHeader); ok {
+		return file.Filename, true
+	}
+	return common.StringValue(ctx)
+}
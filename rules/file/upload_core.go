@@ -0,0 +1,13 @@
+//go:build scgcore || tinygo
+
+package file
+
+import (
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+// uploadFilename returns the value as a filename string; the core build has no multipart uploads
+func uploadFilename(ctx contract.RuleContext) (string, bool) {
+	return common.StringValue(ctx)
+}
@@ -0,0 +1,177 @@
+package format
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	arnRuleName           = "arn"
+	arnRuleDefaultMsg     = "the :attribute must be a valid ARN"
+	arnRuleServiceMsg     = "the :attribute must be an ARN of the :param0 service"
+	arnRuleInvalidTypeMsg = "the :attribute must be a string"
+
+	arnPrefix        = "arn"
+	arnAccountLength = 12
+	arnAnyParam      = "*"
+)
+
+// ARNRule validates an Amazon Resource Name, arn:partition:service:region:account-id:resource.
+// The region and the account may be empty (arn:aws:s3:::my-bucket), and the account may be "aws"
+// for AWS managed resources. Parameters constrain the service, the resource type and the region:
+// "arn:s3", "arn:iam:role", "arn:lambda:function:eu-west-1"; "*" accepts any. Colons and commas
+// both separate them, so "arn:iam,role" is the same constraint.
+type ARNRule struct {
+	common.BaseRule
+	service      string
+	resourceType string
+	region       string
+}
+
+// NewARNRule creates a new ARNRule
+func NewARNRule(parameters []string) (contract.Rule, error) {
+	// The rule parser hands "arn:iam:role" over as the single parameter "iam:role"
+	var params []string
+	for _, param := range parameters {
+		params = append(params, strings.Split(param, ":")...)
+	}
+	constraint := func(i int) string {
+		if i >= len(params) {
+			return ""
+		}
+		value := strings.ToLower(strings.TrimSpace(params[i]))
+		if value == arnAnyParam {
+			return ""
+		}
+		return value
+	}
+
+	r := &ARNRule{
+		service:      constraint(0),
+		resourceType: constraint(1),
+		region:       constraint(2),
+	}
+	msg := arnRuleDefaultMsg
+	if r.service != "" {
+		msg = arnRuleServiceMsg
+	}
+	r.BaseRule = common.NewBaseRule(arnRuleName, msg, params)
+	return r, nil
+}
+
+// Validate checks the structure of the ARN and the constraints
+func (r *ARNRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	val, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(arnRuleInvalidTypeMsg)
+	}
+
+	parts := strings.SplitN(val, ":", 6)
+	if len(parts) != 6 || parts[0] != arnPrefix {
+		return errors.New(arnRuleDefaultMsg)
+	}
+	partition, service, region, account, resource := parts[1], parts[2], parts[3], parts[4], parts[5]
+	if !isARNPartition(partition) || !isARNService(service) || !isARNRegion(region) ||
+		!isARNAccount(account) || !isARNResource(resource) {
+		return errors.New(arnRuleDefaultMsg)
+	}
+
+	if r.service != "" && service != r.service {
+		return errors.New(r.GetMessage())
+	}
+	if r.resourceType != "" {
+		resourceType, _, _ := strings.Cut(resource, "/")
+		resourceType, _, _ = strings.Cut(resourceType, ":")
+		if resourceType != r.resourceType {
+			return errors.New(r.GetMessage())
+		}
+	}
+	if r.region != "" && region != r.region {
+		return errors.New(r.GetMessage())
+	}
+	return nil
+}
+
+func (r *ARNRule) Name() string {
+	return arnRuleName
+}
+
+// isARNPartition checks aws or aws- followed by lowercase words (aws-cn, aws-us-gov, aws-iso-b)
+func isARNPartition(partition string) bool {
+	if partition == "aws" {
+		return true
+	}
+	rest, ok := strings.CutPrefix(partition, "aws-")
+	return ok && isLowerDashWords(rest, false)
+}
+
+// isARNService checks a lowercase service namespace such as s3, iam or lambda
+func isARNService(service string) bool {
+	return isLowerDashWords(service, true)
+}
+
+// isARNRegion checks an empty region or one such as us-east-1 or us-gov-west-1
+func isARNRegion(region string) bool {
+	if region == "" {
+		return true
+	}
+	i := strings.LastIndexByte(region, '-')
+	if i <= 0 || i == len(region)-1 {
+		return false
+	}
+	for _, c := range []byte(region[i+1:]) {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return isLowerDashWords(region[:i], false)
+}
+
+// isARNAccount checks an empty account, a 12-digit account ID or "aws"
+func isARNAccount(account string) bool {
+	if account == "" || account == "aws" {
+		return true
+	}
+	if len(account) != arnAccountLength {
+		return false
+	}
+	for i := 0; i < len(account); i++ {
+		if account[i] < '0' || account[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isARNResource checks a non-empty resource without whitespace or control characters
+func isARNResource(resource string) bool {
+	if resource == "" {
+		return false
+	}
+	for i := 0; i < len(resource); i++ {
+		if c := resource[i]; c <= ' ' || c == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// isLowerDashWords checks lowercase letters separated by single hyphens, and digits if allowed
+func isLowerDashWords(s string, digits bool) bool {
+	if s == "" || s[0] == '-' || s[len(s)-1] == '-' || strings.Contains(s, "--") {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '-' && (c < 'a' || c > 'z') && (!digits || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
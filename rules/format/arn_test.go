@@ -0,0 +1,56 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestARNRule(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     []string
+		value      any
+		shouldPass bool
+	}{
+		{"s3 bucket", nil, "arn:aws:s3:::my-bucket", true},
+		{"iam role", nil, "arn:aws:iam::123456789012:role/Admin", true},
+		{"lambda function", nil, "arn:aws:lambda:eu-west-1:123456789012:function:my-fn", true},
+		{"managed policy", nil, "arn:aws:iam::aws:policy/ReadOnlyAccess", true},
+		{"govcloud", nil, "arn:aws-us-gov:ec2:us-gov-west-1:123456789012:instance/i-0abc", true},
+		{"china partition", nil, "arn:aws-cn:sqs:cn-north-1:123456789012:queue", true},
+		{"missing resource", nil, "arn:aws:s3:::", false},
+		{"too few parts", nil, "arn:aws:s3:my-bucket", false},
+		{"wrong prefix", nil, "urn:aws:s3:::my-bucket", false},
+		{"unknown partition", nil, "arn:azure:s3:::my-bucket", false},
+		{"uppercase service", nil, "arn:aws:S3:::my-bucket", false},
+		{"malformed region", nil, "arn:aws:lambda:euwest:123456789012:function:f", false},
+		{"short account", nil, "arn:aws:iam::12345:role/Admin", false},
+		{"resource with space", nil, "arn:aws:iam::123456789012:role/My Role", false},
+		{"service constraint", []string{"s3"}, "arn:aws:s3:::my-bucket", true},
+		{"other service", []string{"s3"}, "arn:aws:iam::123456789012:role/Admin", false},
+		{"resource type constraint", []string{"iam", "role"}, "arn:aws:iam::123456789012:role/Admin", true},
+		{"other resource type", []string{"iam", "role"}, "arn:aws:iam::123456789012:user/alice", false},
+		{"colon resource type", []string{"lambda", "function"}, "arn:aws:lambda:eu-west-1:123456789012:function:f", true},
+		{"region constraint", []string{"lambda", "*", "eu-west-1"}, "arn:aws:lambda:eu-west-1:123456789012:function:f", true},
+		{"other region", []string{"lambda", "*", "eu-west-1"}, "arn:aws:lambda:us-east-1:123456789012:function:f", false},
+		{"colon separated", []string{"iam:role"}, "arn:aws:iam::123456789012:role/x", true},
+		{"colon separated other type", []string{"iam:role"}, "arn:aws:iam::123456789012:user/x", false},
+		{"colon separated region", []string{"lambda:function:eu-west-1"}, "arn:aws:lambda:eu-west-1:123456789012:function:f",
+			true},
+		{"not a string", nil, 42, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := format.NewARNRule(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			err = rule.Validate(contract.NewValidationContext("role_arn", tt.value, nil, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %v, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+}
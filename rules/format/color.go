@@ -0,0 +1,228 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	colorRuleName           = "color"
+	colorRuleDefaultMsg     = "the :attribute must be a valid color"
+	colorRuleInvalidTypeMsg = "the :attribute must be a string"
+	colorRuleUnknownFormat  = "color rule does not support format %q"
+
+	// Formats of the color rule, given as parameters ("color:hex,rgb")
+	ColorFormatHex   = "hex"
+	ColorFormatRGB   = "rgb"
+	ColorFormatHSL   = "hsl"
+	ColorFormatNamed = "named"
+)
+
+// cssNamedColors are the CSS Color Module Level 4 named colors, with transparent
+var cssNamedColors = map[string]bool{
+	"aliceblue": true, "antiquewhite": true, "aqua": true, "aquamarine": true, "azure": true, "beige": true,
+	"bisque": true, "black": true, "blanchedalmond": true, "blue": true, "blueviolet": true, "brown": true,
+	"burlywood": true, "cadetblue": true, "chartreuse": true, "chocolate": true, "coral": true,
+	"cornflowerblue": true, "cornsilk": true, "crimson": true, "cyan": true, "darkblue": true, "darkcyan": true,
+	"darkgoldenrod": true, "darkgray": true, "darkgreen": true, "darkgrey": true, "darkkhaki": true,
+	"darkmagenta": true, "darkolivegreen": true, "darkorange": true, "darkorchid": true, "darkred": true,
+	"darksalmon": true, "darkseagreen": true, "darkslateblue": true, "darkslategray": true,
+	"darkslategrey": true, "darkturquoise": true, "darkviolet": true, "deeppink": true, "deepskyblue": true,
+	"dimgray": true, "dimgrey": true, "dodgerblue": true, "firebrick": true, "floralwhite": true,
+	"forestgreen": true, "fuchsia": true, "gainsboro": true, "ghostwhite": true, "gold": true,
+	"goldenrod": true, "gray": true, "green": true, "greenyellow": true, "grey": true, "honeydew": true,
+	"hotpink": true, "indianred": true, "indigo": true, "ivory": true, "khaki": true, "lavender": true,
+	"lavenderblush": true, "lawngreen": true, "lemonchiffon": true, "lightblue": true, "lightcoral": true,
+	"lightcyan": true, "lightgoldenrodyellow": true, "lightgray": true, "lightgreen": true, "lightgrey": true,
+	"lightpink": true, "lightsalmon": true, "lightseagreen": true, "lightskyblue": true,
+	"lightslategray": true, "lightslategrey": true, "lightsteelblue": true, "lightyellow": true, "lime": true,
+	"limegreen": true, "linen": true, "magenta": true, "maroon": true, "mediumaquamarine": true,
+	"mediumblue": true, "mediumorchid": true, "mediumpurple": true, "mediumseagreen": true,
+	"mediumslateblue": true, "mediumspringgreen": true, "mediumturquoise": true, "mediumvioletred": true,
+	"midnightblue": true, "mintcream": true, "mistyrose": true, "moccasin": true, "navajowhite": true,
+	"navy": true, "oldlace": true, "olive": true, "olivedrab": true, "orange": true, "orangered": true,
+	"orchid": true, "palegoldenrod": true, "palegreen": true, "paleturquoise": true, "palevioletred": true,
+	"papayawhip": true, "peachpuff": true, "peru": true, "pink": true, "plum": true, "powderblue": true,
+	"purple": true, "rebeccapurple": true, "red": true, "rosybrown": true, "royalblue": true,
+	"saddlebrown": true, "salmon": true, "sandybrown": true, "seagreen": true, "seashell": true,
+	"sienna": true, "silver": true, "skyblue": true, "slateblue": true, "slategray": true, "slategrey": true,
+	"snow": true, "springgreen": true, "steelblue": true, "tan": true, "teal": true, "thistle": true,
+	"tomato": true, "turquoise": true, "violet": true, "wheat": true, "white": true, "whitesmoke": true,
+	"yellow": true, "yellowgreen": true, "transparent": true,
+}
+
+// ColorRule validates CSS colors: hex ("#0af", "#00aaffcc"), rgb()/rgba(), hsl()/hsla() in the
+// comma and the space-separated syntax ("rgb(0 170 255 / 50%)"), and named colors ("rebeccapurple").
+// Parameters restrict the accepted formats ("color:hex,rgb"); all are accepted without any.
+type ColorRule struct {
+	common.BaseRule
+	formats map[string]bool
+}
+
+// NewColorRule creates a new ColorRule
+func NewColorRule(parameters []string) (contract.Rule, error) {
+	formats := make(map[string]bool, len(parameters))
+	for _, param := range parameters {
+		format := strings.ToLower(strings.TrimSpace(param))
+		switch format {
+		case ColorFormatHex, ColorFormatRGB, ColorFormatHSL, ColorFormatNamed:
+			formats[format] = true
+		case "":
+		default:
+			return nil, fmt.Errorf(colorRuleUnknownFormat, param)
+		}
+	}
+	if len(formats) == 0 {
+		for _, format := range []string{ColorFormatHex, ColorFormatRGB, ColorFormatHSL, ColorFormatNamed} {
+			formats[format] = true
+		}
+	}
+
+	return &ColorRule{
+		BaseRule: common.NewBaseRule(colorRuleName, colorRuleDefaultMsg, parameters),
+		formats:  formats,
+	}, nil
+}
+
+// Validate checks the value against the accepted formats
+func (r *ColorRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	val, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(colorRuleInvalidTypeMsg)
+	}
+
+	color := strings.ToLower(strings.TrimSpace(val))
+	switch {
+	case r.formats[ColorFormatHex] && isHexColor(color):
+	case r.formats[ColorFormatRGB] && isColorFunction(color, "rgb", isRGBComponents):
+	case r.formats[ColorFormatHSL] && isColorFunction(color, "hsl", isHSLComponents):
+	case r.formats[ColorFormatNamed] && cssNamedColors[color]:
+	default:
+		return errors.New(colorRuleDefaultMsg)
+	}
+	return nil
+}
+
+func (r *ColorRule) Name() string {
+	return colorRuleName
+}
+
+// isHexColor checks "#" followed by 3, 4, 6 or 8 hex digits
+func isHexColor(color string) bool {
+	digits, ok := strings.CutPrefix(color, "#")
+	if !ok {
+		return false
+	}
+	switch len(digits) {
+	case 3, 4, 6, 8:
+	default:
+		return false
+	}
+	_, err := strconv.ParseUint(digits, 16, 32)
+	return err == nil
+}
+
+// isColorFunction checks name(...) or name + "a"(...) and their components, separated by commas
+// or by spaces with an optional "/ alpha"
+func isColorFunction(color, name string, components func(values []string) bool) bool {
+	args, ok := strings.CutPrefix(color, name+"a(")
+	if !ok {
+		if args, ok = strings.CutPrefix(color, name+"("); !ok {
+			return false
+		}
+	}
+	args, ok = strings.CutSuffix(args, ")")
+	if !ok {
+		return false
+	}
+
+	var values []string
+	if strings.Contains(args, ",") {
+		values = strings.Split(args, ",")
+	} else {
+		channels, alpha, hasAlpha := strings.Cut(args, "/")
+		values = strings.Fields(channels)
+		if hasAlpha {
+			if len(values) != 3 {
+				return false
+			}
+			values = append(values, alpha)
+		}
+	}
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	if len(values) == 4 && !isAlphaValue(values[3]) {
+		return false
+	}
+	return (len(values) == 3 || len(values) == 4) && components(values[:3])
+}
+
+// isRGBComponents checks three channels, all numbers from 0 to 255 or all percentages
+func isRGBComponents(values []string) bool {
+	percent := strings.HasSuffix(values[0], "%")
+	for _, value := range values {
+		if strings.HasSuffix(value, "%") != percent {
+			return false
+		}
+		if percent {
+			if !isPercentage(value) {
+				return false
+			}
+		} else if n, ok := parseColorNumber(value); !ok || n > 255 {
+			return false
+		}
+	}
+	return true
+}
+
+// isHSLComponents checks a hue, optionally in deg, rad, grad or turn, and two percentages
+func isHSLComponents(values []string) bool {
+	hue := values[0]
+	for _, unit := range []string{"deg", "grad", "rad", "turn"} {
+		if trimmed, ok := strings.CutSuffix(hue, unit); ok {
+			hue = trimmed
+			break
+		}
+	}
+	if _, ok := parseColorNumber(strings.TrimPrefix(hue, "-")); !ok {
+		return false
+	}
+	return isPercentage(values[1]) && isPercentage(values[2])
+}
+
+// isAlphaValue checks an alpha channel: a number from 0 to 1 or a percentage
+func isAlphaValue(value string) bool {
+	if strings.HasSuffix(value, "%") {
+		return isPercentage(value)
+	}
+	n, ok := parseColorNumber(value)
+	return ok && n <= 1
+}
+
+// isPercentage checks a number from 0 to 100 followed by "%"
+func isPercentage(value string) bool {
+	number, ok := strings.CutSuffix(value, "%")
+	if !ok {
+		return false
+	}
+	n, ok := parseColorNumber(number)
+	return ok && n <= 100
+}
+
+// parseColorNumber parses a non-negative decimal number such as "12", "0.5" or ".5"
+func parseColorNumber(value string) (float64, bool) {
+	if value == "" || strings.Trim(value, "0123456789.") != "" || strings.Count(value, ".") > 1 || value == "." {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	return n, err == nil
+}
@@ -0,0 +1,63 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestColorRule(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     []string
+		value      any
+		shouldPass bool
+	}{
+		{"short hex", nil, "#0af", true},
+		{"short hex with alpha", nil, "#0afc", true},
+		{"hex", nil, "#00AAFF", true},
+		{"hex with alpha", nil, "#00aaffcc", true},
+		{"hex without hash", nil, "00aaff", false},
+		{"hex of wrong length", nil, "#00aaf", false},
+		{"hex with non hex digit", nil, "#00aafg", false},
+		{"rgb", nil, "rgb(0, 170, 255)", true},
+		{"rgba", nil, "rgba(0, 170, 255, 0.5)", true},
+		{"rgb percentages", nil, "rgb(0%, 66.7%, 100%)", true},
+		{"rgb space syntax", nil, "rgb(0 170 255 / 50%)", true},
+		{"rgb channel out of range", nil, "rgb(0, 170, 256)", false},
+		{"rgb mixed units", nil, "rgb(0, 66%, 255)", false},
+		{"rgb alpha out of range", nil, "rgba(0, 170, 255, 1.5)", false},
+		{"rgb missing channel", nil, "rgb(0, 170)", false},
+		{"rgb negative", nil, "rgb(-1, 170, 255)", false},
+		{"hsl", nil, "hsl(200, 100%, 50%)", true},
+		{"hsla with unit", nil, "hsla(0.5turn, 100%, 50%, .8)", true},
+		{"hsl space syntax", nil, "hsl(200deg 100% 50% / 0.8)", true},
+		{"hsl lightness without percent", nil, "hsl(200, 100%, 50)", false},
+		{"hsl with exponent", nil, "hsl(1e2, 100%, 50%)", false},
+		{"named", nil, "RebeccaPurple", true},
+		{"transparent", nil, "transparent", true},
+		{"unknown name", nil, "blurple", false},
+		{"not a string", nil, 255, false},
+		{"hex only accepts hex", []string{"hex"}, "#fff", true},
+		{"hex only rejects rgb", []string{"hex"}, "rgb(0, 0, 0)", false},
+		{"hex and named accept names", []string{"hex", "named"}, "red", true},
+		{"rgb only rejects hsl", []string{"rgb"}, "hsl(0, 0%, 0%)", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := format.NewColorRule(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			err = rule.Validate(contract.NewValidationContext("color", tt.value, nil, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %v, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+
+	if _, err := format.NewColorRule([]string{"cmyk"}); err == nil {
+		t.Fatal("expected an error for an unknown format")
+	}
+}
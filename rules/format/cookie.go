@@ -0,0 +1,93 @@
+package format
+
+import (
+	"errors"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	cookieNameRuleName       = "cookie_name"
+	cookieNameRuleDefaultMsg = "the :attribute must be a valid cookie name"
+	cookieValueRuleName      = "cookie_value"
+	cookieValueRuleMsg       = "the :attribute must be a valid cookie value"
+	cookieRuleInvalidTypeMsg = "the :attribute must be a string"
+)
+
+// CookieNameRule validates a cookie name, an RFC 7230 token as required by RFC 6265
+type CookieNameRule struct {
+	common.BaseRule
+}
+
+// NewCookieNameRule creates a new CookieNameRule
+func NewCookieNameRule() (contract.Rule, error) {
+	return &CookieNameRule{
+		BaseRule: common.NewBaseRule(cookieNameRuleName, cookieNameRuleDefaultMsg, nil),
+	}, nil
+}
+
+// Validate checks the cookie name
+func (r *CookieNameRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	val, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(cookieRuleInvalidTypeMsg)
+	}
+	if !isHTTPToken(val) {
+		return errors.New(cookieNameRuleDefaultMsg)
+	}
+	return nil
+}
+
+func (r *CookieNameRule) Name() string {
+	return cookieNameRuleName
+}
+
+// CookieValueRule validates a cookie value with the cookie-octet characters of RFC 6265: visible
+// ASCII except '"', ',', ';' and '\', optionally wrapped in double quotes. Spaces and control
+// characters are rejected, so values cannot add attributes or cookies to a Set-Cookie header.
+type CookieValueRule struct {
+	common.BaseRule
+}
+
+// NewCookieValueRule creates a new CookieValueRule
+func NewCookieValueRule() (contract.Rule, error) {
+	return &CookieValueRule{
+		BaseRule: common.NewBaseRule(cookieValueRuleName, cookieValueRuleMsg, nil),
+	}, nil
+}
+
+// Validate checks the cookie value
+func (r *CookieValueRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	val, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(cookieRuleInvalidTypeMsg)
+	}
+	if !isCookieValue(val) {
+		return errors.New(cookieValueRuleMsg)
+	}
+	return nil
+}
+
+func (r *CookieValueRule) Name() string {
+	return cookieValueRuleName
+}
+
+// isCookieValue checks *cookie-octet or DQUOTE *cookie-octet DQUOTE
+func isCookieValue(s string) bool {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c <= ' ' || c >= 0x7f || c == '"' || c == ',' || c == ';' || c == '\\' {
+			return false
+		}
+	}
+	return true
+}
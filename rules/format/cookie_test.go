@@ -0,0 +1,46 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestCookieRules(t *testing.T) {
+	name, _ := format.NewCookieNameRule()
+	value, _ := format.NewCookieValueRule()
+
+	tests := []struct {
+		name       string
+		rule       contract.Rule
+		value      any
+		shouldPass bool
+	}{
+		{"cookie name", name, "session_id", true},
+		{"prefixed cookie name", name, "__Host-token", true},
+		{"name with equals sign", name, "a=b", false},
+		{"name with semicolon", name, "a;b", false},
+		{"name with space", name, "my cookie", false},
+		{"cookie value", value, "abc123-XYZ_.~!#$%&'()*+/:<=>?@[]^`{|}", true},
+		{"quoted cookie value", value, `"abc123"`, true},
+		{"base64 cookie value", value, "dGVzdA==", true},
+		{"value with semicolon", value, "abc; Path=/", false},
+		{"value with comma", value, "a,b", false},
+		{"value with space", value, "a b", false},
+		{"value with backslash", value, `a\b`, false},
+		{"value with inner quote", value, `a"b`, false},
+		{"unbalanced quote", value, `"abc`, false},
+		{"value with line break", value, "a\r\nSet-Cookie: admin=1", false},
+		{"value with non-ascii", value, "café", false},
+		{"not a string", value, 42, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate(contract.NewValidationContext("cookie", tt.value, nil, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %q, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package format
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+// bundledDisposableDomains are well-known throwaway email services, the initial content of
+// DisposableDomains
+var bundledDisposableDomains = []string{
+	"10minutemail.com", "10minutemail.net", "20minutemail.com", "33mail.com", "anonbox.net",
+	"burnermail.io", "byom.de", "discard.email", "dispostable.com", "dropmail.me",
+	"emailondeck.com", "emailfake.com", "fakeinbox.com", "fakemail.net", "getairmail.com",
+	"getnada.com", "guerrillamail.biz", "guerrillamail.com", "guerrillamail.de", "guerrillamail.info",
+	"guerrillamail.net", "guerrillamail.org", "guerrillamailblock.com", "harakirimail.com", "incognitomail.org",
+	"jetable.org", "mail-temp.com", "mailcatch.com", "maildrop.cc", "mailinator.com",
+	"mailinator.net", "mailinator2.com", "mailnesia.com", "mailpoof.com", "mailsac.com",
+	"mintemail.com", "mohmal.com", "moakt.com", "mytemp.email", "mytrashmail.com",
+	"nada.email", "throwam.com", "sharklasers.com", "spam4.me", "spambog.com",
+	"spambox.us", "spamgourmet.com", "spamex.com", "tempail.com", "tempinbox.com",
+	"tempmail.com", "tempmail.net", "tempmailo.com", "temp-mail.io", "temp-mail.org",
+	"tempr.email", "throwawaymail.com", "trash-mail.com", "trashmail.com", "trashmail.de",
+	"trashmail.me", "trashmail.net", "wegwerfmail.de", "wegwerfmail.net", "yopmail.com",
+	"yopmail.fr", "yopmail.net", "emailtemporanea.com", "mailforspam.com", "grr.la",
+}
+
+// DisposableDomains is the blocklist the email_not_disposable rule consults unless a provider is
+// set with registry.SetDisposableEmailProvider. It starts with a bundled list of well-known
+// services; Load or Replace refresh it at runtime, e.g. from a maintained upstream list.
+var DisposableDomains = NewDisposableBlocklist(bundledDisposableDomains...)
+
+// DisposableBlocklist is a contract.DisposableEmailProvider over a set of domains, safe for
+// concurrent use. Listing a domain also covers its subdomains.
+type DisposableBlocklist struct {
+	mu      sync.RWMutex
+	domains map[string]bool
+}
+
+// Ensure DisposableBlocklist implements contract.DisposableEmailProvider
+var _ contract.DisposableEmailProvider = (*DisposableBlocklist)(nil)
+
+// NewDisposableBlocklist creates a blocklist of domains
+func NewDisposableBlocklist(domains ...string) *DisposableBlocklist {
+	b := &DisposableBlocklist{}
+	b.Replace(domains)
+	return b
+}
+
+// Replace swaps the domains of the blocklist for domains
+func (b *DisposableBlocklist) Replace(domains []string) {
+	set := make(map[string]bool, len(domains))
+	for _, domain := range domains {
+		if domain = normalizeListedDomain(domain); domain != "" {
+			set[domain] = true
+		}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.domains = set
+}
+
+// Add adds domains to the blocklist
+func (b *DisposableBlocklist) Add(domains ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, domain := range domains {
+		if domain = normalizeListedDomain(domain); domain != "" {
+			b.domains[domain] = true
+		}
+	}
+}
+
+// Load replaces the domains of the blocklist with those read from r, one per line. Blank lines
+// and lines starting with "#" are ignored. The blocklist is unchanged if reading fails.
+func (b *DisposableBlocklist) Load(r io.Reader) error {
+	var domains []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			domains = append(domains, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	b.Replace(domains)
+	return nil
+}
+
+// Len returns the number of listed domains
+func (b *DisposableBlocklist) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.domains)
+}
+
+// IsDisposable reports whether domain or one of its parent domains is listed
+func (b *DisposableBlocklist) IsDisposable(_ context.Context, domain string) (bool, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for domain != "" {
+		if b.domains[domain] {
+			return true, nil
+		}
+		_, parent, found := strings.Cut(domain, ".")
+		if !found {
+			break
+		}
+		domain = parent
+	}
+	return false, nil
+}
+
+// normalizeListedDomain lowercases a listed domain and converts it to punycode
+func normalizeListedDomain(domain string) string {
+	domain = strings.ToLower(strings.Trim(strings.TrimSpace(domain), "."))
+	if ascii, ok := ToASCII(domain); ok {
+		return ascii
+	}
+	return domain
+}
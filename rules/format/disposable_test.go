@@ -0,0 +1,46 @@
+package format_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestDisposableBlocklist(t *testing.T) {
+	list := format.NewDisposableBlocklist("Throwaway.example", "wegwerf-bücher.de")
+	isDisposable := func(domain string) bool {
+		disposable, err := list.IsDisposable(context.Background(), domain)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return disposable
+	}
+
+	if !isDisposable("throwaway.example") || !isDisposable("inbox.throwaway.example") {
+		t.Fatal("expected the domain and its subdomains to be listed")
+	}
+	if !isDisposable("xn--wegwerf-bcher-4ob.de") {
+		t.Fatal("expected listed domains to be converted to punycode")
+	}
+	if isDisposable("example") || isDisposable("example.com") {
+		t.Fatal("unexpected listed domain")
+	}
+
+	list.Add("burner.example")
+	if !isDisposable("burner.example") || list.Len() != 3 {
+		t.Fatalf("expected the added domain, got %d domains", list.Len())
+	}
+
+	if err := list.Load(strings.NewReader("# refreshed\nfresh.example\n\n")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list.Len() != 1 || !isDisposable("fresh.example") || isDisposable("burner.example") {
+		t.Fatal("expected Load to replace the domains")
+	}
+
+	if format.DisposableDomains.Len() == 0 {
+		t.Fatal("expected the bundled blocklist")
+	}
+}
@@ -2,9 +2,12 @@ package format
 
 import (
 	"errors"
+	"fmt"
 	"net/mail"
 	"strings"
 
+	"github.com/next-trace/scg-validator/registry"
+
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
 )
@@ -18,8 +21,31 @@ const (
 		"but it is not a valid email format"
 	EmailRuleProvidedDataHasInvalidDomainMessage = "the :attribute must provide a valid email address, " +
 		"but the domain part is invalid"
+	EmailRuleRoleAddressMessage  = "the :attribute must be a personal email address, not a role address"
+	EmailRuleNoMailServerMessage = "the :attribute must provide a valid email address, " +
+		"but its domain does not accept email"
+	emailRuleUnresolvedMessage = "the :attribute domain could not be resolved"
+	emailRuleNoResolverMessage = "email rule dns mode requires an MX resolver, see registry.SetMXResolver"
+	emailRuleUnknownModeMsg    = "email rule does not support mode %q"
+
+	// Modes of the email rule, given as parameters ("email:filter,dns")
+	EmailModeRFC    = "rfc"
+	EmailModeFilter = "filter"
+	EmailModeDNS    = "dns"
+	EmailModeNoRole = "no_role"
+
+	emailMaxLength      = 254
+	emailMaxLocalLength = 64
 )
 
+// emailRoleAccounts are the local parts of role addresses rejected by the no_role mode
+var emailRoleAccounts = map[string]bool{
+	"abuse": true, "admin": true, "administrator": true, "billing": true, "contact": true, "help": true,
+	"hostmaster": true, "info": true, "mail": true, "marketing": true, "no-reply": true, "noc": true,
+	"noreply": true, "office": true, "postmaster": true, "root": true, "sales": true, "security": true,
+	"support": true, "sysadmin": true, "team": true, "webmaster": true,
+}
+
 // extractDomain extracts the domain part from an email address
 func extractDomain(email string) string {
 	at := strings.LastIndex(email, "@")
@@ -37,38 +63,200 @@ func isValidDomain(domain string) bool {
 		strings.Contains(domain, ".")
 }
 
+// EmailRule validates email addresses. Its parameters select modes, combined as given:
+//   - rfc (the default): the address parses as an RFC 5322 address
+//   - filter: a pragmatic check in the spirit of PHP's FILTER_VALIDATE_EMAIL, rejecting the IP
+//     literal domains RFC 5322 allows, non-ASCII local parts and non-hostname domains
+//   - dns: the domain has a mail exchanger, looked up with the resolver set by registry.SetMXResolver
+//   - no_role: role addresses such as info@ and admin@ (ignoring "+tag" suffixes) fail
+//
+// Internationalized domains ("jörg@bücher.de") are accepted when they convert to punycode; with the
+// punycode parameter ("email:punycode") the address is normalized to its ASCII domain
+// ("jörg@xn--bcher-kva.de").
 type EmailRule struct {
 	common.BaseRule
+	rfc      bool
+	filter   bool
+	noRole   bool
+	punycode bool
+}
+
+// dnsEmailRule is the email rule in dns mode, an async rule querying contract.ResourceDNS
+type dnsEmailRule struct {
+	*EmailRule
 }
 
 func NewEmailRule(params []string, opts ...common.RuleOption) (contract.Rule, error) {
-	return &EmailRule{
+	r := &EmailRule{
 		BaseRule: common.NewBaseRule(EmailRuleName, EmailRuleDefaultMessage, params, opts...),
-	}, nil
+	}
+	dns := false
+	for _, param := range params {
+		switch strings.ToLower(strings.TrimSpace(param)) {
+		case EmailModeRFC:
+			r.rfc = true
+		case EmailModeFilter:
+			r.filter = true
+		case EmailModeDNS:
+			dns = true
+		case EmailModeNoRole:
+			r.noRole = true
+		case PunycodeParam:
+			r.punycode = true
+		case "":
+		default:
+			return nil, fmt.Errorf(emailRuleUnknownModeMsg, param)
+		}
+	}
+	r.rfc = r.rfc || !r.filter
+
+	if dns {
+		return &dnsEmailRule{EmailRule: r}, nil
+	}
+	return r, nil
 }
 
 func (r *EmailRule) Validate(ctx contract.RuleContext) error {
 	if r.ShouldSkipValidation(ctx.Value()) {
 		return nil
 	}
-	val, ok := ctx.Value().(string)
+	val, ok := common.StringValue(ctx)
 	if !ok || strings.TrimSpace(val) == "" {
 		return errors.New(EmailRuleDataNotProvideOrIsEmptyMSgDefaultMessage)
 	}
 
-	addr, err := mail.ParseAddress(val)
-	if err != nil || addr.Address != val {
-		return errors.New(EmailRuleProvidedDataCanNotBeAnEmailDefaultMessage)
+	if r.rfc {
+		addr, err := mail.ParseAddress(val)
+		if err != nil || addr.Address != val {
+			return errors.New(EmailRuleProvidedDataCanNotBeAnEmailDefaultMessage)
+		}
 	}
 
 	domain := extractDomain(val)
 	if !isValidDomain(domain) {
 		return errors.New(EmailRuleProvidedDataHasInvalidDomainMessage)
 	}
+	ascii, ok := ToASCII(domain)
+	if !ok {
+		return errors.New(EmailRuleProvidedDataHasInvalidDomainMessage)
+	}
+
+	local := val[:len(val)-len(domain)-1]
+	if r.filter {
+		if len(local)+1+len(ascii) > emailMaxLength || !isFilterLocalPart(local) {
+			return errors.New(EmailRuleProvidedDataCanNotBeAnEmailDefaultMessage)
+		}
+		if !isHostname(ascii) {
+			return errors.New(EmailRuleProvidedDataHasInvalidDomainMessage)
+		}
+	}
+	if r.noRole && isRoleAccount(local) {
+		return errors.New(EmailRuleRoleAddressMessage)
+	}
 
 	return nil
 }
 
+// Normalize converts the domain of an accepted address to punycode when the punycode parameter is set
+func (r *EmailRule) Normalize(ctx contract.RuleContext) any {
+	val, ok := ctx.Value().(string)
+	if !ok || !r.punycode {
+		return ctx.Value()
+	}
+	domain := extractDomain(val)
+	ascii, ok := ToASCII(domain)
+	if domain == "" || !ok {
+		return val
+	}
+	return val[:len(val)-len(domain)] + ascii
+}
+
 func (r *EmailRule) Name() string {
 	return EmailRuleName
 }
+
+// Validate checks the address, then looks up the mail exchangers of its domain
+func (r *dnsEmailRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	if err := r.EmailRule.Validate(ctx); err != nil {
+		return err
+	}
+
+	resolver, ok := registry.FindMXResolver()
+	if !ok {
+		return errors.New(emailRuleNoResolverMessage)
+	}
+	val, _ := common.StringValue(ctx)
+	ascii, _ := ToASCII(extractDomain(val))
+	hosts, err := resolver.LookupMX(contract.ContextOf(ctx), ascii)
+	if err != nil {
+		return &contract.ExternalError{Resource: contract.ResourceDNS, Err: errors.New(emailRuleUnresolvedMessage)}
+	}
+	// A single "." host is a null MX (RFC 7505): the domain does not accept email
+	if len(hosts) == 0 || (len(hosts) == 1 && strings.Trim(hosts[0], ".") == "") {
+		return errors.New(EmailRuleNoMailServerMessage)
+	}
+	return nil
+}
+
+// Async lets the engine overlap MX lookups with other I/O-bound rules
+func (r *dnsEmailRule) Async() bool {
+	return true
+}
+
+// Resource flags the rule as resolving domains through DNS, so it can be rate limited
+func (r *dnsEmailRule) Resource() string {
+	return contract.ResourceDNS
+}
+
+// isFilterLocalPart checks a local part of dot-separated atoms of ASCII letters, digits and
+// the RFC 5322 atext symbols
+func isFilterLocalPart(local string) bool {
+	if local == "" || len(local) > emailMaxLocalLength {
+		return false
+	}
+	for _, atom := range strings.Split(local, ".") {
+		if atom == "" {
+			return false
+		}
+		for i := 0; i < len(atom); i++ {
+			c := atom[i]
+			if !isASCIIAlphaNum(c) && !strings.ContainsRune("!#$%&'*+/=?^_`{|}~-", rune(c)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isHostname checks an ASCII domain of at least two labels of letters, digits and inner
+// hyphens, with a top-level label that is not numeric
+func isHostname(domain string) bool {
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 || strings.Trim(labels[len(labels)-1], "0123456789") == "" {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > idnMaxLabelLength || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			if !isASCIIAlphaNum(label[i]) && label[i] != '-' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isASCIIAlphaNum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// isRoleAccount reports whether a local part names a role rather than a person
+func isRoleAccount(local string) bool {
+	name, _, _ := strings.Cut(strings.ToLower(local), "+")
+	return emailRoleAccounts[name]
+}
@@ -0,0 +1,63 @@
+package format
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	emailNotDisposableRuleName       = "email_not_disposable"
+	emailNotDisposableRuleDefaultMsg = "the :attribute must not be a disposable email address"
+	emailNotDisposableRuleInvalidMsg = "the :attribute must be an email address"
+)
+
+// EmailNotDisposableRule rejects addresses of throwaway email services, for signup abuse
+// prevention. Domains are checked with the provider set by registry.SetDisposableEmailProvider,
+// or else against the DisposableDomains blocklist. Combine it with email to check the format.
+type EmailNotDisposableRule struct {
+	common.BaseRule
+}
+
+// NewEmailNotDisposableRule creates a new EmailNotDisposableRule
+func NewEmailNotDisposableRule() (contract.Rule, error) {
+	return &EmailNotDisposableRule{
+		BaseRule: common.NewBaseRule(emailNotDisposableRuleName, emailNotDisposableRuleDefaultMsg, nil),
+	}, nil
+}
+
+// Validate looks up the domain of the address
+func (r *EmailNotDisposableRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	val, ok := common.StringValue(ctx)
+	domain := extractDomain(val)
+	if !ok || domain == "" {
+		return errors.New(emailNotDisposableRuleInvalidMsg)
+	}
+
+	domain = strings.ToLower(domain)
+	if ascii, ok := ToASCII(domain); ok {
+		domain = ascii
+	}
+	var provider contract.DisposableEmailProvider = DisposableDomains
+	if registered, ok := registry.FindDisposableEmailProvider(); ok {
+		provider = registered
+	}
+	disposable, err := provider.IsDisposable(contract.ContextOf(ctx), domain)
+	if err != nil {
+		return err
+	}
+	if disposable {
+		return errors.New(emailNotDisposableRuleDefaultMsg)
+	}
+	return nil
+}
+
+func (r *EmailNotDisposableRule) Name() string {
+	return emailNotDisposableRuleName
+}
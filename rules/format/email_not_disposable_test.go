@@ -0,0 +1,58 @@
+package format_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+type fakeDisposableProvider struct {
+	err error
+}
+
+func (f fakeDisposableProvider) IsDisposable(_ context.Context, domain string) (bool, error) {
+	return domain == "burner.example", f.err
+}
+
+func TestEmailNotDisposableRule(t *testing.T) {
+	rule, err := format.NewEmailNotDisposableRule()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	validate := func(value any) error {
+		return rule.Validate(contract.NewValidationContext("email", value, nil, nil))
+	}
+
+	tests := []struct {
+		value      any
+		shouldPass bool
+	}{
+		{"john@example.com", true},
+		{"john@mailinator.com", false},
+		{"john@EU.Mailinator.com", false},
+		{"not-an-email", false},
+		{123, false},
+	}
+	for _, tt := range tests {
+		if err := validate(tt.value); (err == nil) != tt.shouldPass {
+			t.Errorf("%v: expected pass %v, got %v", tt.value, tt.shouldPass, err)
+		}
+	}
+
+	defer registry.SetDisposableEmailProvider(nil)
+	registry.SetDisposableEmailProvider(fakeDisposableProvider{})
+	if err := validate("john@mailinator.com"); err != nil {
+		t.Fatalf("expected the registered provider to replace the blocklist, got %v", err)
+	}
+	if err := validate("john@burner.example"); err == nil {
+		t.Fatal("expected the registered provider to reject its domain")
+	}
+	registry.SetDisposableEmailProvider(fakeDisposableProvider{err: errors.New("provider down")})
+	if err := validate("john@example.com"); err == nil || err.Error() != "provider down" {
+		t.Fatalf("expected the provider error, got %v", err)
+	}
+}
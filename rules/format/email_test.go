@@ -1,9 +1,12 @@
 package format_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry"
 	"github.com/next-trace/scg-validator/rules/format"
 	"github.com/next-trace/scg-validator/utils"
 )
@@ -28,6 +31,9 @@ func TestEmailRule(t *testing.T) {
 		{"invalid leading dot", "test@.example.com", false},
 		{"invalid trailing dot", "test@example.com.", false},
 		{"invalid multiple @", "test@@example.com", false},
+		{"valid internationalized domain", "jörg@bücher.de", true},
+		{"valid punycode domain", "jorg@xn--bcher-kva.de", true},
+		{"invalid internationalized label", "test@bü!cher.de", false},
 		{"empty string", "", false},
 		{"non-string type", 123, false},
 		{"nil value", nil, false},
@@ -66,3 +72,85 @@ func TestContainsDot(t *testing.T) {
 		}
 	}
 }
+
+func TestEmailRule_Modes(t *testing.T) {
+	tests := []struct {
+		params     []string
+		value      string
+		shouldPass bool
+	}{
+		{[]string{"rfc"}, "john@[192.168.0.1]", true},
+		{[]string{"filter"}, "john@[192.168.0.1]", false},
+		{[]string{"filter"}, "john.doe+tag@example.com", true},
+		{[]string{"filter"}, "john..doe@example.com", false},
+		{[]string{"filter"}, "jörg@example.com", false},
+		{[]string{"filter"}, "john@bücher.de", true},
+		{[]string{"filter"}, "john@ex_ample.com", false},
+		{[]string{"filter"}, "john@example.123", false},
+		{[]string{"rfc", "filter"}, "john@example.com", true},
+		{[]string{"no_role"}, "john@example.com", true},
+		{[]string{"no_role"}, "Info@example.com", false},
+		{[]string{"no_role"}, "admin+alerts@example.com", false},
+	}
+	for _, tt := range tests {
+		rule, err := format.NewEmailRule(tt.params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		err = rule.Validate(contract.NewValidationContext("email", tt.value, tt.params, nil))
+		if (err == nil) != tt.shouldPass {
+			t.Errorf("email:%v on %q: expected pass %v, got %v", tt.params, tt.value, tt.shouldPass, err)
+		}
+	}
+
+	if _, err := format.NewEmailRule([]string{"strict"}); err == nil {
+		t.Fatal("expected an error for an unknown mode")
+	}
+}
+
+func TestEmailRule_DNSMode(t *testing.T) {
+	resolver, _ := registry.FindMXResolver()
+	defer registry.SetMXResolver(resolver)
+	registry.SetMXResolver(contract.MXResolverFunc(func(_ context.Context, domain string) ([]string, error) {
+		switch domain {
+		case "example.com", "xn--bcher-kva.de":
+			return []string{"mx." + domain}, nil
+		case "null.example":
+			return []string{"."}, nil
+		case "down.example":
+			return nil, errors.New("timeout")
+		default:
+			return nil, nil
+		}
+	}))
+
+	rule, err := format.NewEmailRule([]string{"dns"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if external, ok := rule.(contract.ExternalRule); !ok || external.Resource() != contract.ResourceDNS {
+		t.Fatal("expected the dns mode to be an external dns rule")
+	}
+	validate := func(value string) error {
+		return rule.Validate(contract.NewValidationContext("email", value, []string{"dns"}, nil))
+	}
+	if err := validate("john@example.com"); err != nil {
+		t.Fatalf("expected pass, got %v", err)
+	}
+	if err := validate("jörg@bücher.de"); err != nil {
+		t.Fatalf("expected the punycode domain to be looked up, got %v", err)
+	}
+	for _, value := range []string{"john@nomx.example", "john@null.example", "not-an-email"} {
+		if err := validate(value); err == nil {
+			t.Fatalf("expected %q to fail", value)
+		}
+	}
+	if err := validate("john@down.example"); !contract.IsExternalError(err) {
+		t.Fatalf("expected an external error for a failed lookup, got %v", err)
+	}
+
+	registry.SetMXResolver(nil)
+	if err := validate("john@example.com"); err == nil {
+		t.Fatal("expected an error without a resolver")
+	}
+}
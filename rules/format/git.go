@@ -0,0 +1,137 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	gitSHARuleName       = "git_sha"
+	gitSHARuleDefaultMsg = "the :attribute must be a git commit SHA"
+	gitSHARuleFullMsg    = "the :attribute must be a full 40-character git commit SHA"
+	gitSHARuleUnknown    = "git_sha rule does not support parameter %q"
+	gitRefRuleName       = "git_ref"
+	gitRefRuleDefaultMsg = "the :attribute must be a valid git branch or tag name"
+	gitRuleInvalidType   = "the :attribute must be a string"
+
+	// GitSHAFullParam makes the git_sha rule require the full 40 hex digits ("git_sha:full")
+	GitSHAFullParam = "full"
+
+	gitSHAMinLength = 7
+	gitSHAMaxLength = 40
+)
+
+// GitSHARule validates a git commit SHA-1, abbreviated to 7 digits or more or, with the full
+// parameter, exactly 40 digits long
+type GitSHARule struct {
+	common.BaseRule
+	full bool
+}
+
+// NewGitSHARule creates a new GitSHARule
+func NewGitSHARule(params []string) (contract.Rule, error) {
+	full := false
+	for _, param := range params {
+		switch strings.ToLower(strings.TrimSpace(param)) {
+		case GitSHAFullParam:
+			full = true
+		case "":
+		default:
+			return nil, fmt.Errorf(gitSHARuleUnknown, param)
+		}
+	}
+
+	msg := gitSHARuleDefaultMsg
+	if full {
+		msg = gitSHARuleFullMsg
+	}
+	return &GitSHARule{
+		BaseRule: common.NewBaseRule(gitSHARuleName, msg, params),
+		full:     full,
+	}, nil
+}
+
+// Validate checks the length and the hex digits of the SHA
+func (r *GitSHARule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	sha, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(gitRuleInvalidType)
+	}
+
+	minLength := gitSHAMinLength
+	if r.full {
+		minLength = gitSHAMaxLength
+	}
+	if len(sha) < minLength || len(sha) > gitSHAMaxLength || !isHexString(sha) {
+		return errors.New(r.GetMessage())
+	}
+	return nil
+}
+
+func (r *GitSHARule) Name() string {
+	return gitSHARuleName
+}
+
+// GitRefRule validates a branch or tag name with the rules of git check-ref-format, allowing
+// one-level names such as "main". Names starting with "-", which git refuses as branch names, are
+// rejected too.
+type GitRefRule struct {
+	common.BaseRule
+}
+
+// NewGitRefRule creates a new GitRefRule
+func NewGitRefRule() (contract.Rule, error) {
+	return &GitRefRule{
+		BaseRule: common.NewBaseRule(gitRefRuleName, gitRefRuleDefaultMsg, nil),
+	}, nil
+}
+
+// Validate checks the reference name
+func (r *GitRefRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	ref, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(gitRuleInvalidType)
+	}
+	if !isValidGitRef(ref) {
+		return errors.New(gitRefRuleDefaultMsg)
+	}
+	return nil
+}
+
+func (r *GitRefRule) Name() string {
+	return gitRefRuleName
+}
+
+// isValidGitRef applies the rules of git check-ref-format
+func isValidGitRef(ref string) bool {
+	if ref == "" || ref == "@" || strings.HasPrefix(ref, "-") || strings.HasSuffix(ref, ".") {
+		return false
+	}
+	if strings.Contains(ref, "..") || strings.Contains(ref, "@{") {
+		return false
+	}
+	for i := 0; i < len(ref); i++ {
+		c := ref[i]
+		if c < 0x20 || c == 0x7f || strings.IndexByte(" ~^:?*[\\", c) >= 0 {
+			return false
+		}
+	}
+	// Components may not be empty (leading, trailing or doubled slashes), start with a dot or end
+	// with ".lock"
+	for _, component := range strings.Split(ref, "/") {
+		if component == "" || strings.HasPrefix(component, ".") || strings.HasSuffix(component, ".lock") {
+			return false
+		}
+	}
+	return true
+}
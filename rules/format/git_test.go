@@ -0,0 +1,64 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestGitRules(t *testing.T) {
+	sha, _ := format.NewGitSHARule(nil)
+	fullSHA, _ := format.NewGitSHARule([]string{"full"})
+	ref, _ := format.NewGitRefRule()
+
+	tests := []struct {
+		name       string
+		rule       contract.Rule
+		value      any
+		shouldPass bool
+	}{
+		{"abbreviated sha", sha, "a1b2c3d", true},
+		{"full sha", sha, "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3", true},
+		{"uppercase sha", sha, "A1B2C3D", true},
+		{"sha too short", sha, "a1b2c3", false},
+		{"sha too long", sha, "a94a8fe5ccb19ba61c4c0873d391e987982fbbd30", false},
+		{"sha with non hex digit", sha, "a1b2c3z", false},
+		{"full sha required", fullSHA, "a1b2c3d", false},
+		{"full sha given", fullSHA, "a94a8fe5ccb19ba61c4c0873d391e987982fbbd3", true},
+		{"branch", ref, "main", true},
+		{"nested branch", ref, "feature/login-form", true},
+		{"tag", ref, "v1.2.3", true},
+		{"full ref", ref, "refs/heads/release/2024.10", true},
+		{"double dot", ref, "feature..x", false},
+		{"component starting with dot", ref, "feature/.hidden", false},
+		{"lock suffix", ref, "main.lock", false},
+		{"trailing slash", ref, "feature/", false},
+		{"leading slash", ref, "/main", false},
+		{"double slash", ref, "feature//x", false},
+		{"trailing dot", ref, "v1.", false},
+		{"space", ref, "my branch", false},
+		{"tilde", ref, "main~1", false},
+		{"caret", ref, "main^", false},
+		{"colon", ref, "a:b", false},
+		{"glob", ref, "feature/*", false},
+		{"backslash", ref, `feature\x`, false},
+		{"reflog syntax", ref, "main@{1}", false},
+		{"at sign", ref, "@", false},
+		{"leading dash", ref, "-f", false},
+		{"control character", ref, "main\x01", false},
+		{"not a string", ref, 42, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate(contract.NewValidationContext("ref", tt.value, nil, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %v, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+
+	if _, err := format.NewGitSHARule([]string{"short"}); err == nil {
+		t.Fatal("expected an error for an unknown parameter")
+	}
+}
@@ -0,0 +1,93 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	upcARuleName   = "upc_a"
+	gtin14RuleName = "gtin14"
+
+	upcALength   = 12
+	gtin14Length = 14
+
+	gtinRuleDefaultMsg     = "the :attribute must be a valid %s code"
+	gtinRuleInvalidTypeMsg = "the :attribute must be a string of digits"
+	gtinRuleLengthMsg      = "the :attribute must have %d digits"
+	gtinRuleCheckDigitMsg  = "the :attribute has an invalid check digit"
+)
+
+// GTINRule validates a GS1 product code of a fixed number of digits, including its check digit:
+// upc_a for 12-digit UPC-A codes, gtin14 for 14-digit GTIN-14 (case and pallet) codes. Values are
+// strings so leading zeros survive.
+type GTINRule struct {
+	common.BaseRule
+	name   string
+	length int
+}
+
+// NewUPCARule creates a GTINRule for 12-digit UPC-A codes
+func NewUPCARule() (contract.Rule, error) {
+	return newGTINRule(upcARuleName, "UPC-A", upcALength), nil
+}
+
+// NewGTIN14Rule creates a GTINRule for 14-digit GTIN-14 codes
+func NewGTIN14Rule() (contract.Rule, error) {
+	return newGTINRule(gtin14RuleName, "GTIN-14", gtin14Length), nil
+}
+
+func newGTINRule(name, label string, length int) *GTINRule {
+	return &GTINRule{
+		BaseRule: common.NewBaseRule(name, fmt.Sprintf(gtinRuleDefaultMsg, label), nil),
+		name:     name,
+		length:   length,
+	}
+}
+
+// Validate checks the length, the digits and the check digit of the code
+func (r *GTINRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+
+	code, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(gtinRuleInvalidTypeMsg)
+	}
+	if len(code) != r.length {
+		return fmt.Errorf(gtinRuleLengthMsg, r.length)
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < '0' || code[i] > '9' {
+			return errors.New(gtinRuleInvalidTypeMsg)
+		}
+	}
+	if !validGS1CheckDigit(code) {
+		return errors.New(gtinRuleCheckDigitMsg)
+	}
+
+	return nil
+}
+
+func (r *GTINRule) Name() string {
+	return r.name
+}
+
+// validGS1CheckDigit verifies the last digit of a GS1 code (UPC, EAN, GTIN): the other digits
+// are weighted 3 and 1 alternately from the right, and the check digit rounds their sum up to
+// a multiple of ten
+func validGS1CheckDigit(digits string) bool {
+	sum := 0
+	for i := len(digits) - 2; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if (len(digits)-2-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return int(digits[len(digits)-1]-'0') == (10-sum%10)%10
+}
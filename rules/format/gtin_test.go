@@ -0,0 +1,43 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestGTINRules(t *testing.T) {
+	upcA, _ := format.NewUPCARule()
+	gtin14, _ := format.NewGTIN14Rule()
+
+	tests := []struct {
+		name       string
+		rule       contract.Rule
+		value      any
+		shouldPass bool
+	}{
+		{"valid upc_a", upcA, "036000291452", true},
+		{"valid upc_a with leading zeros", upcA, "012345678905", true},
+		{"upc_a wrong check digit", upcA, "036000291453", false},
+		{"upc_a too short", upcA, "03600029145", false},
+		{"upc_a with letters", upcA, "03600029145A", false},
+		{"upc_a as number", upcA, 36000291452, false},
+		{"valid gtin14", gtin14, "10614141000415", true},
+		{"valid gtin14 from upc", gtin14, "00036000291452", true},
+		{"gtin14 wrong check digit", gtin14, "10614141000416", false},
+		{"gtin14 with upc length", gtin14, "036000291452", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate(contract.NewValidationContext("code", tt.value, nil, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %v, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+
+	if upcA.Name() != "upc_a" || gtin14.Name() != "gtin14" {
+		t.Fatalf("unexpected names %q, %q", upcA.Name(), gtin14.Name())
+	}
+}
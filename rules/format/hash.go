@@ -0,0 +1,114 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	hashRuleName           = "hash"
+	hashRuleDefaultMsg     = "the :attribute must be a valid %s hash"
+	hashRuleInvalidTypeMsg = "the :attribute must be a string"
+	hashRuleMissingParams  = "hash rule requires an algorithm"
+	hashRuleUnknownAlg     = "hash rule does not support algorithm %q, use one of: %s"
+)
+
+// hashLengths are the hex digest lengths of the supported algorithms
+var hashLengths = map[string]int{
+	"md5":    32,
+	"sha1":   40,
+	"sha224": 56,
+	"sha256": 64,
+	"sha384": 96,
+	"sha512": 128,
+}
+
+// HashRule validates a hex digest of a hash algorithm, for artifact-integrity fields: md5, sha1,
+// sha256 and sha512 check their own algorithm, hash:alg any of md5, sha1, sha224, sha256, sha384
+// and sha512. Upper and lower case digits are accepted; the digest must have the exact length.
+type HashRule struct {
+	common.BaseRule
+	name   string
+	length int
+}
+
+// NewMD5Rule creates a HashRule for MD5 digests
+func NewMD5Rule() (contract.Rule, error) {
+	return newHashRule("md5", "md5", nil), nil
+}
+
+// NewSHA1Rule creates a HashRule for SHA-1 digests
+func NewSHA1Rule() (contract.Rule, error) {
+	return newHashRule("sha1", "sha1", nil), nil
+}
+
+// NewSHA256Rule creates a HashRule for SHA-256 digests
+func NewSHA256Rule() (contract.Rule, error) {
+	return newHashRule("sha256", "sha256", nil), nil
+}
+
+// NewSHA512Rule creates a HashRule for SHA-512 digests
+func NewSHA512Rule() (contract.Rule, error) {
+	return newHashRule("sha512", "sha512", nil), nil
+}
+
+// NewHashRule creates a HashRule for the algorithm given as parameter ("hash:sha384")
+func NewHashRule(params []string) (contract.Rule, error) {
+	if len(params) == 0 {
+		return nil, errors.New(hashRuleMissingParams)
+	}
+	alg := strings.ToLower(strings.TrimSpace(params[0]))
+	if _, ok := hashLengths[alg]; !ok {
+		algs := make([]string, 0, len(hashLengths))
+		for name := range hashLengths {
+			algs = append(algs, name)
+		}
+		sort.Strings(algs)
+		return nil, fmt.Errorf(hashRuleUnknownAlg, params[0], strings.Join(algs, ", "))
+	}
+	return newHashRule(hashRuleName, alg, params), nil
+}
+
+func newHashRule(name, alg string, params []string) *HashRule {
+	return &HashRule{
+		BaseRule: common.NewBaseRule(name, fmt.Sprintf(hashRuleDefaultMsg, alg), params),
+		name:     name,
+		length:   hashLengths[alg],
+	}
+}
+
+// Validate checks the length and the hex digits of the digest
+func (r *HashRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+
+	digest, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(hashRuleInvalidTypeMsg)
+	}
+	if len(digest) != r.length || !isHexString(digest) {
+		return errors.New(r.GetMessage())
+	}
+	return nil
+}
+
+func (r *HashRule) Name() string {
+	return r.name
+}
+
+// isHexString reports whether s holds only hex digits
+func isHexString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') && (c < 'A' || c > 'F') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,59 @@
+package format_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestHashRules(t *testing.T) {
+	md5, _ := format.NewMD5Rule()
+	sha1, _ := format.NewSHA1Rule()
+	sha256, _ := format.NewSHA256Rule()
+	sha512, _ := format.NewSHA512Rule()
+	sha384, err := format.NewHashRule([]string{"SHA384"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		rule       contract.Rule
+		value      any
+		shouldPass bool
+	}{
+		{"md5", md5, "d41d8cd98f00b204e9800998ecf8427e", true},
+		{"md5 uppercase", md5, "D41D8CD98F00B204E9800998ECF8427E", true},
+		{"md5 too short", md5, "d41d8cd98f00b204e9800998ecf8427", false},
+		{"md5 with non hex digit", md5, "d41d8cd98f00b204e9800998ecf8427g", false},
+		{"sha1", sha1, "da39a3ee5e6b4b0d3255bfef95601890afd80709", true},
+		{"sha1 given md5", sha1, "d41d8cd98f00b204e9800998ecf8427e", false},
+		{"sha256", sha256, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", true},
+		{"sha256 with prefix", sha256, "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", false},
+		{"sha512", sha512, strings.Repeat("cf83e135", 16), true},
+		{"sha512 given sha256", sha512, strings.Repeat("ab", 32), false},
+		{"hash:sha384", sha384, strings.Repeat("38", 48), true},
+		{"hash:sha384 too long", sha384, strings.Repeat("38", 49), false},
+		{"not a string", md5, 42, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate(contract.NewValidationContext("checksum", tt.value, nil, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %v, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+
+	if md5.Name() != "md5" || sha384.Name() != "hash" {
+		t.Fatalf("unexpected names %q, %q", md5.Name(), sha384.Name())
+	}
+	if _, err := format.NewHashRule([]string{"crc32"}); err == nil {
+		t.Fatal("expected an error for an unknown algorithm")
+	}
+	if _, err := format.NewHashRule(nil); err == nil {
+		t.Fatal("expected an error without an algorithm")
+	}
+}
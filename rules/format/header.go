@@ -0,0 +1,110 @@
+package format
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	headerNameRuleName       = "header_name"
+	headerNameRuleDefaultMsg = "the :attribute must be a valid HTTP header name"
+	headerValueRuleName      = "header_value"
+	headerValueRuleMsg       = "the :attribute must be a valid HTTP header value"
+	headerRuleInvalidTypeMsg = "the :attribute must be a string"
+)
+
+// HeaderNameRule validates an HTTP header name, an RFC 7230 token, so configured names cannot
+// carry separators or line breaks into proxied or webhook requests
+type HeaderNameRule struct {
+	common.BaseRule
+}
+
+// NewHeaderNameRule creates a new HeaderNameRule
+func NewHeaderNameRule() (contract.Rule, error) {
+	return &HeaderNameRule{
+		BaseRule: common.NewBaseRule(headerNameRuleName, headerNameRuleDefaultMsg, nil),
+	}, nil
+}
+
+// Validate checks the header name
+func (r *HeaderNameRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	val, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(headerRuleInvalidTypeMsg)
+	}
+	if !isHTTPToken(val) {
+		return errors.New(headerNameRuleDefaultMsg)
+	}
+	return nil
+}
+
+func (r *HeaderNameRule) Name() string {
+	return headerNameRuleName
+}
+
+// HeaderValueRule validates an HTTP header value: visible ASCII characters with inner spaces and
+// tabs. CR, LF and other control characters, which allow header injection, are rejected, as are
+// non-ASCII bytes and leading or trailing whitespace. Empty values are left to required.
+type HeaderValueRule struct {
+	common.BaseRule
+}
+
+// NewHeaderValueRule creates a new HeaderValueRule
+func NewHeaderValueRule() (contract.Rule, error) {
+	return &HeaderValueRule{
+		BaseRule: common.NewBaseRule(headerValueRuleName, headerValueRuleMsg, nil),
+	}, nil
+}
+
+// Validate checks the header value
+func (r *HeaderValueRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	val, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(headerRuleInvalidTypeMsg)
+	}
+	if !isHTTPFieldValue(val) {
+		return errors.New(headerValueRuleMsg)
+	}
+	return nil
+}
+
+func (r *HeaderValueRule) Name() string {
+	return headerValueRuleName
+}
+
+// isHTTPToken checks an RFC 7230 token: one or more visible ASCII characters other than the
+// delimiters (),/:;<=>?@[\]{}"
+func isHTTPToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c <= ' ' || c >= 0x7f || strings.IndexByte(`"(),/:;<=>?@[\]{}`, c) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isHTTPFieldValue checks visible ASCII characters, spaces and tabs, without leading or trailing
+// whitespace
+func isHTTPFieldValue(s string) bool {
+	if strings.TrimLeft(s, " \t") != s || strings.TrimRight(s, " \t") != s {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; (c < ' ' && c != '\t') || c >= 0x7f {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestHeaderRules(t *testing.T) {
+	name, _ := format.NewHeaderNameRule()
+	value, _ := format.NewHeaderValueRule()
+
+	tests := []struct {
+		name       string
+		rule       contract.Rule
+		value      any
+		shouldPass bool
+	}{
+		{"header name", name, "X-Request-ID", true},
+		{"token characters", name, "x-custom!#$%&'*+.^_`|~", true},
+		{"name with colon", name, "X-Evil:", false},
+		{"name with space", name, "X Request", false},
+		{"name with line break", name, "X-A\r\nX-B", false},
+		{"name with non-ascii", name, "X-Größe", false},
+		{"header value", value, "Bearer abc.def-123", true},
+		{"value with inner spaces and tabs", value, "text/html;\tq=0.9, */*", true},
+		{"value with crlf", value, "ok\r\nSet-Cookie: admin=1", false},
+		{"value with lf", value, "ok\nX: y", false},
+		{"value with null byte", value, "ok\x00", false},
+		{"value with non-ascii", value, "café", false},
+		{"value with leading space", value, " ok", false},
+		{"value with trailing tab", value, "ok\t", false},
+		{"not a string", value, 42, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate(contract.NewValidationContext("header", tt.value, nil, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %q, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package format
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// PunycodeParam makes the email and url rules normalize internationalized domains to punycode
+	PunycodeParam = "punycode"
+
+	idnACEPrefix      = "xn--"
+	idnMaxLabelLength = 63
+	idnMaxLength      = 253
+
+	// RFC 3492 bootstring parameters of punycode
+	punycodeBase        = 36
+	punycodeTMin        = 1
+	punycodeTMax        = 26
+	punycodeSkew        = 38
+	punycodeDamp        = 700
+	punycodeInitialBias = 72
+	punycodeInitialN    = 128
+	punycodeMaxDelta    = 1 << 30
+)
+
+// hasParam reports whether params holds name, ignoring case and surrounding spaces
+func hasParam(params []string, name string) bool {
+	for _, param := range params {
+		if strings.EqualFold(strings.TrimSpace(param), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isASCII reports whether s holds only ASCII characters
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// ToASCII converts an internationalized domain name to its ASCII form: each label with non-ASCII
+// characters is NFC-normalized, lowercased and encoded as an "xn--" punycode label. Such labels may
+// only hold letters, marks, digits and inner hyphens, and must fit 63 bytes once encoded. ASCII
+// labels are kept as they are.
+func ToASCII(domain string) (string, bool) {
+	labels := strings.Split(domain, ".")
+	for i, label := range labels {
+		if isASCII(label) {
+			continue
+		}
+		label = strings.ToLower(norm.NFC.String(label))
+		if !isIDNLabel(label) {
+			return "", false
+		}
+		encoded, ok := punycodeEncode(label)
+		if !ok || len(idnACEPrefix)+len(encoded) > idnMaxLabelLength {
+			return "", false
+		}
+		labels[i] = idnACEPrefix + encoded
+	}
+	ascii := strings.Join(labels, ".")
+	return ascii, len(ascii) <= idnMaxLength
+}
+
+// isIDNLabel checks the characters of a non-ASCII domain label
+func isIDNLabel(label string) bool {
+	if label == "" || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return false
+	}
+	for _, r := range label {
+		if r != '-' && !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// punycodeEncode encodes a label with the RFC 3492 punycode algorithm, without the "xn--" prefix
+func punycodeEncode(label string) (string, bool) {
+	runes := []rune(label)
+	var out strings.Builder
+	for _, r := range runes {
+		if r < utf8.RuneSelf {
+			out.WriteRune(r)
+		}
+	}
+	basic := out.Len()
+	handled := basic
+	if basic > 0 {
+		out.WriteByte('-')
+	}
+
+	n, delta, bias := punycodeInitialN, 0, punycodeInitialBias
+	for handled < len(runes) {
+		next := int(unicode.MaxRune) + 1
+		for _, r := range runes {
+			if int(r) >= n && int(r) < next {
+				next = int(r)
+			}
+		}
+		delta += (next - n) * (handled + 1)
+		if delta > punycodeMaxDelta {
+			return "", false
+		}
+		n = next
+
+		for _, r := range runes {
+			if int(r) < n {
+				delta++
+			}
+			if int(r) != n {
+				continue
+			}
+			q := delta
+			for k := punycodeBase; ; k += punycodeBase {
+				t := min(max(k-bias, punycodeTMin), punycodeTMax)
+				if q < t {
+					break
+				}
+				out.WriteByte(punycodeDigit(t + (q-t)%(punycodeBase-t)))
+				q = (q - t) / (punycodeBase - t)
+			}
+			out.WriteByte(punycodeDigit(q))
+			bias = punycodeAdapt(delta, handled+1, handled == basic)
+			delta = 0
+			handled++
+		}
+		delta++
+		n++
+	}
+	return out.String(), true
+}
+
+// punycodeAdapt is the RFC 3492 bias adaptation function
+func punycodeAdapt(delta, points int, first bool) int {
+	if first {
+		delta /= punycodeDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / points
+	k := 0
+	for delta > ((punycodeBase-punycodeTMin)*punycodeTMax)/2 {
+		delta /= punycodeBase - punycodeTMin
+		k += punycodeBase
+	}
+	return k + (punycodeBase-punycodeTMin+1)*delta/(delta+punycodeSkew)
+}
+
+// punycodeDigit returns the basic code point of a punycode digit: a-z for 0-25, 0-9 for 26-35
+func punycodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
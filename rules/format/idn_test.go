@@ -0,0 +1,63 @@
+package format_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestToASCII(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string
+		ok     bool
+	}{
+		{"example.com", "example.com", true},
+		{"bücher.de", "xn--bcher-kva.de", true},
+		{"MÜNCHEN.de", "xn--mnchen-3ya.de", true},
+		{"例子.测试", "xn--fsqu00a.xn--0zwm56d", true},
+		{"bü!cher.de", "", false},
+		{"-bücher.de", "", false},
+		{strings.Repeat("ü", 60) + ".de", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := format.ToASCII(tt.domain)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ToASCII(%q) = %q, %v; want %q, %v", tt.domain, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestPunycodeNormalization(t *testing.T) {
+	tests := []struct {
+		create func([]string) (contract.Rule, error)
+		params []string
+		value  string
+		want   string
+	}{
+		{func(p []string) (contract.Rule, error) { return format.NewEmailRule(p) },
+			[]string{format.PunycodeParam}, "jörg@bücher.de", "jörg@xn--bcher-kva.de"},
+		{func(p []string) (contract.Rule, error) { return format.NewEmailRule(p) },
+			nil, "jörg@bücher.de", "jörg@bücher.de"},
+		{func(p []string) (contract.Rule, error) { return format.NewURLRule(p) },
+			[]string{format.PunycodeParam},
+			"https://me@bücher.de:8443/bücher?q=ü", "https://me@xn--bcher-kva.de:8443/bücher?q=ü"},
+		{func(p []string) (contract.Rule, error) { return format.NewURLRule(p) },
+			[]string{format.PunycodeParam}, "https://example.com/ü", "https://example.com/ü"},
+	}
+	for _, tt := range tests {
+		rule, err := tt.create(tt.params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ctx := contract.NewValidationContext("field", tt.value, tt.params, nil)
+		if err := rule.Validate(ctx); err != nil {
+			t.Fatalf("expected %q to pass, got %v", tt.value, err)
+		}
+		if got := rule.(contract.Normalizer).Normalize(ctx); got != tt.want {
+			t.Errorf("expected %q to normalize to %q, got %v", tt.value, tt.want, got)
+		}
+	}
+}
@@ -48,7 +48,7 @@ func (r *IPRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	val, ok := ctx.Value().(string)
+	val, ok := common.StringValue(ctx)
 	if !ok || strings.TrimSpace(val) == "" {
 		return errors.New(ipRuleDataNotProvidedMsg)
 	}
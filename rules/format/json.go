@@ -33,8 +33,7 @@ func (r *JSONRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	raw := ctx.Value()
-	str, ok := raw.(string)
+	str, ok := common.StringValue(ctx)
 	if !ok {
 		return errors.New(jsonRuleInvalidTypeMessage)
 	}
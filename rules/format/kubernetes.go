@@ -0,0 +1,132 @@
+package format
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	dns1123LabelRuleName     = "dns1123_label"
+	dns1123SubdomainRuleName = "dns1123_subdomain"
+	k8sQualifiedNameRuleName = "k8s_qualified_name"
+
+	dns1123LabelRuleDefaultMsg     = "the :attribute must be a lowercase DNS label, at most 63 characters"
+	dns1123SubdomainRuleDefaultMsg = "the :attribute must be a lowercase DNS subdomain, at most 253 characters"
+	k8sQualifiedNameRuleDefaultMsg = "the :attribute must be a qualified name such as example.com/my-name"
+	k8sNameRuleInvalidTypeMsg      = "the :attribute must be a string"
+
+	dns1123LabelMaxLength     = 63
+	dns1123SubdomainMaxLength = 253
+	qualifiedNameMaxLength    = 63
+)
+
+// KubernetesNameRule validates the names Kubernetes gives resources and their metadata, with the
+// constraints of k8s.io/apimachinery/pkg/util/validation: dns1123_label for namespaces and
+// services, dns1123_subdomain for most other resources, k8s_qualified_name for label and
+// annotation keys such as "app.kubernetes.io/name".
+type KubernetesNameRule struct {
+	common.BaseRule
+	name  string
+	valid func(string) bool
+}
+
+// NewDNS1123LabelRule creates a KubernetesNameRule for RFC 1123 labels
+func NewDNS1123LabelRule() (contract.Rule, error) {
+	return newKubernetesNameRule(dns1123LabelRuleName, dns1123LabelRuleDefaultMsg, isDNS1123Label), nil
+}
+
+// NewDNS1123SubdomainRule creates a KubernetesNameRule for RFC 1123 subdomains
+func NewDNS1123SubdomainRule() (contract.Rule, error) {
+	return newKubernetesNameRule(dns1123SubdomainRuleName, dns1123SubdomainRuleDefaultMsg, isDNS1123Subdomain), nil
+}
+
+// NewK8sQualifiedNameRule creates a KubernetesNameRule for qualified names
+func NewK8sQualifiedNameRule() (contract.Rule, error) {
+	return newKubernetesNameRule(k8sQualifiedNameRuleName, k8sQualifiedNameRuleDefaultMsg, isQualifiedName), nil
+}
+
+func newKubernetesNameRule(name, msg string, valid func(string) bool) *KubernetesNameRule {
+	return &KubernetesNameRule{
+		BaseRule: common.NewBaseRule(name, msg, nil),
+		name:     name,
+		valid:    valid,
+	}
+}
+
+// Validate checks the name
+func (r *KubernetesNameRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	val, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(k8sNameRuleInvalidTypeMsg)
+	}
+	if !r.valid(val) {
+		return errors.New(r.GetMessage())
+	}
+	return nil
+}
+
+func (r *KubernetesNameRule) Name() string {
+	return r.name
+}
+
+// isDNS1123Label checks lowercase alphanumerics and inner hyphens, at most 63 characters
+func isDNS1123Label(s string) bool {
+	return len(s) <= dns1123LabelMaxLength && isDNS1123LabelChars(s)
+}
+
+// isDNS1123Subdomain checks dot-separated RFC 1123 labels, at most 253 characters in total. Like
+// Kubernetes, the labels themselves are not limited to 63 characters.
+func isDNS1123Subdomain(s string) bool {
+	if len(s) > dns1123SubdomainMaxLength {
+		return false
+	}
+	for _, label := range strings.Split(s, ".") {
+		if !isDNS1123LabelChars(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// isDNS1123LabelChars checks [a-z0-9]([-a-z0-9]*[a-z0-9])?
+func isDNS1123LabelChars(s string) bool {
+	if s == "" || s[0] == '-' || s[len(s)-1] == '-' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c != '-' && (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// isQualifiedName checks an optional DNS subdomain prefix and a slash, followed by a name of at
+// most 63 alphanumerics, '-', '_' and '.' that starts and ends with an alphanumeric
+func isQualifiedName(s string) bool {
+	name := s
+	if prefix, rest, found := strings.Cut(s, "/"); found {
+		if !isDNS1123Subdomain(prefix) {
+			return false
+		}
+		name = rest
+	}
+	if name == "" || len(name) > qualifiedNameMaxLength {
+		return false
+	}
+	if !isASCIIAlphaNum(name[0]) || !isASCIIAlphaNum(name[len(name)-1]) {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if c := name[i]; !isASCIIAlphaNum(c) && c != '-' && c != '_' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,56 @@
+package format_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestKubernetesNameRules(t *testing.T) {
+	label, _ := format.NewDNS1123LabelRule()
+	subdomain, _ := format.NewDNS1123SubdomainRule()
+	qualified, _ := format.NewK8sQualifiedNameRule()
+
+	tests := []struct {
+		name       string
+		rule       contract.Rule
+		value      any
+		shouldPass bool
+	}{
+		{"label", label, "my-namespace", true},
+		{"numeric label", label, "123", true},
+		{"label of 63 characters", label, strings.Repeat("a", 63), true},
+		{"label too long", label, strings.Repeat("a", 64), false},
+		{"label with uppercase", label, "My-Namespace", false},
+		{"label with dot", label, "my.namespace", false},
+		{"label starting with hyphen", label, "-ns", false},
+		{"label ending with hyphen", label, "ns-", false},
+		{"subdomain", subdomain, "my-app.example.com", true},
+		{"subdomain of one label", subdomain, "my-app", true},
+		{"subdomain with empty label", subdomain, "my-app..com", false},
+		{"subdomain with underscore", subdomain, "my_app", false},
+		{"subdomain too long", subdomain, strings.Repeat("a.", 126) + "ab", false},
+		{"qualified name", qualified, "MyName_1.x", true},
+		{"qualified name with prefix", qualified, "app.kubernetes.io/name", true},
+		{"qualified name with empty prefix", qualified, "/name", false},
+		{"qualified name with uppercase prefix", qualified, "Example.com/name", false},
+		{"qualified name ending with dot", qualified, "name.", false},
+		{"qualified name with two slashes", qualified, "a/b/c", false},
+		{"qualified name too long", qualified, "example.com/" + strings.Repeat("a", 64), false},
+		{"not a string", label, 42, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate(contract.NewValidationContext("name", tt.value, nil, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %v, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+
+	if qualified.Name() != "k8s_qualified_name" {
+		t.Fatalf("unexpected name %q", qualified.Name())
+	}
+}
@@ -0,0 +1,198 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	localeRuleName           = "locale"
+	localeRuleDefaultMsg     = "the :attribute must be a valid locale"
+	localeRuleNotAllowedMsg  = "the :attribute must be one of the following locales: :values"
+	localeRuleInvalidTypeMsg = "the :attribute must be a string"
+	localeRuleInvalidParam   = "locale rule parameter %q is not a well-formed language tag"
+)
+
+// irregularLanguageTags are the grandfathered tags of RFC 5646 that do not follow the langtag
+// syntax
+var irregularLanguageTags = map[string]bool{
+	"en-gb-oed": true, "i-ami": true, "i-bnn": true, "i-default": true, "i-enochian": true,
+	"i-hak": true, "i-klingon": true, "i-lux": true, "i-mingo": true, "i-navajo": true,
+	"i-pwn": true, "i-tao": true, "i-tay": true, "i-tsu": true, "sgn-be-fr": true,
+	"sgn-be-nl": true, "sgn-ch-de": true,
+}
+
+// LocaleRule validates well-formed BCP 47 language tags ("en", "de-CH", "zh-Hant-TW",
+// "sr-Latn-RS-u-nu-latn") with the syntax of RFC 5646, case-insensitively. Subtags are not
+// looked up in the IANA registry, and POSIX forms such as "en_US" are rejected.
+//
+// Parameters restrict the tags to an allow-list ("locale:en,de-DE"). A listed tag also accepts
+// the more specific tags it is a prefix of, as in RFC 4647 basic filtering: "en" accepts "en-GB".
+type LocaleRule struct {
+	common.BaseRule
+	allowed []string
+}
+
+// NewLocaleRule creates a new LocaleRule
+func NewLocaleRule(params []string) (contract.Rule, error) {
+	allowed := make([]string, 0, len(params))
+	for _, param := range params {
+		tag := strings.ToLower(strings.TrimSpace(param))
+		if tag == "" {
+			continue
+		}
+		if !isLanguageTag(tag) {
+			return nil, fmt.Errorf(localeRuleInvalidParam, param)
+		}
+		allowed = append(allowed, tag)
+	}
+
+	msg := localeRuleDefaultMsg
+	if len(allowed) > 0 {
+		msg = localeRuleNotAllowedMsg
+	}
+	return &LocaleRule{
+		BaseRule: common.NewBaseRule(localeRuleName, msg, params),
+		allowed:  allowed,
+	}, nil
+}
+
+// Validate checks the syntax of the tag and the allow-list
+func (r *LocaleRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	val, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(localeRuleInvalidTypeMsg)
+	}
+
+	tag := strings.ToLower(val)
+	if !isLanguageTag(tag) {
+		return errors.New(localeRuleDefaultMsg)
+	}
+	if len(r.allowed) == 0 {
+		return nil
+	}
+	for _, allowed := range r.allowed {
+		if tag == allowed || strings.HasPrefix(tag, allowed+"-") {
+			return nil
+		}
+	}
+	return errors.New(localeRuleNotAllowedMsg)
+}
+
+func (r *LocaleRule) Name() string {
+	return localeRuleName
+}
+
+// isLanguageTag checks a lowercase tag against the RFC 5646 grammar:
+//
+//	langtag    = language ["-" script] ["-" region] *("-" variant) *("-" extension) ["-" privateuse]
+//	language   = 2*3ALPHA ["-" extlang] / 4ALPHA / 5*8ALPHA
+//	extlang    = 3ALPHA *2("-" 3ALPHA)
+//	script     = 4ALPHA
+//	region     = 2ALPHA / 3DIGIT
+//	variant    = 5*8alphanum / (DIGIT 3alphanum)
+//	extension  = singleton 1*("-" (2*8alphanum))
+//	privateuse = "x" 1*("-" (1*8alphanum))
+//
+// Extension singletons may not repeat. Private use tags ("x-klingon") and irregular grandfathered
+// tags are accepted on their own.
+func isLanguageTag(tag string) bool {
+	if irregularLanguageTags[tag] {
+		return true
+	}
+	subtags := strings.Split(tag, "-")
+	for _, subtag := range subtags {
+		if subtag == "" || len(subtag) > 8 || !isLowerAlphaNumString(subtag) {
+			return false
+		}
+	}
+	if subtags[0] == "x" {
+		return len(subtags) > 1
+	}
+
+	i := 0
+	language := subtags[i]
+	if !isAlphaString(language) || len(language) < 2 {
+		return false
+	}
+	i++
+	if len(language) <= 3 {
+		for end := i + 3; i < end && i < len(subtags) && len(subtags[i]) == 3 && isAlphaString(subtags[i]); {
+			i++
+		}
+	}
+	if i < len(subtags) && len(subtags[i]) == 4 && isAlphaString(subtags[i]) {
+		i++
+	}
+	if i < len(subtags) && (len(subtags[i]) == 2 && isAlphaString(subtags[i]) ||
+		len(subtags[i]) == 3 && isDigitString(subtags[i])) {
+		i++
+	}
+	for i < len(subtags) && isLanguageVariant(subtags[i]) {
+		i++
+	}
+
+	singletons := make(map[string]bool)
+	for i < len(subtags) && len(subtags[i]) == 1 {
+		singleton := subtags[i]
+		if singleton == "x" {
+			// The private use subtags run to the end of the tag and may have any length from 1
+			return i+1 < len(subtags)
+		}
+		if singletons[singleton] {
+			return false
+		}
+		singletons[singleton] = true
+		i++
+		start := i
+		for i < len(subtags) && len(subtags[i]) >= 2 {
+			i++
+		}
+		if i == start {
+			return false
+		}
+	}
+	return i == len(subtags)
+}
+
+// isLanguageVariant checks 5 to 8 alphanumerics, or a digit followed by 3 alphanumerics
+func isLanguageVariant(subtag string) bool {
+	return len(subtag) >= 5 || len(subtag) == 4 && subtag[0] >= '0' && subtag[0] <= '9'
+}
+
+// isLowerAlphaNumString reports whether s holds only lowercase ASCII letters and digits
+func isLowerAlphaNumString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isLowerAlphaNum(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isAlphaString reports whether s holds only lowercase ASCII letters
+func isAlphaString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isDigitString reports whether s holds only ASCII digits
+func isDigitString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
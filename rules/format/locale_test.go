@@ -0,0 +1,63 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestLocaleRule(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     []string
+		value      any
+		shouldPass bool
+	}{
+		{"language", nil, "en", true},
+		{"language and region", nil, "de-CH", true},
+		{"script and region", nil, "zh-Hant-TW", true},
+		{"numeric region", nil, "es-419", true},
+		{"extlang", nil, "zh-yue-HK", true},
+		{"variant", nil, "sl-rozaj-biske", true},
+		{"digit variant", nil, "de-CH-1996", true},
+		{"extension", nil, "sr-Latn-RS-u-nu-latn", true},
+		{"private use", nil, "en-US-x-twain", true},
+		{"private use only", nil, "x-klingon", true},
+		{"grandfathered", nil, "i-klingon", true},
+		{"lowercase region", nil, "en-us", true},
+		{"posix form", nil, "en_US", false},
+		{"single letter language", nil, "e", false},
+		{"too long subtag", nil, "en-abcdefghi", false},
+		{"empty subtag", nil, "en--US", false},
+		{"trailing hyphen", nil, "en-", false},
+		{"two regions", nil, "en-US-GB", false},
+		{"extension without subtags", nil, "en-u", false},
+		{"repeated extension", nil, "en-u-nu-latn-u-ca-buddhist", false},
+		{"private use without subtags", nil, "en-x", false},
+		{"digit language", nil, "12", false},
+		{"not a string", nil, 42, false},
+		{"allowed", []string{"en", "de-DE"}, "de-DE", true},
+		{"allowed case-insensitively", []string{"en", "de-DE"}, "DE-de", true},
+		{"allowed by prefix", []string{"en", "de-DE"}, "en-GB", true},
+		{"not allowed", []string{"en", "de-DE"}, "de-AT", false},
+		{"prefix of a subtag only", []string{"en"}, "eng", false},
+		{"ill-formed with allow-list", []string{"en"}, "en_GB", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := format.NewLocaleRule(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			err = rule.Validate(contract.NewValidationContext("locale", tt.value, nil, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %v, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+
+	if _, err := format.NewLocaleRule([]string{"en_US"}); err == nil {
+		t.Fatal("expected an error for an ill-formed allowed tag")
+	}
+}
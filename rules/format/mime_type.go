@@ -0,0 +1,140 @@
+package format
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	mimeTypeRuleName           = "mime_type"
+	mimeTypeRuleDefaultMsg     = "the :attribute must be a valid MIME type"
+	mimeTypeRuleNotAllowedMsg  = "the :attribute must be a MIME type of: :values"
+	mimeTypeRuleInvalidTypeMsg = "the :attribute must be a string"
+
+	mediaTypeNameMaxLength = 127
+)
+
+// MimeTypeRule validates the syntax of a MIME type as declared by API fields, type/subtype with
+// the restricted names of RFC 6838 and optional parameters ("text/plain; charset=utf-8"). It does
+// not look at any content. Parameters restrict the top-level type ("mime_type:image,video").
+type MimeTypeRule struct {
+	common.BaseRule
+	types map[string]bool
+}
+
+// NewMimeTypeRule creates a new MimeTypeRule
+func NewMimeTypeRule(params []string) (contract.Rule, error) {
+	types := make(map[string]bool, len(params))
+	for _, param := range params {
+		if t := strings.ToLower(strings.TrimSpace(param)); t != "" {
+			types[t] = true
+		}
+	}
+
+	msg := mimeTypeRuleDefaultMsg
+	if len(types) > 0 {
+		msg = mimeTypeRuleNotAllowedMsg
+	}
+	return &MimeTypeRule{
+		BaseRule: common.NewBaseRule(mimeTypeRuleName, msg, params),
+		types:    types,
+	}, nil
+}
+
+// Validate checks the syntax of the MIME type and its top-level type
+func (r *MimeTypeRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	val, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(mimeTypeRuleInvalidTypeMsg)
+	}
+
+	mediaType, params, hasParams := strings.Cut(val, ";")
+	topLevel, subtype, found := strings.Cut(strings.TrimSpace(mediaType), "/")
+	if !found || !isMediaTypeName(topLevel) || !isMediaTypeName(subtype) || hasParams && !isMediaTypeParams(params) {
+		return errors.New(mimeTypeRuleDefaultMsg)
+	}
+	if len(r.types) > 0 && !r.types[strings.ToLower(topLevel)] {
+		return errors.New(mimeTypeRuleNotAllowedMsg)
+	}
+	return nil
+}
+
+func (r *MimeTypeRule) Name() string {
+	return mimeTypeRuleName
+}
+
+// isMediaTypeName checks an RFC 6838 restricted name: up to 127 letters, digits and
+// !#$&-^_.+ starting with a letter or digit
+func isMediaTypeName(name string) bool {
+	if name == "" || len(name) > mediaTypeNameMaxLength || !isASCIIAlphaNum(name[0]) {
+		return false
+	}
+	for i := 1; i < len(name); i++ {
+		if c := name[i]; !isASCIIAlphaNum(c) && strings.IndexByte("!#$&-^_.+", c) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isMediaTypeParams checks the parameters after the first ";": name=value pairs separated by ";",
+// where names are tokens and values tokens or quoted strings
+func isMediaTypeParams(params string) bool {
+	for _, param := range splitMediaTypeParams(params) {
+		name, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if !found || !isHTTPToken(name) {
+			return false
+		}
+		if !isHTTPToken(value) && !isQuotedString(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitMediaTypeParams splits parameters at the semicolons outside quoted strings
+func splitMediaTypeParams(params string) []string {
+	var parts []string
+	quoted, escaped, start := false, false, 0
+	for i := 0; i < len(params); i++ {
+		switch c := params[i]; {
+		case escaped:
+			escaped = false
+		case c == '\\' && quoted:
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case c == ';' && !quoted:
+			parts = append(parts, params[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, params[start:])
+}
+
+// isQuotedString checks an RFC 7230 quoted string of visible ASCII, spaces, tabs and
+// backslash-escaped characters
+func isQuotedString(s string) bool {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return false
+	}
+	for i := 1; i < len(s)-1; i++ {
+		c := s[i]
+		switch {
+		case c == '\\':
+			i++
+			if i == len(s)-1 {
+				return false
+			}
+		case c == '"' || c < ' ' && c != '\t' || c == 0x7f:
+			return false
+		}
+	}
+	return true
+}
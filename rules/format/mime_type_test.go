@@ -0,0 +1,49 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestMimeTypeRule(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     []string
+		value      any
+		shouldPass bool
+	}{
+		{"simple type", nil, "application/json", true},
+		{"vendor type", nil, "application/vnd.api+json", true},
+		{"uppercase", nil, "Text/HTML", true},
+		{"parameter", nil, "text/plain; charset=utf-8", true},
+		{"several parameters", nil, "multipart/form-data; boundary=abc123;charset=utf-8", true},
+		{"quoted parameter", nil, `multipart/mixed; boundary="a;b \"c\""`, true},
+		{"missing subtype", nil, "text", false},
+		{"empty subtype", nil, "text/", false},
+		{"two slashes", nil, "text/plain/x", false},
+		{"wildcard", nil, "image/*", false},
+		{"space in name", nil, "text/pl ain", false},
+		{"trailing semicolon", nil, "text/plain;", false},
+		{"parameter without value", nil, "text/plain; charset", false},
+		{"unterminated quoted parameter", nil, `text/plain; a="b`, false},
+		{"header injection", nil, "text/plain\r\nX-Evil: 1", false},
+		{"not a string", nil, 42, false},
+		{"allowed top-level type", []string{"image", "video"}, "image/png", true},
+		{"allowed top-level type in other case", []string{"image", "video"}, "VIDEO/mp4", true},
+		{"other top-level type", []string{"image", "video"}, "application/pdf", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := format.NewMimeTypeRule(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			err = rule.Validate(contract.NewValidationContext("content_type", tt.value, nil, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %v, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+}
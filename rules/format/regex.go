@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -24,12 +25,13 @@ type RegexRule struct {
 }
 
 // NewRegexRule creates a new RegexRule with the given pattern parameter.
+// Unescaped commas split rule parameters, so all parameters are joined back into one pattern.
 func NewRegexRule(parameters []string, options ...common.RuleOption) (contract.Rule, error) {
 	if len(parameters) == 0 {
 		return nil, errors.New(regexRuleInvalidParamMsg)
 	}
 
-	pat, err := regexp.Compile(parameters[0])
+	pat, err := regexp.Compile(strings.Join(parameters, ","))
 	if err != nil {
 		return nil, fmt.Errorf(regexRuleInvalidParamMsg+": %w", err)
 	}
@@ -46,7 +48,7 @@ func (r *RegexRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	str, ok := ctx.Value().(string)
+	str, ok := common.StringValue(ctx)
 	if !ok {
 		return errors.New(regexRuleInvalidTypeMsg)
 	}
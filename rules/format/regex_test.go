@@ -65,3 +65,20 @@ func TestRegexRule_InvalidConstruction(t *testing.T) {
 		}
 	})
 }
+
+func TestRegexRule_JoinsSplitParameters(t *testing.T) {
+	t.Parallel()
+
+	// "regex:^\d{1,3}$" is split on the unescaped comma by the parser
+	rule, err := format.NewRegexRule([]string{`^\d{1`, `3}$`})
+	if err != nil {
+		t.Fatalf("failed to create RegexRule: %v", err)
+	}
+
+	if err := rule.Validate(contract.NewValidationContext("code", "123", nil, nil)); err != nil {
+		t.Errorf("expected pass, got error: %v", err)
+	}
+	if err := rule.Validate(contract.NewValidationContext("code", "1234", nil, nil)); err == nil {
+		t.Error("expected failure for four digits")
+	}
+}
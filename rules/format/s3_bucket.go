@@ -0,0 +1,99 @@
+package format
+
+import (
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	s3BucketRuleName           = "s3_bucket"
+	s3BucketRuleDefaultMsg     = "the :attribute must be a valid S3 bucket name"
+	s3BucketRuleInvalidTypeMsg = "the :attribute must be a string"
+
+	s3BucketMinLength = 3
+	s3BucketMaxLength = 63
+)
+
+var (
+	// s3BucketReservedPrefixes and s3BucketReservedSuffixes are reserved by AWS for its own
+	// features (access point aliases, Object Lambda, Multi-Region Access Points, directory and
+	// table buckets)
+	s3BucketReservedPrefixes = []string{"xn--", "sthree-", "amzn-s3-demo-"}
+	s3BucketReservedSuffixes = []string{"-s3alias", "--ol-s3", ".mrap", "--x-s3", "--table-s3"}
+)
+
+// S3BucketRule validates the name of an S3 general purpose bucket with the AWS naming rules: 3 to 63
+// lowercase letters, digits, dots and hyphens, starting and ending with a letter or a digit, with no
+// adjacent dots, no dot next to a hyphen, no IP address form and none of the reserved prefixes and
+// suffixes
+type S3BucketRule struct {
+	common.BaseRule
+}
+
+// NewS3BucketRule creates a new S3BucketRule
+func NewS3BucketRule() (contract.Rule, error) {
+	return &S3BucketRule{
+		BaseRule: common.NewBaseRule(s3BucketRuleName, s3BucketRuleDefaultMsg, nil),
+	}, nil
+}
+
+// Validate checks the bucket name
+func (r *S3BucketRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	name, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(s3BucketRuleInvalidTypeMsg)
+	}
+	if !isS3BucketName(name) {
+		return errors.New(s3BucketRuleDefaultMsg)
+	}
+	return nil
+}
+
+func (r *S3BucketRule) Name() string {
+	return s3BucketRuleName
+}
+
+// isS3BucketName applies the naming rules of general purpose buckets
+func isS3BucketName(name string) bool {
+	if len(name) < s3BucketMinLength || len(name) > s3BucketMaxLength {
+		return false
+	}
+	first, last := name[0], name[len(name)-1]
+	if !isLowerAlphaNum(first) || !isLowerAlphaNum(last) {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if c := name[i]; !isLowerAlphaNum(c) && c != '.' && c != '-' {
+			return false
+		}
+	}
+	if strings.Contains(name, "..") || strings.Contains(name, ".-") || strings.Contains(name, "-.") {
+		return false
+	}
+	if ip := net.ParseIP(name); ip != nil && ip.To4() != nil {
+		return false
+	}
+	for _, prefix := range s3BucketReservedPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	for _, suffix := range s3BucketReservedSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// isLowerAlphaNum reports whether c is a lowercase ASCII letter or a digit
+func isLowerAlphaNum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,48 @@
+package format_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/format"
+)
+
+func TestS3BucketRule(t *testing.T) {
+	rule, _ := format.NewS3BucketRule()
+
+	tests := []struct {
+		name       string
+		value      any
+		shouldPass bool
+	}{
+		{"simple name", "my-bucket", true},
+		{"name with dots", "logs.example.com", true},
+		{"minimum length", "abc", true},
+		{"maximum length", strings.Repeat("a", 63), true},
+		{"digits", "2024-backups", true},
+		{"too short", "ab", false},
+		{"too long", strings.Repeat("a", 64), false},
+		{"uppercase", "My-Bucket", false},
+		{"underscore", "my_bucket", false},
+		{"starting with hyphen", "-bucket", false},
+		{"ending with dot", "bucket.", false},
+		{"adjacent dots", "my..bucket", false},
+		{"dot next to hyphen", "my-.bucket", false},
+		{"ip address form", "192.168.5.4", false},
+		{"reserved prefix", "xn--bucket", false},
+		{"sthree prefix", "sthree-bucket", false},
+		{"access point alias suffix", "bucket-s3alias", false},
+		{"multi-region access point suffix", "bucket.mrap", false},
+		{"directory bucket suffix", "bucket--x-s3", false},
+		{"not a string", 42, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rule.Validate(contract.NewValidationContext("bucket", tt.value, nil, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %v, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package format
 import (
 	"errors"
 	"net/url"
+	"strings"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -15,15 +16,19 @@ const (
 	urlRuleInvalidFormatMessage = "the :attribute must be a properly formatted URL (with scheme and host)"
 )
 
-// URLRule validates that a field is a valid absolute URL.
+// URLRule validates that a field is a valid absolute URL. Internationalized hosts
+// ("https://bücher.de") are accepted when they convert to punycode; with the punycode parameter
+// ("url:punycode") the URL is normalized to its ASCII host ("https://xn--bcher-kva.de").
 type URLRule struct {
 	common.BaseRule
+	punycode bool
 }
 
 // NewURLRule creates a new instance of URLRule.
 func NewURLRule(parameters []string, options ...common.RuleOption) (contract.Rule, error) {
 	return &URLRule{
 		BaseRule: common.NewBaseRule(urlRuleName, urlRuleDefaultMessage, parameters, options...),
+		punycode: hasParam(parameters, PunycodeParam),
 	}, nil
 }
 
@@ -33,8 +38,7 @@ func (r *URLRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	raw := ctx.Value()
-	str, ok := raw.(string)
+	str, ok := common.StringValue(ctx)
 	if !ok || str == "" {
 		return errors.New(urlRuleInvalidTypeMessage)
 	}
@@ -43,10 +47,39 @@ func (r *URLRule) Validate(ctx contract.RuleContext) error {
 	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
 		return errors.New(urlRuleInvalidFormatMessage)
 	}
+	if _, ok := ToASCII(parsed.Hostname()); !ok {
+		return errors.New(urlRuleInvalidFormatMessage)
+	}
 
 	return nil
 }
 
+// Normalize converts the host of an accepted URL to punycode when the punycode parameter is set
+func (r *URLRule) Normalize(ctx contract.RuleContext) any {
+	str, ok := ctx.Value().(string)
+	if !ok || !r.punycode {
+		return ctx.Value()
+	}
+	parsed, err := url.ParseRequestURI(str)
+	if err != nil {
+		return str
+	}
+	host := parsed.Hostname()
+	ascii, ok := ToASCII(host)
+	if !ok || ascii == host {
+		return str
+	}
+
+	// Replace the host in the authority only, keeping the rest of the URL as written
+	start := strings.Index(str, "//") + len("//")
+	end := len(str)
+	if i := strings.IndexAny(str[start:], "/?#"); i != -1 {
+		end = start + i
+	}
+	hostStart := start + strings.LastIndex(str[start:end], "@") + 1
+	return str[:hostStart] + strings.Replace(str[hostStart:end], host, ascii, 1) + str[end:]
+}
+
 func (r *URLRule) Name() string {
 	return urlRuleName
 }
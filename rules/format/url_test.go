@@ -25,6 +25,7 @@ func TestURLRule(t *testing.T) {
 		{"valid - https URL", "https://example.com", true},
 		{"valid - with path", "https://example.com/path", true},
 		{"valid - with query", "https://example.com?query=value", true},
+		{"valid - internationalized host", "https://bücher.de/straße", true},
 
 		// ❌ Invalid cases
 		{"invalid - missing scheme", "example.com", false},
@@ -33,6 +34,7 @@ func TestURLRule(t *testing.T) {
 		{"invalid - empty string", "", false},
 		{"invalid - non-string type", 123, false},
 		{"invalid - nil value", nil, false},
+		{"invalid - internationalized label", "https://bü cher.de", false},
 	}
 
 	for _, tc := range tests {
@@ -37,8 +37,7 @@ func (r *UUIDRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	val := ctx.Value()
-	str, ok := val.(string)
+	str, ok := common.StringValue(ctx)
 	if !ok || str == "" {
 		return errors.New(uuidRuleInvalidTypeMessage)
 	}
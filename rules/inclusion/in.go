@@ -2,7 +2,6 @@ package inclusion
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -38,9 +37,8 @@ func (r *InRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	value := fmt.Sprintf("%v", ctx.Value())
 	for _, allowed := range r.allowed {
-		if value == allowed {
+		if common.ValuesEqual(ctx.Value(), allowed) {
 			return nil
 		}
 	}
@@ -2,7 +2,6 @@ package inclusion
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -39,9 +38,8 @@ func (r *NotInRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	value := fmt.Sprintf("%v", ctx.Value())
 	for _, f := range r.forbidden {
-		if value == f {
+		if common.ValuesEqual(ctx.Value(), f) {
 			return errors.New(notInRuleValidationFailedMessage)
 		}
 	}
@@ -0,0 +1,21 @@
+// Package loader parses declarative rule sets from YAML or JSON documents.
+//
+// A document lists fields with their rules (a rule string or a list of rules),
+// optional per-field messages and attribute name, plus global messages and attributes.
+// A field may also be given directly as its rule string or list:
+//
+//	fields:
+//	  email:
+//	    rules: [required, email]
+//	    attribute: e-mail address
+//	    messages:
+//	      required: We need your email
+//	  age: required|integer|min:18
+//	messages:
+//	  email: The :attribute is not valid
+//	profiles:
+//	  staging:
+//	    website: nullable|url
+//
+// Profile overrides replace a field's rules when the profile is selected.
+package loader
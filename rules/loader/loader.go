@@ -0,0 +1,170 @@
+package loader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/parser"
+)
+
+// ErrUnsupportedFormat is returned by LoadFile for extensions other than .yaml, .yml and .json
+var ErrUnsupportedFormat = errors.New("unsupported rule set format")
+
+// document is the on-disk shape of a rule set
+type document struct {
+	Fields     map[string]fieldDocument  `json:"fields" yaml:"fields"`
+	Messages   map[string]string         `json:"messages" yaml:"messages"`
+	Attributes map[string]string         `json:"attributes" yaml:"attributes"`
+	Profiles   map[string]map[string]any `json:"profiles" yaml:"profiles"`
+}
+
+// fieldDocument describes the rules of a single field
+type fieldDocument struct {
+	Rules     any               `json:"rules" yaml:"rules"`
+	Messages  map[string]string `json:"messages" yaml:"messages"`
+	Attribute string            `json:"attribute" yaml:"attribute"`
+}
+
+// UnmarshalYAML accepts either a full field definition or a rule string/list shorthand
+func (f *fieldDocument) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode || node.Kind == yaml.SequenceNode {
+		return node.Decode(&f.Rules)
+	}
+	type plain fieldDocument
+	return node.Decode((*plain)(f))
+}
+
+// UnmarshalJSON accepts either a full field definition or a rule string/list shorthand
+func (f *fieldDocument) UnmarshalJSON(data []byte) error {
+	trimmed := strings.TrimSpace(string(data))
+	if strings.HasPrefix(trimmed, `"`) || strings.HasPrefix(trimmed, "[") {
+		return json.Unmarshal(data, &f.Rules)
+	}
+	type plain fieldDocument
+	return json.Unmarshal(data, (*plain)(f))
+}
+
+// LoadYAML parses a YAML rule set document
+func LoadYAML(data []byte) (*contract.RuleSet, error) {
+	var doc document
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse yaml rule set: %w", err)
+	}
+	return compile(doc)
+}
+
+// LoadJSON parses a JSON rule set document
+func LoadJSON(data []byte) (*contract.RuleSet, error) {
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse json rule set: %w", err)
+	}
+	return compile(doc)
+}
+
+// LoadFile reads a rule set document, choosing the format from the file extension
+func LoadFile(path string) (*contract.RuleSet, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return LoadYAML(data)
+	case ".json":
+		return LoadJSON(data)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, filepath.Ext(path))
+	}
+}
+
+// Check reports the rules of set that are not registered in reg, sorted by field
+func Check(set *contract.RuleSet, reg contract.Registry) error {
+	fields := make([]string, 0, len(set.Rules))
+	for field := range set.Rules {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var unknown []string
+	for _, field := range fields {
+		for _, rule := range parser.ParseRules(set.Rules[field]) {
+			if _, ok := reg.Get(rule.Name); !ok {
+				unknown = append(unknown, field+": "+rule.Name)
+			}
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: %s", contract.ErrRuleNotFound, strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// compile normalizes a parsed document into a RuleSet
+func compile(doc document) (*contract.RuleSet, error) {
+	set := contract.NewRuleSet()
+	for key, message := range doc.Messages {
+		set.Messages[key] = message
+	}
+	for field, attribute := range doc.Attributes {
+		set.Attributes[field] = attribute
+	}
+
+	for field, fieldDoc := range doc.Fields {
+		rules, err := ruleString(fieldDoc.Rules)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field, err)
+		}
+		set.Rules[field] = rules
+
+		for rule, message := range fieldDoc.Messages {
+			set.Messages[rule+"."+field] = message
+		}
+		if fieldDoc.Attribute != "" {
+			set.Attributes[field] = fieldDoc.Attribute
+		}
+	}
+
+	for profile, overrides := range doc.Profiles {
+		set.Profiles[profile] = make(map[string]string, len(overrides))
+		for field, override := range overrides {
+			rules, err := ruleString(override)
+			if err != nil {
+				return nil, fmt.Errorf("profile %s field %s: %w", profile, field, err)
+			}
+			set.Profiles[profile][field] = rules
+		}
+	}
+	return set, nil
+}
+
+// ruleString converts a rule string or list of rules into a pipe-separated rule string
+func ruleString(rules any) (string, error) {
+	switch r := rules.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return r, nil
+	case []any:
+		parts := make([]string, 0, len(r))
+		for _, item := range r {
+			rule, ok := item.(string)
+			if !ok {
+				return "", fmt.Errorf("%w: rule list entries must be strings, got %T", contract.ErrInvalidRule, item)
+			}
+			parts = append(parts, rule)
+		}
+		return strings.Join(parts, "|"), nil
+	default:
+		return "", fmt.Errorf("%w: rules must be a string or a list, got %T", contract.ErrInvalidRule, rules)
+	}
+}
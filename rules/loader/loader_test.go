@@ -0,0 +1,85 @@
+package loader_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules"
+	"github.com/next-trace/scg-validator/rules/loader"
+)
+
+const yamlDoc = `
+fields:
+  email:
+    rules: [required, email]
+    attribute: e-mail address
+    messages:
+      required: We need your email
+  age: required|integer|min:18
+messages:
+  integer: The :attribute must be a whole number
+`
+
+func TestLoadYAML(t *testing.T) {
+	set, err := loader.LoadYAML([]byte(yamlDoc))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if set.Rules["email"] != "required|email" || set.Rules["age"] != "required|integer|min:18" {
+		t.Fatalf("unexpected rules: %#v", set.Rules)
+	}
+	if set.Messages["required.email"] != "We need your email" || set.Messages["integer"] == "" {
+		t.Fatalf("unexpected messages: %#v", set.Messages)
+	}
+	if set.Attributes["email"] != "e-mail address" {
+		t.Fatalf("unexpected attributes: %#v", set.Attributes)
+	}
+}
+
+func TestLoadJSON(t *testing.T) {
+	doc := `{"fields":{"name":{"rules":["required","min:2"]},"id":"uuid"},"attributes":{"name":"full name"}}`
+	set, err := loader.LoadJSON([]byte(doc))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if set.Rules["name"] != "required|min:2" || set.Rules["id"] != "uuid" || set.Attributes["name"] != "full name" {
+		t.Fatalf("unexpected rule set: %#v", set)
+	}
+
+	if _, err := loader.LoadJSON([]byte(`{"fields":{"name":{"rules":[1]}}}`)); !errors.Is(err, contract.ErrInvalidRule) {
+		t.Fatalf("expected invalid rule error, got %v", err)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "user.yml")
+	if err := os.WriteFile(path, []byte("fields:\n  id:\n    rules: required\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	set, err := loader.LoadFile(path)
+	if err != nil || set.Rules["id"] != "required" {
+		t.Fatalf("unexpected result: %#v, %v", set, err)
+	}
+
+	if _, err := loader.LoadFile(filepath.Join(dir, "user.toml")); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+	toml := filepath.Join(dir, "user.toml")
+	_ = os.WriteFile(toml, []byte(""), 0o600)
+	if _, err := loader.LoadFile(toml); !errors.Is(err, loader.ErrUnsupportedFormat) {
+		t.Fatalf("expected unsupported format error, got %v", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	set := contract.NewRuleSet()
+	set.Rules["name"] = "required|no_such_rule"
+	err := loader.Check(set, rules.NewRuleRegistry())
+	if !errors.Is(err, contract.ErrRuleNotFound) {
+		t.Fatalf("expected rule not found error, got %v", err)
+	}
+}
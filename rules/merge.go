@@ -0,0 +1,83 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/parser"
+)
+
+// Merge composes rule sets, e.g. shared audit or pagination fields with an endpoint schema.
+// Each override is applied on top of the result of the previous ones, none of them is modified:
+//   - field rules merge with MergeRules; an empty override rule string drops the field
+//   - messages and attributes of the override replace those with the same key
+//   - profile overrides of the same profile and field replace the base ones
+func Merge(base *contract.RuleSet, overrides ...*contract.RuleSet) *contract.RuleSet {
+	merged := contract.NewRuleSet()
+	for _, set := range append([]*contract.RuleSet{base}, overrides...) {
+		if set == nil {
+			continue
+		}
+		for field, rule := range set.Rules {
+			if rule == "" {
+				delete(merged.Rules, field)
+				continue
+			}
+			merged.Rules[field] = MergeRules(merged.Rules[field], rule)
+		}
+		for key, message := range set.Messages {
+			merged.Messages[key] = message
+		}
+		for field, attribute := range set.Attributes {
+			merged.Attributes[field] = attribute
+		}
+		for profile, fields := range set.Profiles {
+			if merged.Profiles[profile] == nil {
+				merged.Profiles[profile] = make(map[string]string, len(fields))
+			}
+			for field, rule := range fields {
+				merged.Profiles[profile][field] = rule
+			}
+		}
+	}
+	return merged
+}
+
+// MergeRules merges two rule strings of a field: a rule of override replaces the base rule
+// with the same name in place ("required|max:100" + "max:50|email" = "required|max:50|email"),
+// other rules are appended. Negated rules ("!in:...") are distinct from their positive form.
+func MergeRules(base, override string) string {
+	merged := parser.SplitRules(base)
+	positions := make(map[string]int, len(merged))
+	for i, rule := range merged {
+		if _, exists := positions[ruleKey(rule)]; !exists {
+			positions[ruleKey(rule)] = i
+		}
+	}
+
+	for _, rule := range parser.SplitRules(override) {
+		if rule == "" {
+			continue
+		}
+		if i, exists := positions[ruleKey(rule)]; exists {
+			merged[i] = rule
+			continue
+		}
+		positions[ruleKey(rule)] = len(merged)
+		merged = append(merged, rule)
+	}
+	return parser.JoinRules(merged)
+}
+
+// ruleKey identifies a rule by its name and negation, e.g. "max" or "!in"
+func ruleKey(rule string) string {
+	if warning, ok := strings.CutPrefix(strings.TrimSpace(rule), parser.WarningPrefix); ok {
+		return parser.WarningPrefix + ruleKey(warning)
+	}
+	name, _, _ := strings.Cut(rule, ":")
+	name = strings.TrimSpace(name)
+	if negated, ok := strings.CutPrefix(name, parser.NegationPrefix); ok {
+		return parser.NegationPrefix + strings.TrimSpace(negated)
+	}
+	return name
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func TestMergeRules(t *testing.T) {
+	tests := []struct {
+		base, override, want string
+	}{
+		{"required|max:100", "max:50|email", "required|max:50|email"},
+		{"", "required", "required"},
+		{"required|in:a,b", "!in:c", "required|in:a,b|!in:c"},
+		{`regex:[^a|b]|required`, "regex:[^c|d]", `regex:[^c|d]|required`},
+		{`in:a\|b`, "required", `in:a\|b|required`},
+		{"min:3|warn:min:10", "warn:min:20", "min:3|warn:min:20"},
+	}
+	for _, tc := range tests {
+		if got := MergeRules(tc.base, tc.override); got != tc.want {
+			t.Errorf("MergeRules(%q, %q) = %q, want %q", tc.base, tc.override, got, tc.want)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	audit := contract.NewRuleSet()
+	audit.Rules["created_by"] = "required|uuid"
+	audit.Rules["note"] = "nullable|max:100"
+	audit.Messages["required"] = "base message"
+	audit.Attributes["created_by"] = "creator"
+	audit.Profiles["staging"] = map[string]string{"created_by": "nullable"}
+
+	endpoint := contract.NewRuleSet()
+	endpoint.Rules["title"] = "required"
+	endpoint.Rules["note"] = "max:20"
+	endpoint.Rules["created_by"] = ""
+	endpoint.Messages["required"] = "endpoint message"
+
+	merged := Merge(audit, endpoint)
+	wantRules := map[string]string{"title": "required", "note": "nullable|max:20"}
+	if !reflect.DeepEqual(merged.Rules, wantRules) {
+		t.Fatalf("unexpected rules: %#v", merged.Rules)
+	}
+	if merged.Messages["required"] != "endpoint message" || merged.Attributes["created_by"] != "creator" {
+		t.Fatalf("unexpected messages or attributes: %#v %#v", merged.Messages, merged.Attributes)
+	}
+	if merged.Profiles["staging"]["created_by"] != "nullable" {
+		t.Fatalf("expected base profiles to be kept: %#v", merged.Profiles)
+	}
+	if audit.Rules["note"] != "nullable|max:100" {
+		t.Fatal("merge must not modify its inputs")
+	}
+}
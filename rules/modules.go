@@ -0,0 +1,187 @@
+package rules
+
+import (
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/acceptance"
+	"github.com/next-trace/scg-validator/rules/authentication"
+	"github.com/next-trace/scg-validator/rules/comparison"
+	"github.com/next-trace/scg-validator/rules/conditional"
+	"github.com/next-trace/scg-validator/rules/control"
+	"github.com/next-trace/scg-validator/rules/database"
+	"github.com/next-trace/scg-validator/rules/format"
+	"github.com/next-trace/scg-validator/rules/inclusion"
+	"github.com/next-trace/scg-validator/rules/types/boolean"
+	"github.com/next-trace/scg-validator/rules/types/collection"
+	dateRules "github.com/next-trace/scg-validator/rules/types/date"
+	"github.com/next-trace/scg-validator/rules/types/numeric"
+	stringRules "github.com/next-trace/scg-validator/rules/types/string"
+)
+
+// Module names
+const (
+	ModuleCore    = "core"
+	ModuleStrings = "strings"
+	ModuleNetwork = "network"
+	ModuleFinance = "finance"
+	ModuleFiles   = "files"
+	ModuleGeo     = "geo"
+)
+
+// Module is an opt-in group of built-in rules. CLI tools and functions needing a handful of rules
+// register only their modules with NewModuleRegistry (or engine.NewModularEngine), so the other
+// rules are not linked into the binary nor created at startup.
+type Module struct {
+	Name  string
+	Rules map[string]contract.RuleCreator
+}
+
+// All returns every module, the rules NewRuleRegistry registers
+func All() []Module {
+	return []Module{Core(), Strings(), Network(), Finance(), Files(), Geo()}
+}
+
+// Core returns the presence, acceptance, comparison, numeric, date, inclusion and control rules,
+// and the database and current_password rules, which run against pluggable verifiers
+func Core() Module {
+	return Module{Name: ModuleCore, Rules: map[string]contract.RuleCreator{
+		// Acceptance rules
+		RuleAccepted:   func(_ []string) (contract.Rule, error) { return acceptance.NewAcceptedRule() },
+		RuleDeclined:   func(_ []string) (contract.Rule, error) { return acceptance.NewDeclinedRule() },
+		RuleAcceptedIf: acceptance.NewAcceptedIfRule,
+		RuleDeclinedIf: acceptance.NewDeclinedIfRule,
+
+		// Boolean rules
+		RuleBoolean: func(_ []string) (contract.Rule, error) { return boolean.NewBooleanRule() },
+
+		// Comparison rules
+		RuleMin:         comparison.NewMinRule,
+		RuleMax:         comparison.NewMaxRule,
+		RuleSize:        comparison.NewSizeRule,
+		RuleBetween:     comparison.NewBetweenRule,
+		RuleGt:          comparison.NewGtRule,
+		RuleLt:          comparison.NewLtRule,
+		RuleGte:         comparison.NewGteRule,
+		RuleLte:         comparison.NewLteRule,
+		RuleSame:        comparison.NewSameRule,
+		RuleDifferent:   comparison.NewDifferentRule,
+		RuleConfirmed:   func(p []string) (contract.Rule, error) { return comparison.NewConfirmedRule(p...) },
+		RuleConfirmedBy: comparison.NewConfirmedByRule,
+		RuleExpr:        comparison.NewExprRule,
+
+		// Conditional rules
+		RuleRequired:           func(_ []string) (contract.Rule, error) { return conditional.NewRequiredRule() },
+		RuleRequiredIf:         conditional.NewRequiredIfRule,
+		RuleRequiredUnless:     conditional.NewRequiredUnlessRule,
+		RuleRequiredWith:       conditional.NewRequiredWithRule,
+		RuleRequiredWithout:    conditional.NewRequiredWithoutRule,
+		RuleRequiredWithAll:    conditional.NewRequiredWithAllRule,
+		RuleRequiredWithoutAll: conditional.NewRequiredWithoutAllRule,
+		RuleRequiredTogether:   conditional.NewRequiredTogetherRule,
+
+		// Prohibited rules
+		RuleProhibited:       func(_ []string) (contract.Rule, error) { return conditional.NewProhibitedRule() },
+		RuleProhibitedIf:     conditional.NewProhibitedIfRule,
+		RuleProhibitedUnless: conditional.NewProhibitedUnlessRule,
+		RuleProhibits:        conditional.NewProhibitsRule,
+		RuleProhibitedKeys:   func(p []string) (contract.Rule, error) { return collection.NewProhibitedKeysRule(p) },
+
+		// Control rules
+		RuleBail:      func(_ []string) (contract.Rule, error) { return control.NewBailRule() },
+		RuleFilled:    func(_ []string) (contract.Rule, error) { return control.NewFilledRule() },
+		RulePresent:   func(_ []string) (contract.Rule, error) { return control.NewPresentRule() },
+		RuleSometimes: func(_ []string) (contract.Rule, error) { return control.NewSometimesRule() },
+		RuleNullable:  func(_ []string) (contract.Rule, error) { return control.NewNullableRule() },
+
+		// Date rules
+		RuleAfter:         dateRules.NewAfterRule,
+		RuleBefore:        dateRules.NewBeforeRule,
+		RuleBeforeOrEqual: dateRules.NewBeforeOrEqualRule,
+		RuleAfterOrEqual:  dateRules.NewAfterOrEqualRule,
+
+		// Numeric rules
+		RuleNumeric:    func(_ []string) (contract.Rule, error) { return numeric.NewNumericRule() },
+		RuleInteger:    func(_ []string) (contract.Rule, error) { return numeric.NewIntegerRule() },
+		RuleMultipleOf: numeric.NewMultipleOfRule,
+
+		// Inclusion rules
+		RuleIn:       func(p []string) (contract.Rule, error) { return inclusion.NewInRule(p) },
+		RuleNotIn:    func(p []string) (contract.Rule, error) { return inclusion.NewNotInRule(p) },
+		RuleDistinct: func(p []string) (contract.Rule, error) { return collection.NewDistinctRule(p) },
+
+		// Auth rules
+		RuleCurrentPassword: func(_ []string) (contract.Rule, error) { return authentication.NewCurrentPasswordRule() },
+
+		// Database rules
+		RuleExists: database.NewExistRule,
+		RuleUnique: func(_ []string) (contract.Rule, error) { return database.NewUniqueRule() },
+	}}
+}
+
+// Strings returns the character class, casing, identifier, product code, color, hash, git,
+// Kubernetes name, cloud resource, locale, HTTP, pattern and word list rules of strings
+func Strings() Module {
+	return Module{Name: ModuleStrings, Rules: map[string]contract.RuleCreator{
+		RuleAlpha:            func(p []string) (contract.Rule, error) { return stringRules.NewAlphaRule(p) },
+		RuleAlphaNum:         func(p []string) (contract.Rule, error) { return stringRules.NewAlphaNumRule(p) },
+		RuleAlphaDash:        func(p []string) (contract.Rule, error) { return stringRules.NewAlphaDashRule(p) },
+		RuleLowercase:        func(_ []string) (contract.Rule, error) { return stringRules.NewLowercaseRule() },
+		RuleUppercase:        func(_ []string) (contract.Rule, error) { return stringRules.NewUppercaseRule() },
+		RuleASCII:            func(_ []string) (contract.Rule, error) { return stringRules.NewASCIIRule() },
+		RuleUlid:             func(_ []string) (contract.Rule, error) { return stringRules.NewUlidRule() },
+		RuleSlug:             func(_ []string) (contract.Rule, error) { return stringRules.NewSlugRule() },
+		RuleDoesntStartWith:  stringRules.NewDoesntStartWithRule,
+		RuleDoesntEndWith:    func(p []string) (contract.Rule, error) { return stringRules.NewDoesntEndWithRule(p) },
+		RuleNotInWordlist:    stringRules.NewNotInWordlistRule,
+		RuleRegex:            func(p []string) (contract.Rule, error) { return format.NewRegexRule(p) },
+		RuleUPCA:             func(_ []string) (contract.Rule, error) { return format.NewUPCARule() },
+		RuleGTIN14:           func(_ []string) (contract.Rule, error) { return format.NewGTIN14Rule() },
+		RuleColor:            format.NewColorRule,
+		RuleMD5:              func(_ []string) (contract.Rule, error) { return format.NewMD5Rule() },
+		RuleSHA1:             func(_ []string) (contract.Rule, error) { return format.NewSHA1Rule() },
+		RuleSHA256:           func(_ []string) (contract.Rule, error) { return format.NewSHA256Rule() },
+		RuleSHA512:           func(_ []string) (contract.Rule, error) { return format.NewSHA512Rule() },
+		RuleHash:             format.NewHashRule,
+		RuleGitSHA:           format.NewGitSHARule,
+		RuleGitRef:           func(_ []string) (contract.Rule, error) { return format.NewGitRefRule() },
+		RuleDNS1123Label:     func(_ []string) (contract.Rule, error) { return format.NewDNS1123LabelRule() },
+		RuleDNS1123Subdomain: func(_ []string) (contract.Rule, error) { return format.NewDNS1123SubdomainRule() },
+		RuleK8sQualifiedName: func(_ []string) (contract.Rule, error) { return format.NewK8sQualifiedNameRule() },
+		RuleARN:              format.NewARNRule,
+		RuleS3Bucket:         func(_ []string) (contract.Rule, error) { return format.NewS3BucketRule() },
+		RuleLocale:           format.NewLocaleRule,
+		RuleMimeType:         format.NewMimeTypeRule,
+		RuleHeaderName:       func(_ []string) (contract.Rule, error) { return format.NewHeaderNameRule() },
+		RuleHeaderValue:      func(_ []string) (contract.Rule, error) { return format.NewHeaderValueRule() },
+		RuleCookieName:       func(_ []string) (contract.Rule, error) { return format.NewCookieNameRule() },
+		RuleCookieValue:      func(_ []string) (contract.Rule, error) { return format.NewCookieValueRule() },
+	}}
+}
+
+// Network returns the email, email_not_disposable and url rules
+func Network() Module {
+	return Module{Name: ModuleNetwork, Rules: map[string]contract.RuleCreator{
+		RuleEmail:              func(p []string) (contract.Rule, error) { return format.NewEmailRule(p) },
+		RuleEmailNotDisposable: func(_ []string) (contract.Rule, error) { return format.NewEmailNotDisposableRule() },
+		RuleURL:                func(p []string) (contract.Rule, error) { return format.NewURLRule(p) },
+	}}
+}
+
+// Finance returns the money and decimal rules of amounts
+func Finance() Module {
+	return Module{Name: ModuleFinance, Rules: map[string]contract.RuleCreator{
+		RuleDecimal: numeric.NewDecimalRule,
+		RuleMoney:   numeric.NewMoneyRule,
+	}}
+}
+
+// Files returns the file, image, mimes, extensions and filename rules of uploads. Builds with the
+// scgcore or tinygo tag only have extensions and filename, which also check plain filenames.
+func Files() Module {
+	return Module{Name: ModuleFiles, Rules: fileRules()}
+}
+
+// Geo returns the geographic rules. It has none yet and is part of All, so geographic rules added
+// later are opted into the same way.
+func Geo() Module {
+	return Module{Name: ModuleGeo, Rules: map[string]contract.RuleCreator{}}
+}
@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestAll_ModulesAreDisjoint(t *testing.T) {
+	seen := make(map[string]string)
+	for _, module := range All() {
+		for name := range module.Rules {
+			if owner, ok := seen[name]; ok {
+				t.Fatalf("rule %s is in both the %s and %s modules", name, owner, module.Name)
+			}
+			seen[name] = module.Name
+		}
+	}
+	if len(seen) != NewRuleRegistry().Count() {
+		t.Fatalf("expected the modules to hold every default rule, got %d of %d", len(seen), NewRuleRegistry().Count())
+	}
+}
+
+func TestNewModuleRegistry(t *testing.T) {
+	reg := NewModuleRegistry([]Module{Strings(), Network()}, WithExcludeRules(RuleURL))
+	names := reg.List()
+	sort.Strings(names)
+	if len(names) != len(Strings().Rules)+len(Network().Rules)-1 {
+		t.Fatalf("unexpected rules: %v", names)
+	}
+	if !reg.Has(RuleEmail) || !reg.Has(RuleSlug) {
+		t.Fatalf("expected the module rules, got %v", names)
+	}
+	if reg.Has(RuleURL) || reg.Has(RuleRequired) {
+		t.Fatalf("expected url to be excluded and core rules to be absent, got %v", names)
+	}
+}
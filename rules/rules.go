@@ -4,20 +4,8 @@ package rules
 import (
 	"fmt"
 
-	"github.com/next-trace/scg-validator/registry/rules"
-	"github.com/next-trace/scg-validator/rules/authentication"
-	"github.com/next-trace/scg-validator/rules/file"
-	"github.com/next-trace/scg-validator/rules/format"
-	dateRules "github.com/next-trace/scg-validator/rules/types/date"
-	stringRules "github.com/next-trace/scg-validator/rules/types/string"
-
 	"github.com/next-trace/scg-validator/contract"
-	"github.com/next-trace/scg-validator/rules/acceptance"
-	"github.com/next-trace/scg-validator/rules/comparison"
-	"github.com/next-trace/scg-validator/rules/conditional"
-	"github.com/next-trace/scg-validator/rules/control"
-	"github.com/next-trace/scg-validator/rules/types/boolean"
-	"github.com/next-trace/scg-validator/rules/types/numeric"
+	"github.com/next-trace/scg-validator/registry/rules"
 )
 
 // Rule names constants to avoid magic strings
@@ -34,17 +22,19 @@ const (
 	RuleBoolean = "boolean"
 
 	// Comparison Rules
-	RuleMin       = "min"
-	RuleMax       = "max"
-	RuleSize      = "size"
-	RuleBetween   = "between"
-	RuleGt        = "gt"
-	RuleLt        = "lt"
-	RuleGte       = "gte"
-	RuleLte       = "lte"
-	RuleSame      = "same"
-	RuleDifferent = "different"
-	RuleConfirmed = "confirmed"
+	RuleMin         = "min"
+	RuleMax         = "max"
+	RuleSize        = "size"
+	RuleBetween     = "between"
+	RuleGt          = "gt"
+	RuleLt          = "lt"
+	RuleGte         = "gte"
+	RuleLte         = "lte"
+	RuleSame        = "same"
+	RuleDifferent   = "different"
+	RuleConfirmed   = "confirmed"
+	RuleConfirmedBy = "confirmed_by"
+	RuleExpr        = "expr"
 
 	// Conditional Rules
 	RuleRequired           = "required"
@@ -54,12 +44,14 @@ const (
 	RuleRequiredWithout    = "required_without"
 	RuleRequiredWithAll    = "required_with_all"
 	RuleRequiredWithoutAll = "required_without_all"
+	RuleRequiredTogether   = "required_together"
 
 	// Prohibited Rules
 	RuleProhibited       = "prohibited"
 	RuleProhibitedIf     = "prohibited_if"
 	RuleProhibitedUnless = "prohibited_unless"
 	RuleProhibits        = "prohibits"
+	RuleProhibitedKeys   = "prohibited_keys"
 
 	// Control Rules
 	RuleBail      = "bail"
@@ -81,6 +73,7 @@ const (
 	RuleInteger    = "integer"
 	RuleDecimal    = "decimal"
 	RuleMultipleOf = "multiple_of"
+	RuleMoney      = "money"
 
 	// String Rules
 	RuleAlpha     = "alpha"
@@ -92,15 +85,20 @@ const (
 	RuleActiveURL = "active_url"
 	RuleJSON      = "json"
 	RuleRegex     = "regex"
+	RuleIn        = "in"
+	RuleNotIn     = "not_in"
+	RuleDistinct  = "distinct"
 	RuleIP        = "ip"
 	RuleIPv4      = "ipv4"
 	RuleIPv6      = "ipv6"
 	RuleMAC       = "mac"
 
 	// File Validation Rules
-	RuleFile  = "file"
-	RuleImage = "image"
-	RuleMimes = "mimes"
+	RuleFile       = "file"
+	RuleImage      = "image"
+	RuleMimes      = "mimes"
+	RuleExtensions = "extensions"
+	RuleFilename   = "filename"
 
 	// Special String Rules
 	RuleLowercase       = "lowercase"
@@ -110,9 +108,54 @@ const (
 	RuleSlug            = "slug"
 	RuleDoesntStartWith = "doesnt_start_with"
 	RuleDoesntEndWith   = "doesnt_end_with"
+	RuleNotInWordlist   = "not_in_wordlist"
 
 	// Auth Rules
 	RuleCurrentPassword = "current_password"
+
+	// Database Rules
+	RuleExists = "exists"
+	RuleUnique = "unique"
+
+	// Email Rules
+	RuleEmailNotDisposable = "email_not_disposable"
+
+	// Product Code Rules
+	RuleUPCA   = "upc_a"
+	RuleGTIN14 = "gtin14"
+
+	// Color Rules
+	RuleColor = "color"
+
+	// Hash Rules
+	RuleMD5    = "md5"
+	RuleSHA1   = "sha1"
+	RuleSHA256 = "sha256"
+	RuleSHA512 = "sha512"
+	RuleHash   = "hash"
+
+	// Git Rules
+	RuleGitSHA = "git_sha"
+	RuleGitRef = "git_ref"
+
+	// Kubernetes Name Rules
+	RuleDNS1123Label     = "dns1123_label"
+	RuleDNS1123Subdomain = "dns1123_subdomain"
+	RuleK8sQualifiedName = "k8s_qualified_name"
+
+	// Cloud Resource Rules
+	RuleARN      = "arn"
+	RuleS3Bucket = "s3_bucket"
+
+	// Localization Rules
+	RuleLocale = "locale"
+
+	// HTTP Rules
+	RuleMimeType    = "mime_type"
+	RuleHeaderName  = "header_name"
+	RuleHeaderValue = "header_value"
+	RuleCookieName  = "cookie_name"
+	RuleCookieValue = "cookie_value"
 )
 
 // WithCustomRule adds a custom rule to the registry
@@ -161,6 +204,12 @@ func WithIncludeOnly(ruleNames ...string) rules.Option {
 
 // NewRuleRegistry creates a new rule registry with all default rules and applies options
 func NewRuleRegistry(options ...rules.Option) contract.Registry {
+	return NewModuleRegistry(All(), options...)
+}
+
+// NewModuleRegistry creates a new rule registry with the rules of modules and applies options.
+// Rules of modules that are not passed anywhere in a program are left out of its binary.
+func NewModuleRegistry(modules []Module, options ...rules.Option) contract.Registry {
 	reg := rules.NewRegistry()
 
 	// Create config and apply options
@@ -169,8 +218,8 @@ func NewRuleRegistry(options ...rules.Option) contract.Registry {
 		option(config)
 	}
 
-	// Register all default rules using constants with filtering
-	if err := registerDefaultRules(reg, config); err != nil {
+	// Register the module rules with filtering
+	if err := registerModules(reg, modules, config); err != nil {
 		panic(fmt.Sprintf("failed to register default rules: %v", err))
 	}
 
@@ -182,88 +231,13 @@ func NewRuleRegistry(options ...rules.Option) contract.Registry {
 	return reg
 }
 
-// registerDefaultRules registers all available rules from different packages
-func registerDefaultRules(reg contract.Registry, config *contract.Config) error {
-	rules := map[string]contract.RuleCreator{
-		// Acceptance rules
-		RuleAccepted:   func(_ []string) (contract.Rule, error) { return acceptance.NewAcceptedRule() },
-		RuleDeclined:   func(_ []string) (contract.Rule, error) { return acceptance.NewDeclinedRule() },
-		RuleAcceptedIf: acceptance.NewAcceptedIfRule,
-		RuleDeclinedIf: acceptance.NewDeclinedIfRule,
-
-		// Boolean rules
-		RuleBoolean: func(_ []string) (contract.Rule, error) { return boolean.NewBooleanRule() },
-
-		// Comparison rules
-		RuleMin:       comparison.NewMinRule,
-		RuleMax:       comparison.NewMaxRule,
-		RuleSize:      comparison.NewSizeRule,
-		RuleBetween:   comparison.NewBetweenRule,
-		RuleGt:        comparison.NewGtRule,
-		RuleLt:        comparison.NewLtRule,
-		RuleGte:       comparison.NewGteRule,
-		RuleLte:       comparison.NewLteRule,
-		RuleSame:      comparison.NewSameRule,
-		RuleDifferent: comparison.NewDifferentRule,
-		RuleConfirmed: func(_ []string) (contract.Rule, error) { return comparison.NewConfirmedRule() },
-
-		// Conditional rules
-		RuleRequired:           func(_ []string) (contract.Rule, error) { return conditional.NewRequiredRule() },
-		RuleRequiredIf:         conditional.NewRequiredIfRule,
-		RuleRequiredUnless:     conditional.NewRequiredUnlessRule,
-		RuleRequiredWith:       conditional.NewRequiredWithRule,
-		RuleRequiredWithout:    conditional.NewRequiredWithoutRule,
-		RuleRequiredWithAll:    conditional.NewRequiredWithAllRule,
-		RuleRequiredWithoutAll: conditional.NewRequiredWithoutAllRule,
-
-		// Prohibited rules
-		RuleProhibited:       func(_ []string) (contract.Rule, error) { return conditional.NewProhibitedRule() },
-		RuleProhibitedIf:     conditional.NewProhibitedIfRule,
-		RuleProhibitedUnless: conditional.NewProhibitedUnlessRule,
-		RuleProhibits:        conditional.NewProhibitsRule,
-
-		// Control rules
-		RuleBail:      func(_ []string) (contract.Rule, error) { return control.NewBailRule() },
-		RuleFilled:    func(_ []string) (contract.Rule, error) { return control.NewFilledRule() },
-		RulePresent:   func(_ []string) (contract.Rule, error) { return control.NewPresentRule() },
-		RuleSometimes: func(_ []string) (contract.Rule, error) { return control.NewSometimesRule() },
-		RuleNullable:  func(_ []string) (contract.Rule, error) { return control.NewNullableRule() },
-
-		// Date rules
-		RuleAfter:         dateRules.NewAfterRule,
-		RuleBefore:        dateRules.NewBeforeRule,
-		RuleBeforeOrEqual: dateRules.NewBeforeOrEqualRule,
-		RuleAfterOrEqual:  dateRules.NewAfterOrEqualRule,
-
-		// Numeric rules
-		RuleNumeric:    func(_ []string) (contract.Rule, error) { return numeric.NewNumericRule() },
-		RuleInteger:    func(_ []string) (contract.Rule, error) { return numeric.NewIntegerRule() },
-		RuleDecimal:    numeric.NewDecimalRule,
-		RuleMultipleOf: numeric.NewMultipleOfRule,
-
-		// String rules
-		RuleAlpha:           func(p []string) (contract.Rule, error) { return stringRules.NewAlphaRule(p) },
-		RuleAlphaNum:        func(p []string) (contract.Rule, error) { return stringRules.NewAlphaNumRule(p) },
-		RuleAlphaDash:       func(p []string) (contract.Rule, error) { return stringRules.NewAlphaDashRule(p) },
-		RuleLowercase:       func(_ []string) (contract.Rule, error) { return stringRules.NewLowercaseRule() },
-		RuleUppercase:       func(_ []string) (contract.Rule, error) { return stringRules.NewUppercaseRule() },
-		RuleASCII:           func(_ []string) (contract.Rule, error) { return stringRules.NewASCIIRule() },
-		RuleUlid:            func(_ []string) (contract.Rule, error) { return stringRules.NewUlidRule() },
-		RuleSlug:            func(_ []string) (contract.Rule, error) { return stringRules.NewSlugRule() },
-		RuleDoesntStartWith: stringRules.NewDoesntStartWithRule,
-		RuleDoesntEndWith:   func(p []string) (contract.Rule, error) { return stringRules.NewDoesntEndWithRule(p) },
-
-		// Format rules
-		RuleEmail: func(p []string) (contract.Rule, error) { return format.NewEmailRule(p) },
-		RuleURL:   func(p []string) (contract.Rule, error) { return format.NewURLRule(p) },
-
-		// File rules
-		RuleFile:  func(_ []string) (contract.Rule, error) { return file.NewFileRule() },
-		RuleImage: func(_ []string) (contract.Rule, error) { return file.NewImageRule() },
-		RuleMimes: file.NewMimesRule,
-
-		// Auth rules
-		RuleCurrentPassword: func(_ []string) (contract.Rule, error) { return authentication.NewCurrentPasswordRule() },
+// registerModules registers the rules of modules
+func registerModules(reg contract.Registry, modules []Module, config *contract.Config) error {
+	rules := make(map[string]contract.RuleCreator)
+	for _, module := range modules {
+		for name, creator := range module.Rules {
+			rules[name] = creator
+		}
 	}
 
 	// Apply filtering based on config
@@ -0,0 +1,17 @@
+//go:build scgcore || tinygo
+
+package rules
+
+import (
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/file"
+)
+
+// fileRules returns the file rules of the core build: file, image and mimes depend on
+// mime/multipart, extensions and filename only check filename strings here
+func fileRules() map[string]contract.RuleCreator {
+	return map[string]contract.RuleCreator{
+		RuleExtensions: file.NewExtensionsRule,
+		RuleFilename:   file.NewFilenameRule,
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !scgcore && !tinygo
+
+package rules
+
+import (
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/file"
+)
+
+// fileRules returns the file rules, which validate multipart uploads and filenames
+func fileRules() map[string]contract.RuleCreator {
+	return map[string]contract.RuleCreator{
+		RuleFile:       func(_ []string) (contract.Rule, error) { return file.NewFileRule() },
+		RuleImage:      func(_ []string) (contract.Rule, error) { return file.NewImageRule() },
+		RuleMimes:      file.NewMimesRule,
+		RuleExtensions: file.NewExtensionsRule,
+		RuleFilename:   file.NewFilenameRule,
+	}
+}
@@ -0,0 +1,79 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	distinctRuleName               = "distinct"
+	distinctRuleDefaultMessage     = "the :attribute field has a duplicate value"
+	distinctRuleInvalidTypeMessage = "the :attribute must be a slice or array type"
+)
+
+// DistinctRule validates that a list holds no duplicate elements. Elements are compared
+// with common.ValuesEqual, so registered comparators decide equality of domain types.
+// Other elements are grouped by their %v representation, keeping large lists linear.
+type DistinctRule struct {
+	common.BaseRule
+}
+
+// NewDistinctRule creates a new DistinctRule instance.
+func NewDistinctRule(parameters []string, options ...common.RuleOption) (contract.Rule, error) {
+	return &DistinctRule{
+		BaseRule: common.NewBaseRule(distinctRuleName, distinctRuleDefaultMessage, parameters, options...),
+	}, nil
+}
+
+// Validate checks that no two elements of the value are equal.
+func (r *DistinctRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+
+	value := ctx.Value()
+	if value == nil {
+		return errors.New(distinctRuleInvalidTypeMessage)
+	}
+
+	list := reflect.ValueOf(value)
+	if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+		return errors.New(distinctRuleInvalidTypeMessage)
+	}
+
+	seen := make(map[string]bool, list.Len())
+	var compared []int
+	for i := 0; i < list.Len(); i++ {
+		element := list.Index(i).Interface()
+		if _, ok := registry.FindComparator(element); ok {
+			compared = append(compared, i)
+			continue
+		}
+		key := fmt.Sprintf("%v", element)
+		if seen[key] {
+			return errors.New(distinctRuleDefaultMessage)
+		}
+		seen[key] = true
+	}
+
+	// Elements with a comparator are compared with every other element, as only it knows
+	// which values equal them
+	for _, i := range compared {
+		for j := 0; j < list.Len(); j++ {
+			if j != i && common.ValuesEqual(list.Index(i).Interface(), list.Index(j).Interface()) {
+				return errors.New(distinctRuleDefaultMessage)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *DistinctRule) Name() string {
+	return distinctRuleName
+}
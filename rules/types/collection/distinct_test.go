@@ -0,0 +1,73 @@
+package collection_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/types/collection"
+)
+
+func TestDistinctRule(t *testing.T) {
+	t.Parallel()
+
+	rule, err := collection.NewDistinctRule(nil)
+	if err != nil {
+		t.Fatalf("failed to create DistinctRule: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		value      any
+		shouldPass bool
+	}{
+		{"valid - distinct strings", []string{"a", "b", "c"}, true},
+		{"valid - empty slice", []int{}, true},
+		{"valid - distinct mixed", []any{1, "2", 3.5}, true},
+		{"invalid - duplicate strings", []string{"a", "b", "a"}, false},
+		{"invalid - duplicate array ints", [3]int{1, 2, 2}, false},
+		{"invalid - same representation", []any{1, "1"}, false},
+		{"invalid - not a list", "abc", false},
+		{"invalid - nil", nil, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := rule.Validate(contract.NewValidationContext("tags", tc.value, nil, nil))
+			if tc.shouldPass && err != nil {
+				t.Errorf("expected pass for %v, got %v", tc.value, err)
+			}
+			if !tc.shouldPass && err == nil {
+				t.Errorf("expected failure for %v", tc.value)
+			}
+		})
+	}
+}
+
+func TestDistinctRule_LargeList(t *testing.T) {
+	t.Parallel()
+
+	rule, err := collection.NewDistinctRule(nil)
+	if err != nil {
+		t.Fatalf("failed to create DistinctRule: %v", err)
+	}
+
+	items := make([]string, 100000)
+	for i := range items {
+		items[i] = "item-" + strconv.Itoa(i)
+	}
+	start := time.Now()
+	if err := rule.Validate(contract.NewValidationContext("tags", items, nil, nil)); err != nil {
+		t.Fatalf("expected distinct items to pass, got %v", err)
+	}
+	items[len(items)-1] = items[0]
+	if err := rule.Validate(contract.NewValidationContext("tags", items, nil, nil)); err == nil {
+		t.Fatal("expected a duplicate at the end to fail")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected a large list to be checked in linear time, took %v", elapsed)
+	}
+}
@@ -0,0 +1,75 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+)
+
+const (
+	prohibitedKeysRuleName               = "prohibited_keys"
+	prohibitedKeysRuleDefaultMessage     = "the :attribute field must not contain the keys :values"
+	prohibitedKeysRuleInvalidTypeMessage = "the :attribute must be a map type"
+	prohibitedKeysRuleParamErrorMessage  = "prohibited_keys rule requires at least one key"
+)
+
+// ProhibitedKeysRule validates that a map holds none of the listed keys, e.g. to reject
+// "admin" or "role" entries smuggled into a nested settings object. Keys match case-insensitively,
+// as encoding/json binds {"Admin": true} to an Admin field too.
+// Usage: prohibited_keys:admin,role
+type ProhibitedKeysRule struct {
+	common.BaseRule
+	keys []string
+}
+
+// NewProhibitedKeysRule creates a new ProhibitedKeysRule instance.
+func NewProhibitedKeysRule(parameters []string, options ...common.RuleOption) (contract.Rule, error) {
+	if len(parameters) == 0 {
+		return nil, errors.New(prohibitedKeysRuleParamErrorMessage)
+	}
+	return &ProhibitedKeysRule{
+		BaseRule: common.NewBaseRule(
+			prohibitedKeysRuleName, prohibitedKeysRuleDefaultMessage, parameters, options...,
+		),
+		keys: parameters,
+	}, nil
+}
+
+// Validate checks that no key of the map is prohibited. An absent value holds no keys and passes.
+func (r *ProhibitedKeysRule) Validate(ctx contract.RuleContext) error {
+	value := ctx.Value()
+	if value == nil || r.ShouldSkipValidation(value) {
+		return nil
+	}
+
+	m := reflect.ValueOf(value)
+	if m.Kind() != reflect.Map {
+		return errors.New(prohibitedKeysRuleInvalidTypeMessage)
+	}
+
+	for _, key := range m.MapKeys() {
+		if r.prohibited(fmt.Sprint(key.Interface())) {
+			return errors.New(prohibitedKeysRuleDefaultMessage)
+		}
+	}
+
+	return nil
+}
+
+// prohibited reports whether key equals one of the prohibited keys, ignoring case
+func (r *ProhibitedKeysRule) prohibited(key string) bool {
+	for _, prohibited := range r.keys {
+		if strings.EqualFold(key, prohibited) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *ProhibitedKeysRule) Name() string {
+	return prohibitedKeysRuleName
+}
@@ -0,0 +1,55 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/types/collection"
+)
+
+func TestProhibitedKeysRule(t *testing.T) {
+	t.Parallel()
+
+	rule, err := collection.NewProhibitedKeysRule([]string{"admin", "role"})
+	if err != nil {
+		t.Fatalf("failed to create ProhibitedKeysRule: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		value      any
+		shouldPass bool
+	}{
+		{"valid - allowed keys", map[string]any{"theme": "dark", "lang": "en"}, true},
+		{"valid - empty map", map[string]any{}, true},
+		{"valid - typed map", map[string]bool{"notifications": true}, true},
+		{"valid - nil", nil, true},
+
+		{"invalid - prohibited key", map[string]any{"theme": "dark", "role": "owner"}, false},
+		{"invalid - prohibited key with nil value", map[string]any{"admin": nil}, false},
+		{"invalid - typed map", map[string]bool{"admin": true}, false},
+		{"invalid - different case", map[string]any{"Admin": true}, false},
+		{"invalid - upper case", map[string]bool{"ROLE": true}, false},
+		{"invalid - not a map", []string{"admin"}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := contract.NewValidationContext("settings", tc.value, []string{"admin", "role"}, nil)
+			err := rule.Validate(ctx)
+
+			if tc.shouldPass && err != nil {
+				t.Errorf("expected pass for value %#v, got error: %v", tc.value, err)
+			}
+			if !tc.shouldPass && err == nil {
+				t.Errorf("expected failure for value %#v, but got none", tc.value)
+			}
+		})
+	}
+
+	if _, err := collection.NewProhibitedKeysRule(nil); err == nil {
+		t.Error("expected an error without keys")
+	}
+}
@@ -3,7 +3,6 @@ package date
 import (
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -21,32 +20,25 @@ const (
 // AfterRule validates that a value is a date after a given reference date.
 type AfterRule struct {
 	common.BaseRule
-	comparisonDate time.Time
-	format         string
+	reference dateReference
 }
 
 // NewAfterRule constructs a new AfterRule.
-// parameters[0] = comparison date string (required)
-// parameters[1] = format (optional, defaults to RFC3339)
+// parameters[0] = comparison date string or field name (required)
+// parameters[1:] = tolerance such as "+1h" and/or format (optional, format defaults to RFC3339)
 func NewAfterRule(parameters []string) (contract.Rule, error) {
 	if len(parameters) == 0 {
 		return nil, errors.New(afterRuleMissingParamError)
 	}
 
-	format := time.RFC3339
-	if len(parameters) > 1 {
-		format = parameters[1]
-	}
-
-	parsedDate, err := time.Parse(format, parameters[0])
+	reference, err := parseDateReference(parameters)
 	if err != nil {
 		return nil, fmt.Errorf(afterRuleInvalidFormatError, err)
 	}
 
 	return &AfterRule{
-		BaseRule:       common.NewBaseRule(afterRuleName, afterRuleDefaultTemplate, parameters),
-		comparisonDate: parsedDate,
-		format:         format,
+		BaseRule:  common.NewBaseRule(afterRuleName, afterRuleDefaultTemplate, parameters),
+		reference: reference,
 	}, nil
 }
 
@@ -56,17 +48,17 @@ func (r *AfterRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	str, ok := ctx.Value().(string)
-	if !ok {
+	parsedValue, err := parseDateValue(ctx.Value(), r.reference.format)
+	if err != nil {
 		return errors.New(afterRuleValueMustBeDateError)
 	}
 
-	parsedValue, err := time.Parse(r.format, str)
+	comparisonDate, err := r.reference.resolve(ctx.Data())
 	if err != nil {
-		return errors.New(afterRuleValueMustBeDateError)
+		return errors.New(afterRuleComparisonFailedError)
 	}
 
-	if !parsedValue.After(r.comparisonDate) {
+	if !parsedValue.After(comparisonDate) {
 		return errors.New(afterRuleComparisonFailedError)
 	}
 
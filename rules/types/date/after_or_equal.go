@@ -19,7 +19,7 @@ type AfterOrEqualRule struct {
 }
 
 // NewAfterOrEqualRule creates a new rule instance.
-// parameters[0] = reference date, parameters[1] = optional format (defaults to RFC3339)
+// parameters[0] = reference date or field name, parameters[1:] = optional tolerance and/or format
 func NewAfterOrEqualRule(parameters []string) (contract.Rule, error) {
 	base, err := NewBaseDateComparisonRule(
 		afterOrEqualRuleName,
@@ -29,6 +29,8 @@ func TestAfterRule(t *testing.T) {
 		{"valid - after", tomorrow, true},
 		{"invalid - before", yesterday, false},
 		{"invalid - equal", nowStr, false},
+		{"valid - time.Time after", now.AddDate(0, 0, 1), true},
+		{"invalid - time.Time before", now.AddDate(0, 0, -1), false},
 		{"invalid - int", 123, false},
 		{"invalid - empty string", "", false},
 		{"invalid - malformed date", "2023-13-99", false},
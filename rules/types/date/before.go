@@ -3,7 +3,6 @@ package date
 import (
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -21,49 +20,41 @@ const (
 // BeforeRule checks if the given value is before a specific comparison date.
 type BeforeRule struct {
 	common.BaseRule
-	comparisonDate time.Time
-	format         string
+	reference dateReference
 }
 
 // NewBeforeRule constructs a new BeforeRule.
-// parameters[0] = comparison date string
-// parameters[1] = optional format (defaults to RFC3339)
+// parameters[0] = comparison date string or field name
+// parameters[1:] = optional tolerance such as "-2d" and/or format (defaults to RFC3339)
 func NewBeforeRule(parameters []string) (contract.Rule, error) {
 	if len(parameters) == 0 {
 		return nil, errors.New(beforeRuleMissingParamError)
 	}
 
-	format := time.RFC3339
-	if len(parameters) > 1 {
-		format = parameters[1]
-	}
-
-	refDate, err := time.Parse(format, parameters[0])
+	reference, err := parseDateReference(parameters)
 	if err != nil {
 		return nil, fmt.Errorf(beforeRuleInvalidFormatError, err)
 	}
 
 	return &BeforeRule{
-		BaseRule:       common.NewBaseRule(beforeRuleName, beforeRuleDefaultTemplate, parameters),
-		comparisonDate: refDate,
-		format:         format,
+		BaseRule:  common.NewBaseRule(beforeRuleName, beforeRuleDefaultTemplate, parameters),
+		reference: reference,
 	}, nil
 }
 
-// Validate ensures the value is a string and a date before the comparison date.
+// Validate ensures the value is a date string or time.Time before the comparison date.
 func (r *BeforeRule) Validate(ctx contract.RuleContext) error {
-	raw := ctx.Value()
-	strVal, ok := raw.(string)
-	if !ok {
+	parsed, err := parseDateValue(ctx.Value(), r.reference.format)
+	if err != nil {
 		return errors.New(beforeRuleInvalidTypeError)
 	}
 
-	parsed, err := time.Parse(r.format, strVal)
+	comparisonDate, err := r.reference.resolve(ctx.Data())
 	if err != nil {
-		return errors.New(beforeRuleInvalidTypeError)
+		return errors.New(beforeRuleValidationFailedMessage)
 	}
 
-	if parsed.Before(r.comparisonDate) {
+	if parsed.Before(comparisonDate) {
 		return nil
 	}
 
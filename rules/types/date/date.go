@@ -34,18 +34,16 @@ func NewDateRule(parameters []string) (contract.Rule, error) {
 	}, nil
 }
 
-// Validate checks if the context value is a string and a valid date in the specified format.
+// Validate checks if the context value is a time.Time or a valid date string in the specified format.
 func (r *Rule) Validate(ctx contract.RuleContext) error {
 	if r.ShouldSkipValidation(ctx.Value()) {
 		return nil
 	}
 
-	strVal, ok := ctx.Value().(string)
-	if !ok {
-		return errors.New(dateRuleDefaultMsg)
-	}
-
-	if _, err := time.Parse(r.format, strVal); err != nil {
+	if _, err := parseDateValue(ctx.Value(), r.format); err != nil {
+		if errors.Is(err, errNotDateValue) {
+			return errors.New(dateRuleDefaultMsg)
+		}
 		return fmt.Errorf(dateRuleParseErrMsg, err)
 	}
 
@@ -23,8 +23,7 @@ const (
 // BaseDateComparisonRule provides common functionality for date comparison rules
 type BaseDateComparisonRule struct {
 	common.BaseRule
-	comparisonDate     time.Time
-	format             string
+	reference          dateReference
 	comparisonType     ComparisonType
 	ruleName           string
 	typeErrorMsg       string
@@ -41,20 +40,14 @@ func NewBaseDateComparisonRule(
 		return nil, errors.New(missingParamError)
 	}
 
-	format := time.RFC3339
-	if len(parameters) > 1 {
-		format = parameters[1]
-	}
-
-	parsedDate, err := time.Parse(format, parameters[0])
+	reference, err := parseDateReference(parameters)
 	if err != nil {
 		return nil, fmt.Errorf(parseError, err)
 	}
 
 	return &BaseDateComparisonRule{
 		BaseRule:           common.NewBaseRule(ruleName, defaultTemplate, parameters),
-		comparisonDate:     parsedDate,
-		format:             format,
+		reference:          reference,
 		comparisonType:     comparisonType,
 		ruleName:           ruleName,
 		typeErrorMsg:       typeError,
@@ -68,36 +61,57 @@ func (r *BaseDateComparisonRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	val, ok := ctx.Value().(string)
-	if !ok {
+	parsedVal, err := parseDateValue(ctx.Value(), r.reference.format)
+	if err != nil {
 		return errors.New(r.typeErrorMsg)
 	}
 
-	parsedVal, err := time.Parse(r.format, val)
+	comparisonDate, err := r.reference.resolve(ctx.Data())
 	if err != nil {
-		return errors.New(r.typeErrorMsg)
+		return errors.New(r.validationErrorMsg)
 	}
 
-	if r.compareDate(parsedVal) {
+	if r.compareDate(parsedVal, comparisonDate) {
 		return nil
 	}
 
 	return errors.New(r.validationErrorMsg)
 }
 
+// errNotDateValue is returned by parseDateValue for values that are neither strings nor time.Time
+var errNotDateValue = errors.New("value is not a date")
+
+// parseDateValue returns value as a time.Time. time.Time values are used as-is;
+// strings are parsed with format.
+func parseDateValue(value any, format string) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, errNotDateValue
+		}
+		return *v, nil
+	case string:
+		return time.Parse(format, v)
+	default:
+		return time.Time{}, errNotDateValue
+	}
+}
+
 // compareDate performs the actual date comparison based on the comparison type
-func (r *BaseDateComparisonRule) compareDate(value time.Time) bool {
+func (r *BaseDateComparisonRule) compareDate(value, comparisonDate time.Time) bool {
 	switch r.comparisonType {
 	case ComparisonAfter:
-		return value.After(r.comparisonDate)
+		return value.After(comparisonDate)
 	case ComparisonAfterOrEqual:
-		return value.After(r.comparisonDate) || value.Equal(r.comparisonDate)
+		return value.After(comparisonDate) || value.Equal(comparisonDate)
 	case ComparisonBefore:
-		return value.Before(r.comparisonDate)
+		return value.Before(comparisonDate)
 	case ComparisonBeforeOrEqual:
-		return value.Before(r.comparisonDate) || value.Equal(r.comparisonDate)
+		return value.Before(comparisonDate) || value.Equal(comparisonDate)
 	case ComparisonEqual:
-		return value.Equal(r.comparisonDate)
+		return value.Equal(comparisonDate)
 	default:
 		return false
 	}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -20,8 +19,7 @@ const (
 // EqualsRule validates that a value is a date equal to a target date.
 type EqualsRule struct {
 	common.BaseRule
-	comparisonDate time.Time
-	format         string
+	reference dateReference
 }
 
 // NewDateEqualsRule creates a new EqualsRule with the given parameters.
@@ -30,21 +28,14 @@ func NewDateEqualsRule(parameters []string) (contract.Rule, error) {
 		return nil, errors.New(dateEqualsMissingParamMsg)
 	}
 
-	dateStr := parameters[0]
-	format := time.RFC3339
-	if len(parameters) > 1 && parameters[1] != "" {
-		format = parameters[1]
-	}
-
-	parsedDate, err := time.Parse(format, dateStr)
+	reference, err := parseDateReference(parameters)
 	if err != nil {
 		return nil, fmt.Errorf(dateEqualsInvalidFormatError, err)
 	}
 
 	return &EqualsRule{
-		BaseRule:       common.NewBaseRule(dateEqualsRuleName, dateEqualsDefaultMsg, parameters),
-		comparisonDate: parsedDate,
-		format:         format,
+		BaseRule:  common.NewBaseRule(dateEqualsRuleName, dateEqualsDefaultMsg, parameters),
+		reference: reference,
 	}, nil
 }
 
@@ -54,17 +45,16 @@ func (r *EqualsRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	valueStr, ok := ctx.Value().(string)
-	if !ok {
+	parsedValue, err := parseDateValue(ctx.Value(), r.reference.format)
+	if errors.Is(err, errNotDateValue) {
 		return errors.New(dateEqualsDefaultMsg)
 	}
-
-	parsedValue, err := time.Parse(r.format, valueStr)
 	if err != nil {
 		return fmt.Errorf(dateEqualsInvalidFormatError, err)
 	}
 
-	if parsedValue.Equal(r.comparisonDate) {
+	comparisonDate, err := r.reference.resolve(ctx.Data())
+	if err == nil && parsedValue.Equal(comparisonDate) {
 		return nil
 	}
 
@@ -0,0 +1,111 @@
+package date
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// errMissingReference is returned when a referenced date field is absent or not a date
+var errMissingReference = errors.New("the comparison date is not available")
+
+// relativeDateKeywords are relative dates other validators accept ("after:tomorrow"). They are
+// not supported and would otherwise be taken for the names of fields that do not exist.
+var relativeDateKeywords = map[string]bool{
+	"now": true, "today": true, "tomorrow": true, "yesterday": true, "midnight": true, "noon": true,
+}
+
+// fieldReferencePattern matches parameters naming another field (e.g. "start_date", "booking.ends_at")
+var fieldReferencePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z0-9_*]+)*$`)
+
+// dateReference is the date a value is compared against: a literal date or the date
+// held by another field, shifted by an optional tolerance ("after:start_date,+1h").
+type dateReference struct {
+	date      time.Time
+	field     string
+	tolerance time.Duration
+	format    string
+}
+
+// parseDateReference reads the date comparison parameters:
+// parameters[0] = reference date, or the name of the field holding it (required)
+// parameters[1:] = optional tolerance ("+1h", "-2d", "+1w", "+90m") and/or format (defaults to RFC3339)
+// For literal dates that fail to parse, the time.Parse error is returned; relative dates such as
+// "tomorrow" are rejected rather than taken for field names.
+func parseDateReference(parameters []string) (dateReference, error) {
+	ref := dateReference{format: time.RFC3339}
+	for _, param := range parameters[1:] {
+		if tolerance, ok := parseTolerance(param); ok {
+			ref.tolerance = tolerance
+			continue
+		}
+		if param != "" {
+			ref.format = param
+		}
+	}
+
+	parsed, err := time.Parse(ref.format, parameters[0])
+	if err == nil {
+		ref.date = parsed
+		return ref, nil
+	}
+	if relativeDateKeywords[strings.ToLower(parameters[0])] {
+		return dateReference{}, fmt.Errorf("relative date %q is not supported, use a date or a field name", parameters[0])
+	}
+	if fieldReferencePattern.MatchString(parameters[0]) {
+		ref.field = parameters[0]
+		return ref, nil
+	}
+	return dateReference{}, err
+}
+
+// resolve returns the reference date for the data being validated, tolerance included
+func (d dateReference) resolve(data map[string]any) (time.Time, error) {
+	if d.field == "" {
+		return d.date.Add(d.tolerance), nil
+	}
+
+	value, exists := utils.GetPath(data, d.field)
+	if !exists {
+		return time.Time{}, errMissingReference
+	}
+	parsed, err := parseDateValue(utils.Unwrap(value), d.format)
+	if err != nil {
+		return time.Time{}, errMissingReference
+	}
+	return parsed.Add(d.tolerance), nil
+}
+
+// parseTolerance parses a signed offset. Besides time.ParseDuration units, "d" (24h)
+// and "w" (7 days) are accepted as single-unit offsets.
+func parseTolerance(param string) (time.Duration, bool) {
+	if !strings.HasPrefix(param, "+") && !strings.HasPrefix(param, "-") {
+		return 0, false
+	}
+
+	unit := time.Duration(0)
+	switch {
+	case strings.HasSuffix(param, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(param, "w"):
+		unit = 7 * 24 * time.Hour
+	}
+	if unit != 0 {
+		amount, err := strconv.Atoi(param[:len(param)-1])
+		if err != nil {
+			return 0, false
+		}
+		return time.Duration(amount) * unit, true
+	}
+
+	tolerance, err := time.ParseDuration(param)
+	if err != nil {
+		return 0, false
+	}
+	return tolerance, true
+}
@@ -0,0 +1,65 @@
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/types/date"
+)
+
+func TestDateRules_FieldReferenceWithTolerance(t *testing.T) {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	data := map[string]any{
+		"start_date": start.Format(time.RFC3339),
+		"booking":    map[string]any{"deadline": start},
+	}
+
+	tests := []struct {
+		name    string
+		create  func([]string) (contract.Rule, error)
+		params  []string
+		value   any
+		wantErr bool
+	}{
+		{"after field with hour tolerance", date.NewAfterRule, []string{"start_date", "+1h"},
+			start.Add(2 * time.Hour), false},
+		{"inside hour tolerance", date.NewAfterRule, []string{"start_date", "+1h"}, start.Add(30 * time.Minute), true},
+		{"after field without tolerance", date.NewAfterRule, []string{"start_date"}, start.Add(time.Second), false},
+		{"before nested field minus days", date.NewBeforeOrEqualRule, []string{"booking.deadline", "-2d"},
+			start.AddDate(0, 0, -2), false},
+		{"not two days before", date.NewBeforeOrEqualRule, []string{"booking.deadline", "-2d"},
+			start.AddDate(0, 0, -1), true},
+		{"after or equal with week", date.NewAfterOrEqualRule, []string{"start_date", "+1w"}, start.AddDate(0, 0, 7), false},
+		{"before with composite duration", date.NewBeforeRule, []string{"start_date", "+1h30m"},
+			start.Add(89 * time.Minute), false},
+		{"date equals with tolerance", date.NewDateEqualsRule, []string{"start_date", "+15m"},
+			start.Add(15 * time.Minute).Format(time.RFC3339), false},
+		{"literal date with tolerance", date.NewAfterRule, []string{"2024-05-01", "+1d", "2006-01-02"}, "2024-05-02", true},
+		{"missing reference field", date.NewAfterRule, []string{"end_date", "+1h"}, start, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := tt.create(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected creation error: %v", err)
+			}
+			err = rule.Validate(contract.NewValidationContext("ends_at", tt.value, tt.params, data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDateRules_InvalidLiteralStillFails(t *testing.T) {
+	if _, err := date.NewAfterRule([]string{"2023-13-99"}); err == nil {
+		t.Error("expected an invalid literal date to be rejected")
+	}
+	for _, keyword := range []string{"tomorrow", "Today", "now"} {
+		if _, err := date.NewBeforeRule([]string{keyword}); err == nil {
+			t.Errorf("expected the relative date %q to be rejected", keyword)
+		}
+	}
+}
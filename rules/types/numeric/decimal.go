@@ -1,8 +1,10 @@
 package numeric
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -56,14 +58,23 @@ func NewDecimalRule(params []string) (contract.Rule, error) {
 func (r *DecimalRule) Validate(ctx contract.RuleContext) error {
 	var decimals int
 
+	if exact, ok, err := common.ExactNumber(ctx.Value()); ok {
+		if err != nil {
+			return errors.New(decimalRuleErrMsgParseFailed)
+		}
+		decimals = ratDecimalPlaces(exact)
+		if number, isJSON := ctx.Value().(json.Number); isJSON {
+			// Count from the original text to preserve trailing zeros
+			decimals = r.countDecimalPlacesFromString(number.String())
+		}
+		return r.checkDecimals(decimals)
+	}
+
 	switch v := ctx.Value().(type) {
 	case float64:
 		decimals = r.countDecimalPlaces(v)
 	case float32:
 		decimals = r.countDecimalPlaces(float64(v))
-	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
-		// Integer types have 0 decimal places
-		decimals = 0
 	case string:
 		// For strings, count decimal places from the original string to preserve trailing zeros
 		decimals = r.countDecimalPlacesFromString(v)
@@ -75,6 +86,11 @@ func (r *DecimalRule) Validate(ctx contract.RuleContext) error {
 		return errors.New(decimalRuleErrMsgInvalidType)
 	}
 
+	return r.checkDecimals(decimals)
+}
+
+// checkDecimals compares the number of decimal places of the value with the parameters
+func (r *DecimalRule) checkDecimals(decimals int) error {
 	// No decimal part, valid only if minDecimals == 0
 	if decimals == 0 && r.minDecimals == 0 {
 		return nil
@@ -104,6 +120,30 @@ func (r *DecimalRule) countDecimalPlaces(f float64) int {
 	return 0
 }
 
+// ratDecimalPlaces returns the number of decimal places of an exact number (3/2 has 1), or -1
+// when it has no finite decimal expansion (1/3)
+func ratDecimalPlaces(exact *big.Rat) int {
+	denominator := new(big.Int).Set(exact.Denom())
+	places := 0
+	for _, factor := range []int64{2, 5} {
+		divisor, remainder := big.NewInt(factor), new(big.Int)
+		count := 0
+		for {
+			quotient, mod := new(big.Int).QuoRem(denominator, divisor, remainder)
+			if mod.Sign() != 0 {
+				break
+			}
+			denominator = quotient
+			count++
+		}
+		places = max(places, count)
+	}
+	if denominator.Cmp(big.NewInt(1)) != 0 {
+		return -1
+	}
+	return places
+}
+
 func (r *DecimalRule) countDecimalPlacesFromString(s string) int {
 	parts := strings.Split(s, ".")
 	if len(parts) == 2 {
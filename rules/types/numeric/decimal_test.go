@@ -1,6 +1,7 @@
 package numeric_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -44,6 +45,9 @@ func TestDecimalRule_ExactMatch(t *testing.T) {
 		{"integer value", 12, false},
 		{"string integer", "12", false},
 		{"invalid string", "not a number", false},
+		{"json number with 2 decimals", json.Number("12.30"), true},
+		{"json number with 3 decimals", json.Number("12.305"), false},
+		{"invalid json number", json.Number("1e5000000"), false},
 	})
 }
 
@@ -0,0 +1,59 @@
+package numeric_test
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/types/numeric"
+)
+
+// exactDecimal mimics decimal.Decimal-like types exposing an exact rational
+type exactDecimal struct{ value string }
+
+func (d exactDecimal) Rat() *big.Rat {
+	r, _ := new(big.Rat).SetString(d.value)
+	return r
+}
+
+func TestNumericRules_ExactNumbers(t *testing.T) {
+	huge, _ := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639936", 10)
+	numericRule, _ := numeric.NewNumericRule()
+	integerRule, _ := numeric.NewIntegerRule()
+	multipleRule, _ := numeric.NewMultipleOfRule([]string{"0.25"})
+	decimalRule, _ := numeric.NewDecimalRule([]string{"0", "2"})
+
+	tests := []struct {
+		name                              string
+		value                             any
+		numeric, integer, multiple, fixed bool
+	}{
+		{"big.Int", huge, true, true, true, true},
+		{"big.Int value", *big.NewInt(3), true, true, true, true},
+		{"nil big.Int", (*big.Int)(nil), false, false, false, false},
+		{"big.Float integer", big.NewFloat(4), true, true, true, true},
+		{"big.Float fraction", big.NewFloat(0.75), true, false, true, true},
+		{"big.Float infinity", big.NewFloat(math.Inf(1)), false, false, false, false},
+		{"big.Rat", big.NewRat(1, 3), true, false, false, false},
+		{"big.Rat decimal", big.NewRat(5, 4), true, false, true, true},
+		{"exact decimal", exactDecimal{"2.50"}, true, false, true, true},
+		{"exact decimal fraction", exactDecimal{"2.125"}, true, false, false, false},
+		{"exact decimal without value", exactDecimal{"x"}, false, false, false, false},
+		{"json number", json.Number("1.5"), true, false, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contract.NewValidationContext("amount", tt.value, nil, nil)
+			for rule, want := range map[contract.Rule]bool{
+				numericRule: tt.numeric, integerRule: tt.integer, multipleRule: tt.multiple, decimalRule: tt.fixed,
+			} {
+				if err := rule.Validate(ctx); (err == nil) != want {
+					t.Errorf("%s: expected pass=%v, got %v", rule.Name(), want, err)
+				}
+			}
+		})
+	}
+}
@@ -33,11 +33,15 @@ func (r *IntegerRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	switch v := ctx.Value().(type) {
-	case int, int8, int16, int32, int64,
-		uint, uint8, uint16, uint32, uint64:
-		return nil
+	// Integer kinds, json.Number and math/big numbers are integers when their exact value is
+	if exact, ok, err := common.ExactNumber(ctx.Value()); ok {
+		if err == nil && exact.IsInt() {
+			return nil
+		}
+		return errors.New(integerRuleInvalidType)
+	}
 
+	switch v := ctx.Value().(type) {
 	case string:
 		if _, err := strconv.Atoi(v); err == nil {
 			return nil
@@ -1,6 +1,7 @@
 package numeric_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -44,6 +45,12 @@ func TestIntegerRule(t *testing.T) {
 		{"float32 non-integer", float32(123.45), false},
 		{"float64 non-integer", float64(123.45), false},
 
+		// JSON numbers decoded with UseNumber
+		{"json number integer", json.Number("5"), true},
+		{"json number beyond int64", json.Number("18446744073709551616"), true},
+		{"json number fraction", json.Number("5.5"), false},
+		{"json number too large", json.Number("1e5000000"), false},
+
 		// Invalid strings
 		{"string float", "123.45", false},
 		{"string non-numeric", "abc", false},
@@ -0,0 +1,243 @@
+package numeric
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/common"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+const (
+	moneyRuleName                = "money"
+	moneyRuleDefaultMsg          = "the :attribute must be a valid amount"
+	moneyRuleErrMsgRequiresParam = "money rule requires a currency code or currency field parameter"
+	moneyRuleErrMsgUnknownLocale = "money rule does not support locale %q"
+	moneyRuleErrMsgCurrency      = "the :attribute currency is not supported"
+	moneyRuleErrMsgInvalidType   = "the :attribute must be a string or number"
+	moneyRuleErrMsgMinorUnits    = "the :attribute has too many decimal places for its currency"
+)
+
+// currencyMinorUnits lists ISO 4217 currencies and the number of decimal places of their minor unit
+var currencyMinorUnits = map[string]int{
+	"AED": 2, "AUD": 2, "BRL": 2, "CAD": 2, "CHF": 2, "CNY": 2, "CZK": 2, "DKK": 2, "EUR": 2,
+	"GBP": 2, "HKD": 2, "HUF": 2, "ILS": 2, "INR": 2, "MXN": 2, "NOK": 2, "NZD": 2, "PLN": 2,
+	"RUB": 2, "SAR": 2, "SEK": 2, "SGD": 2, "TRY": 2, "USD": 2, "ZAR": 2,
+	"CLP": 0, "ISK": 0, "JPY": 0, "KRW": 0, "PYG": 0, "UGX": 0, "VND": 0, "XAF": 0, "XOF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// moneyFormat holds the separators used by a locale
+type moneyFormat struct {
+	group   string
+	decimal string
+}
+
+// plainMoneyFormat is used when no locale is given: no grouping, "." as decimal separator
+var plainMoneyFormat = moneyFormat{decimal: "."}
+
+// moneyLocales maps locale tags (or their language part) to their separators
+var moneyLocales = map[string]moneyFormat{
+	"en":    {group: ",", decimal: "."},
+	"de":    {group: ".", decimal: ","},
+	"es":    {group: ".", decimal: ","},
+	"it":    {group: ".", decimal: ","},
+	"nl":    {group: ".", decimal: ","},
+	"pt":    {group: ".", decimal: ","},
+	"tr":    {group: ".", decimal: ","},
+	"fr":    {group: " ", decimal: ","},
+	"pl":    {group: " ", decimal: ","},
+	"ru":    {group: " ", decimal: ","},
+	"sv":    {group: " ", decimal: ","},
+	"cs":    {group: " ", decimal: ","},
+	"de-ch": {group: "'", decimal: "."},
+	"fr-ch": {group: "'", decimal: "."},
+}
+
+// MoneyRule validates monetary amounts against the minor units of a currency, given
+// literally ("money:EUR") or read from another field ("money:currency"), with optional
+// locale-specific separators ("money:EUR,de" accepts "1.234,50").
+// Accepted amounts are normalized to a plain decimal string such as "1234.50".
+type MoneyRule struct {
+	common.BaseRule
+	currency string
+	format   moneyFormat
+}
+
+// NewMoneyRule creates a new MoneyRule
+func NewMoneyRule(params []string) (contract.Rule, error) {
+	if len(params) == 0 || strings.TrimSpace(params[0]) == "" {
+		return nil, errors.New(moneyRuleErrMsgRequiresParam)
+	}
+
+	format := plainMoneyFormat
+	if len(params) > 1 {
+		localeFormat, ok := lookupMoneyLocale(params[1])
+		if !ok {
+			return nil, fmt.Errorf(moneyRuleErrMsgUnknownLocale, params[1])
+		}
+		format = localeFormat
+	}
+
+	return &MoneyRule{
+		BaseRule: common.NewBaseRule(moneyRuleName, moneyRuleDefaultMsg, params),
+		currency: strings.TrimSpace(params[0]),
+		format:   format,
+	}, nil
+}
+
+// lookupMoneyLocale resolves a locale tag such as "de_DE" or "fr-CH"
+func lookupMoneyLocale(locale string) (moneyFormat, bool) {
+	tag := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if format, ok := moneyLocales[tag]; ok {
+		return format, true
+	}
+	language, _, _ := strings.Cut(tag, "-")
+	format, ok := moneyLocales[language]
+	return format, ok
+}
+
+// Validate checks that the value is an amount with no more decimals than the currency allows
+func (r *MoneyRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+
+	_, err := r.normalize(ctx)
+	return err
+}
+
+// Normalize returns the accepted amount as a plain decimal string padded to the currency's minor units
+func (r *MoneyRule) Normalize(ctx contract.RuleContext) any {
+	normalized, err := r.normalize(ctx)
+	if err != nil {
+		return ctx.Value()
+	}
+	return normalized
+}
+
+// normalize parses the value of ctx and renders its canonical form
+func (r *MoneyRule) normalize(ctx contract.RuleContext) (string, error) {
+	minorUnits, ok := r.minorUnits(ctx)
+	if !ok {
+		return "", errors.New(moneyRuleErrMsgCurrency)
+	}
+
+	amount, format, ok := moneyString(ctx.Value())
+	if !ok {
+		return "", errors.New(moneyRuleErrMsgInvalidType)
+	}
+	if format == nil {
+		format = &r.format
+	}
+
+	sign, integer, fraction, ok := parseAmount(amount, *format)
+	if !ok {
+		return "", errors.New(moneyRuleDefaultMsg)
+	}
+	if len(fraction) > minorUnits {
+		return "", errors.New(moneyRuleErrMsgMinorUnits)
+	}
+
+	if minorUnits == 0 {
+		return sign + integer, nil
+	}
+	return sign + integer + "." + fraction + strings.Repeat("0", minorUnits-len(fraction)), nil
+}
+
+// minorUnits resolves the currency (literal code or field reference) to its minor units
+func (r *MoneyRule) minorUnits(ctx contract.RuleContext) (int, bool) {
+	if units, ok := currencyMinorUnits[r.currency]; ok {
+		return units, true
+	}
+	value, exists := utils.GetPath(ctx.Data(), r.currency)
+	if !exists {
+		return 0, false
+	}
+	code, ok := value.(string)
+	if !ok {
+		return 0, false
+	}
+	units, ok := currencyMinorUnits[strings.ToUpper(strings.TrimSpace(code))]
+	return units, ok
+}
+
+// moneyString renders value as an amount string. Numbers are rendered in the plain
+// format and return it; strings return nil and are parsed with the rule's locale.
+func moneyString(value any) (string, *moneyFormat, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, nil, true
+	case json.Number:
+		return v.String(), &plainMoneyFormat, true
+	}
+
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(val.Int(), 10), &plainMoneyFormat, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(val.Uint(), 10), &plainMoneyFormat, true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(val.Float(), 'f', -1, val.Type().Bits()), &plainMoneyFormat, true
+	}
+	return "", nil, false
+}
+
+// parseAmount splits an amount into sign, integer digits and fraction digits.
+// Group separators are optional but, when used, must separate groups of three digits.
+func parseAmount(amount string, format moneyFormat) (sign, integer, fraction string, ok bool) {
+	amount = strings.TrimSpace(amount)
+	if format.group == " " {
+		// Accept the no-break spaces commonly produced by locale formatters
+		amount = strings.NewReplacer("\u00a0", " ", "\u202f", " ").Replace(amount)
+	}
+	if strings.HasPrefix(amount, "-") || strings.HasPrefix(amount, "+") {
+		if amount[0] == '-' {
+			sign = "-"
+		}
+		amount = amount[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(amount, format.decimal)
+	if hasFraction && (fraction == "" || !isDigits(fraction)) {
+		return "", "", "", false
+	}
+
+	if format.group != "" && strings.Contains(integer, format.group) {
+		groups := strings.Split(integer, format.group)
+		if len(groups[0]) == 0 || len(groups[0]) > 3 {
+			return "", "", "", false
+		}
+		for _, group := range groups[1:] {
+			if len(group) != 3 {
+				return "", "", "", false
+			}
+		}
+		integer = strings.Join(groups, "")
+	}
+	if integer == "" || !isDigits(integer) {
+		return "", "", "", false
+	}
+
+	return sign, integer, fraction, true
+}
+
+// isDigits reports whether s only contains ASCII digits
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *MoneyRule) Name() string {
+	return moneyRuleName
+}
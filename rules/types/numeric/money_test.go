@@ -0,0 +1,69 @@
+package numeric_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/rules/types/numeric"
+)
+
+func TestMoneyRule(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     []string
+		value      any
+		data       map[string]any
+		wantErr    bool
+		normalized any
+	}{
+		{"plain euro amount", []string{"EUR"}, "12.5", nil, false, "12.50"},
+		{"integer euro amount", []string{"EUR"}, 12, nil, false, "12.00"},
+		{"json number", []string{"USD"}, json.Number("-3.99"), nil, false, "-3.99"},
+		{"too many decimals", []string{"EUR"}, "12.345", nil, true, nil},
+		{"yen has no minor unit", []string{"JPY"}, "1200.5", nil, true, nil},
+		{"yen integer", []string{"JPY"}, "1200", nil, false, "1200"},
+		{"dinar has three decimals", []string{"KWD"}, "1.125", nil, false, "1.125"},
+		{"german separators", []string{"EUR", "de"}, "1.234.567,8", nil, false, "1234567.80"},
+		{"english separators", []string{"USD", "en_US"}, "1,234.56", nil, false, "1234.56"},
+		{"french no-break space", []string{"EUR", "fr"}, "1 234,5", nil, false, "1234.50"},
+		{"swiss apostrophe", []string{"CHF", "de-CH"}, "1'234.05", nil, false, "1234.05"},
+		{"misplaced group separator", []string{"USD", "en"}, "12,34.56", nil, true, nil},
+		{"plain format rejects grouping", []string{"EUR"}, "1,234.56", nil, true, nil},
+		{"not a number", []string{"EUR"}, "abc", nil, true, nil},
+		{"unsupported type", []string{"EUR"}, []int{1}, nil, true, nil},
+		{"currency from field", []string{"currency"}, "100.5", map[string]any{"currency": "jpy"}, true, nil},
+		{"currency from nested field", []string{"price.currency"}, "100.5",
+			map[string]any{"price": map[string]any{"currency": "BHD"}}, false, "100.500"},
+		{"unknown currency", []string{"currency"}, "1", map[string]any{"currency": "XYZ"}, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := numeric.NewMoneyRule(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected creation error: %v", err)
+			}
+			ctx := contract.NewValidationContext("amount", tt.value, tt.params, tt.data)
+			err = rule.Validate(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := rule.(contract.Normalizer).Normalize(ctx); got != tt.normalized {
+				t.Errorf("Normalize() = %v, want %v", got, tt.normalized)
+			}
+		})
+	}
+}
+
+func TestMoneyRule_InvalidParameters(t *testing.T) {
+	if _, err := numeric.NewMoneyRule(nil); err == nil {
+		t.Error("expected error without currency parameter")
+	}
+	if _, err := numeric.NewMoneyRule([]string{"EUR", "xx"}); err == nil {
+		t.Error("expected error for unsupported locale")
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
+	"strings"
 
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/rules/common"
@@ -22,10 +24,13 @@ const (
 	floatEqualityTolerance        float64 = 1e-9
 )
 
-// MultipleOfRule checks if a value is a multiple of a given float.
+// MultipleOfRule checks if a value is a multiple of a given float. Exact numbers (integers,
+// json.Number, math/big numbers, contract.ExactNumber) are checked against the exact parameter,
+// so "multiple_of:0.1" holds for json.Number("0.3").
 type MultipleOfRule struct {
 	common.BaseRule
-	multiple float64
+	multiple      float64
+	exactMultiple *big.Rat
 }
 
 // NewMultipleOfRule constructs a new rule for checking multiples.
@@ -43,9 +48,11 @@ func NewMultipleOfRule(parameters []string) (contract.Rule, error) {
 		return nil, errors.New(multipleOfRuleZeroParamMsg)
 	}
 
+	exactMultiple, _, _ := common.ParseDecimal(strings.TrimSpace(parameters[0]))
 	return &MultipleOfRule{
-		BaseRule: common.NewBaseRule(multipleOfRuleName, multipleOfRuleDefaultMsg, parameters),
-		multiple: val,
+		BaseRule:      common.NewBaseRule(multipleOfRuleName, multipleOfRuleDefaultMsg, parameters),
+		multiple:      val,
+		exactMultiple: exactMultiple,
 	}, nil
 }
 
@@ -56,6 +63,16 @@ func (r *MultipleOfRule) Validate(ctx contract.RuleContext) error {
 		return errors.New(multipleOfRuleInvalidInputMsg)
 	}
 
+	if exact, ok, err := common.ExactNumber(ctx.Value()); ok {
+		if err != nil {
+			return errors.New(multipleOfRuleInvalidInputMsg)
+		}
+		if r.isExactMultiple(exact) {
+			return nil
+		}
+		return fmt.Errorf(multipleOfRuleFailedMsg, utils.FloatToString(r.multiple))
+	}
+
 	var value float64
 	var err error
 
@@ -64,26 +81,6 @@ func (r *MultipleOfRule) Validate(ctx contract.RuleContext) error {
 		value = v
 	case float32:
 		value = float64(v)
-	case int:
-		value = float64(v)
-	case int8:
-		value = float64(v)
-	case int16:
-		value = float64(v)
-	case int32:
-		value = float64(v)
-	case int64:
-		value = float64(v)
-	case uint:
-		value = float64(v)
-	case uint8:
-		value = float64(v)
-	case uint16:
-		value = float64(v)
-	case uint32:
-		value = float64(v)
-	case uint64:
-		value = float64(v)
 	case string:
 		// Parse string as numeric value
 		value, err = strconv.ParseFloat(v, 64)
@@ -101,6 +98,15 @@ func (r *MultipleOfRule) Validate(ctx contract.RuleContext) error {
 	return fmt.Errorf(multipleOfRuleFailedMsg, utils.FloatToString(r.multiple))
 }
 
+// isExactMultiple checks if exact is a multiple of the parameter without float rounding
+func (r *MultipleOfRule) isExactMultiple(exact *big.Rat) bool {
+	if r.exactMultiple == nil {
+		value, _ := exact.Float64()
+		return isMultiple(value, r.multiple)
+	}
+	return new(big.Rat).Quo(exact, r.exactMultiple).IsInt()
+}
+
 // isMultiple checks if `value` is a multiple of `factor` using a tolerance.
 func isMultiple(value, factor float64) bool {
 	if factor == 0 {
@@ -1,6 +1,7 @@
 package numeric_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -23,6 +24,9 @@ func TestMultipleOfRule_IntegerValues(t *testing.T) {
 		{"valid string", "12", true},
 		{"invalid string", "13", false},
 		{"non-numeric string", "abc", false},
+		{"valid json number", json.Number("12"), true},
+		{"invalid json number", json.Number("13"), false},
+		{"unparsable json number", json.Number("1e5000000"), false},
 		{"zero", 0, true},
 		{"nil value", nil, false},
 	}
@@ -98,3 +102,16 @@ func TestMultipleOfRule_InvalidConstruction(t *testing.T) {
 		}
 	})
 }
+
+func TestMultipleOfRule_ExactJSONNumbers(t *testing.T) {
+	rule, err := numeric.NewMultipleOfRule([]string{"0.1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for value, want := range map[json.Number]bool{"0.3": true, "123456789012345678.9": true, "0.35": false} {
+		if err := rule.Validate(contract.NewValidationContext("field", value, nil, nil)); (err == nil) != want {
+			t.Errorf("value %s: expected pass=%v, got %v", value, want, err)
+		}
+	}
+}
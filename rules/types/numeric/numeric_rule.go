@@ -14,7 +14,8 @@ const (
 	numericRuleErrorMsg   = "the :attribute must be numeric"
 )
 
-// Rule checks whether a value is numeric (int, float, or numeric string).
+// Rule checks whether a value is numeric (int, float, numeric string, or an exact number such as
+// json.Number, *big.Int, *big.Float or a contract.ExactNumber).
 type Rule struct {
 	common.BaseRule
 }
@@ -32,10 +33,15 @@ func (r *Rule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
+	if _, exact, err := common.ExactNumber(ctx.Value()); exact {
+		if err != nil {
+			return errors.New(numericRuleErrorMsg)
+		}
+		return nil
+	}
+
 	switch v := ctx.Value().(type) {
-	case int, int8, int16, int32, int64,
-		uint, uint8, uint16, uint32, uint64,
-		float32, float64:
+	case float32, float64:
 		return nil
 	case string:
 		if _, err := strconv.ParseFloat(v, 64); err == nil {
@@ -1,6 +1,7 @@
 package numeric_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
@@ -41,6 +42,13 @@ func TestNumericRule(t *testing.T) {
 		{"string negative float", "-3.14", true},
 		{"string scientific", "1.23e3", true},
 
+		// JSON numbers decoded with UseNumber
+		{"json number int", json.Number("5"), true},
+		{"json number float", json.Number("-0.25"), true},
+		{"json number beyond float64", json.Number("1e400"), true},
+		{"json number too large", json.Number("1e5000000"), false},
+		{"json number invalid", json.Number("abc"), false},
+
 		// Invalid values
 		{"string non-numeric", "abc", false},
 		{"string mixed", "123abc", false},
@@ -1,3 +1,5 @@
+//go:build !scgcore && !tinygo
+
 package string
 
 import (
@@ -35,7 +37,7 @@ func (r *ActiveURLRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	val, ok := ctx.Value().(string)
+	val, ok := common.StringValue(ctx)
 	if !ok {
 		return errors.New(activeURLRuleInvalidType)
 	}
@@ -47,6 +49,10 @@ func (r *ActiveURLRule) Validate(ctx contract.RuleContext) error {
 
 	parsed, _ := url.Parse(val)
 	if _, err := net.LookupHost(parsed.Host); err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && (dnsErr.IsTimeout || dnsErr.IsTemporary) {
+			return &contract.ExternalError{Resource: contract.ResourceDNS, Err: errors.New(activeURLRuleResolutionErr)}
+		}
 		return errors.New(activeURLRuleResolutionErr)
 	}
 
@@ -56,3 +62,8 @@ func (r *ActiveURLRule) Validate(ctx contract.RuleContext) error {
 func (r *ActiveURLRule) Name() string {
 	return activeURLRuleName
 }
+
+// Resource flags the rule as resolving hosts through DNS, so it can be rate limited
+func (r *ActiveURLRule) Resource() string {
+	return contract.ResourceDNS
+}
@@ -35,7 +35,7 @@ func (r *AlphaRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	str, ok := ctx.Value().(string)
+	str, ok := common.StringValue(ctx)
 	if !ok {
 		return errors.New(alphaRuleInvalidTypeMsg)
 	}
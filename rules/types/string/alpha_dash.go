@@ -34,7 +34,7 @@ func (r *AlphaDashRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	str, ok := ctx.Value().(string)
+	str, ok := common.StringValue(ctx)
 	if !ok {
 		return errors.New(alphaDashRuleInvalidTypeMsg)
 	}
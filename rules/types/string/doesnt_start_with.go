@@ -41,7 +41,7 @@ func (r *DoesntStartWithRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	val, ok := ctx.Value().(string)
+	val, ok := common.StringValue(ctx)
 	if !ok {
 		return errors.New(doesntStartWithRuleInvalidTypeMsg)
 	}
@@ -41,7 +41,7 @@ func (r *EndsWithRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	value, ok := ctx.Value().(string)
+	value, ok := common.StringValue(ctx)
 	if !ok {
 		return errors.New(endsWithRuleInvalidTypeMsg)
 	}
@@ -33,7 +33,7 @@ func (r *LowercaseRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	val, ok := ctx.Value().(string)
+	val, ok := common.StringValue(ctx)
 	if !ok {
 		return errors.New(lowercaseRuleInvalidTypeMsg)
 	}
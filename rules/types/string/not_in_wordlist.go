@@ -0,0 +1,145 @@
+package string
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry/wordlist"
+	"github.com/next-trace/scg-validator/rules/common"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	notInWordlistRuleName           = "not_in_wordlist"
+	notInWordlistRuleDefaultMsg     = "the :attribute contains a disallowed word"
+	notInWordlistRuleMissingParam   = "not_in_wordlist rule requires a word list name"
+	notInWordlistRuleUnknownMode    = "not_in_wordlist rule does not support matching mode %q"
+	notInWordlistRuleUnknownList    = "word list %q is not registered; register it with wordlist.RegisterWordList"
+	notInWordlistRuleInvalidTypeMsg = "the :attribute must be a string"
+
+	// Matching modes of the not_in_wordlist rule, given after the list name
+	WordlistMatchExact      = "exact"
+	WordlistMatchSubstring  = "substring"
+	WordlistMatchNormalized = "normalized"
+)
+
+// leetspeakFolds maps digits and symbols used as letters to the letter they stand for. l and
+// 1 both fold to i, so "k1ller" and "killer" fold alike.
+var leetspeakFolds = map[rune]rune{
+	'0': 'o', '1': 'i', '!': 'i', '|': 'i', 'l': 'i', '2': 'z', '3': 'e', '4': 'a', '@': 'a',
+	'5': 's', '$': 's', '6': 'g', '9': 'g', '7': 't', '+': 't', '8': 'b',
+}
+
+// NotInWordlistRule rejects values containing a word of a list registered with
+// wordlist.RegisterWordList ("not_in_wordlist:profanity"), for usernames and public display
+// names. The optional second parameter selects how words match, case-insensitively:
+//   - exact (the default): a word of the value, split on non-alphanumeric characters, is listed
+//   - substring: the value contains a listed word ("superbadword")
+//   - normalized: like substring after folding accents, leetspeak ("b4dw0rd"), separators
+//     ("b.a.d") and repeated letters ("baaad") out of both the value and the words
+//
+// Substring matching also flags innocent words containing a listed one, so prefer exact for
+// short or common words.
+type NotInWordlistRule struct {
+	common.BaseRule
+	list string
+	mode string
+}
+
+// NewNotInWordlistRule creates a new NotInWordlistRule
+func NewNotInWordlistRule(parameters []string) (contract.Rule, error) {
+	if len(parameters) == 0 || strings.TrimSpace(parameters[0]) == "" {
+		return nil, errors.New(notInWordlistRuleMissingParam)
+	}
+	mode := WordlistMatchExact
+	if len(parameters) > 1 {
+		mode = strings.ToLower(strings.TrimSpace(parameters[1]))
+	}
+	switch mode {
+	case WordlistMatchExact, WordlistMatchSubstring, WordlistMatchNormalized:
+	default:
+		return nil, fmt.Errorf(notInWordlistRuleUnknownMode, parameters[1])
+	}
+
+	return &NotInWordlistRule{
+		BaseRule: common.NewBaseRule(notInWordlistRuleName, notInWordlistRuleDefaultMsg, parameters),
+		list:     strings.TrimSpace(parameters[0]),
+		mode:     mode,
+	}, nil
+}
+
+// Validate checks the value against the words of the list
+func (r *NotInWordlistRule) Validate(ctx contract.RuleContext) error {
+	if r.ShouldSkipValidation(ctx.Value()) {
+		return nil
+	}
+	val, ok := common.StringValue(ctx)
+	if !ok {
+		return errors.New(notInWordlistRuleInvalidTypeMsg)
+	}
+	words, ok := wordlist.FindWordList(r.list)
+	if !ok {
+		return fmt.Errorf(notInWordlistRuleUnknownList, r.list)
+	}
+
+	if r.matches(strings.ToLower(val), words) {
+		return errors.New(notInWordlistRuleDefaultMsg)
+	}
+	return nil
+}
+
+// matches reports whether the lowercased value matches one of words under the rule's mode
+func (r *NotInWordlistRule) matches(val string, words []string) bool {
+	switch r.mode {
+	case WordlistMatchSubstring:
+		for _, word := range words {
+			if strings.Contains(val, word) {
+				return true
+			}
+		}
+	case WordlistMatchNormalized:
+		folded := foldWord(val)
+		for _, word := range words {
+			if word = foldWord(word); word != "" && strings.Contains(folded, word) {
+				return true
+			}
+		}
+	default:
+		listed := make(map[string]bool, len(words))
+		for _, word := range words {
+			listed[word] = true
+		}
+		tokens := strings.FieldsFunc(val, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+		for _, token := range tokens {
+			if listed[token] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// foldWord reduces lowercased text to the letters it spells: accents are removed, leetspeak
+// characters replaced by their letter, other characters dropped and repeated letters collapsed
+func foldWord(s string) string {
+	var out []rune
+	for _, r := range norm.NFD.String(s) {
+		if folded, ok := leetspeakFolds[r]; ok {
+			r = folded
+		}
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		if len(out) == 0 || out[len(out)-1] != r {
+			out = append(out, r)
+		}
+	}
+	return string(out)
+}
+
+func (r *NotInWordlistRule) Name() string {
+	return notInWordlistRuleName
+}
@@ -0,0 +1,52 @@
+package string_test
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry/wordlist"
+	stringrule "github.com/next-trace/scg-validator/rules/types/string"
+)
+
+func TestNotInWordlistRule(t *testing.T) {
+	wordlist.RegisterWordList("test_blocked", "Badword", "admin", " ")
+
+	tests := []struct {
+		name       string
+		params     []string
+		value      any
+		shouldPass bool
+	}{
+		{"exact clean", []string{"test_blocked"}, "good_name", true},
+		{"exact listed", []string{"test_blocked"}, "BADWORD", false},
+		{"exact listed token", []string{"test_blocked"}, "the admin team", false},
+		{"exact ignores substrings", []string{"test_blocked"}, "superbadword", true},
+		{"substring", []string{"test_blocked", "substring"}, "superbadword", false},
+		{"substring ignores leetspeak", []string{"test_blocked", "substring"}, "b4dw0rd", true},
+		{"normalized leetspeak", []string{"test_blocked", "normalized"}, "xX_b4dw0rd_Xx", false},
+		{"normalized separators and repeats", []string{"test_blocked", "normalized"}, "b.a.a.a.d w o r d", false},
+		{"normalized accents", []string{"test_blocked", "normalized"}, "àdmïn", false},
+		{"normalized clean", []string{"test_blocked", "normalized"}, "goodname", true},
+		{"non-string", []string{"test_blocked"}, 42, false},
+		{"unknown list", []string{"test_missing"}, "anything", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := stringrule.NewNotInWordlistRule(tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			err = rule.Validate(contract.NewValidationContext("username", tt.value, tt.params, nil))
+			if (err == nil) != tt.shouldPass {
+				t.Fatalf("expected pass %v for %v, got %v", tt.shouldPass, tt.value, err)
+			}
+		})
+	}
+
+	if _, err := stringrule.NewNotInWordlistRule(nil); err == nil {
+		t.Fatal("expected an error without a list name")
+	}
+	if _, err := stringrule.NewNotInWordlistRule([]string{"test_blocked", "fuzzy"}); err == nil {
+		t.Fatal("expected an error for an unknown matching mode")
+	}
+}
@@ -36,7 +36,7 @@ func (r *SlugRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	val, ok := ctx.Value().(string)
+	val, ok := common.StringValue(ctx)
 	if !ok {
 		return errors.New(slugRuleInvalidTypeMsg)
 	}
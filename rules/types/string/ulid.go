@@ -34,7 +34,7 @@ func (r *UlidRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	val, ok := ctx.Value().(string)
+	val, ok := common.StringValue(ctx)
 	if !ok {
 		return errors.New(ulidRuleInvalidTypeError)
 	}
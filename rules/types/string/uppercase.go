@@ -34,7 +34,7 @@ func (r *UppercaseRule) Validate(ctx contract.RuleContext) error {
 		return nil
 	}
 
-	str, ok := ctx.Value().(string)
+	str, ok := common.StringValue(ctx)
 	if !ok {
 		return errors.New(uppercaseRuleTypeError)
 	}
@@ -0,0 +1,3 @@
+// Package sanitizer provides named value transformations (trim, lower, upper,
+// strip_tags and user-registered functions) that run before validation rules.
+package sanitizer
@@ -0,0 +1,71 @@
+package sanitizer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/next-trace/scg-validator/parser"
+)
+
+// Func transforms a single input value. Non-string values should be returned unchanged
+// unless the sanitizer explicitly handles them.
+type Func func(value any) any
+
+var (
+	sanitizers = map[string]Func{
+		"trim":       stringFunc(strings.TrimSpace),
+		"lower":      stringFunc(strings.ToLower),
+		"upper":      stringFunc(strings.ToUpper),
+		"strip_tags": stringFunc(stripTags),
+	}
+	sanitizerLock = &sync.RWMutex{}
+
+	tagPattern = regexp.MustCompile(`<[^>]*>`)
+)
+
+// Register registers a sanitizer under name, replacing any existing one.
+func Register(name string, fn Func) {
+	sanitizerLock.Lock()
+	defer sanitizerLock.Unlock()
+	sanitizers[name] = fn
+}
+
+// Find returns the sanitizer registered under name.
+func Find(name string) (Func, bool) {
+	sanitizerLock.RLock()
+	defer sanitizerLock.RUnlock()
+	fn, ok := sanitizers[name]
+	return fn, ok
+}
+
+// Apply runs the pipe-separated sanitizers in spec (e.g. "trim|lower") over value, in order.
+func Apply(value any, spec string) (any, error) {
+	for _, name := range parser.SplitRules(spec) {
+		if name == "" {
+			continue
+		}
+		fn, ok := Find(name)
+		if !ok {
+			return value, fmt.Errorf("unknown sanitizer: %s", name)
+		}
+		value = fn(value)
+	}
+	return value, nil
+}
+
+// stringFunc adapts a string transformation to a Func that leaves other types untouched
+func stringFunc(fn func(string) string) Func {
+	return func(value any) any {
+		if str, ok := value.(string); ok {
+			return fn(str)
+		}
+		return value
+	}
+}
+
+// stripTags removes HTML and XML tags from s
+func stripTags(s string) string {
+	return tagPattern.ReplaceAllString(s, "")
+}
@@ -0,0 +1,54 @@
+package sanitizer
+
+import "testing"
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		spec  string
+		want  any
+	}{
+		{"trim and lower", "  John@Example.COM ", "trim|lower", "john@example.com"},
+		{"upper", "abc", "upper", "ABC"},
+		{"strip tags", "<b>bold</b> text<br/>", "strip_tags", "bold text"},
+		{"non string untouched", 42, "trim|upper", 42},
+		{"empty spec", " x ", "", " x "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Apply(tt.value, tt.spec)
+			if err != nil || got != tt.want {
+				t.Fatalf("Apply(%v, %q) = %v, %v; want %v", tt.value, tt.spec, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestApply_UnknownSanitizer(t *testing.T) {
+	if _, err := Apply("x", "trim|no_such"); err == nil {
+		t.Fatal("expected error for unknown sanitizer")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	Register("digits", Func(func(value any) any {
+		str, ok := value.(string)
+		if !ok {
+			return value
+		}
+		out := make([]rune, 0, len(str))
+		for _, r := range str {
+			if r >= '0' && r <= '9' {
+				out = append(out, r)
+			}
+		}
+		return string(out)
+	}))
+
+	got, err := Apply("+1 (555) 010-99", "digits")
+	if err != nil || got != "155501099" {
+		t.Fatalf("unexpected custom sanitizer result: %v, %v", got, err)
+	}
+}
@@ -0,0 +1,24 @@
+//go:build !scgcore && !tinygo
+
+package utils
+
+import (
+	"mime/multipart"
+	"net/textproto"
+)
+
+func NewFileHeader(filename string) *multipart.FileHeader {
+	return &multipart.FileHeader{
+		Filename: filename,
+		Header:   textproto.MIMEHeader{"Content-Type": []string{"application/octet-stream"}},
+		Size:     1024,
+	}
+}
+
+func NewFileHeaderWithMime(filename string, mimeType string, size int64) *multipart.FileHeader {
+	return &multipart.FileHeader{
+		Filename: filename,
+		Size:     size,
+		Header:   textproto.MIMEHeader{"Content-Type": []string{mimeType}},
+	}
+}
@@ -0,0 +1,30 @@
+//go:build !scgcore && !tinygo
+
+package utils
+
+import (
+	"mime/multipart"
+	"testing"
+)
+
+func TestNewFileHeader(t *testing.T) {
+	fh := NewFileHeader("file.bin")
+	if fh == nil || fh.Filename != "file.bin" || fh.Size == 0 {
+		t.Fatalf("unexpected file header: %+v", fh)
+	}
+	ct := fh.Header.Get("Content-Type")
+	if ct == "" {
+		t.Fatal("expected content-type to be set")
+	}
+}
+
+func TestNewFileHeaderWithMime(t *testing.T) {
+	var _ *multipart.FileHeader // silence import not used warning
+	fh := NewFileHeaderWithMime("img.png", "image/png", 2048)
+	if fh.Filename != "img.png" || fh.Size != 2048 {
+		t.Fatalf("unexpected file header: %+v", fh)
+	}
+	if fh.Header.Get("Content-Type") != "image/png" {
+		t.Fatalf("unexpected mime: %s", fh.Header.Get("Content-Type"))
+	}
+}
@@ -3,21 +3,25 @@ package utils
 import (
 	"errors"
 	"fmt"
-	"mime/multipart"
-	"net/textproto"
 	"reflect"
 	"strconv"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/next-trace/scg-validator/contract"
 )
 
 // GetAsFloat converts various types to a float64 for size comparison.
+// Values implementing contract.Sizer report their own size.
 // For strings, it returns the rune count. For slices, arrays, and maps, it returns the length.
 // For numeric types, it returns the float64 value.
 func GetAsFloat(value interface{}) (float64, error) {
 	if value == nil {
 		return 0, nil
 	}
+	if sizer, ok := value.(contract.Sizer); ok {
+		return sizer.ValidationSize(), nil
+	}
 
 	val := reflect.ValueOf(value)
 
@@ -70,6 +74,7 @@ func GetAsNumeric(value interface{}) (float64, error) {
 }
 
 // GetAsComparable converts various types to a float64 for comparison rules.
+// Values implementing contract.Sizer report their own size.
 // It tries to parse strings as numbers first, but falls back to length if not numeric.
 // For collections (slices, maps, arrays), it returns the length.
 // For numeric types, it returns the numeric value.
@@ -77,6 +82,9 @@ func GetAsComparable(value interface{}) (float64, error) {
 	if value == nil {
 		return 0, errors.New("cannot convert nil to comparable value")
 	}
+	if sizer, ok := value.(contract.Sizer); ok {
+		return sizer.ValidationSize(), nil
+	}
 
 	val := reflect.ValueOf(value)
 
@@ -137,19 +145,3 @@ func ContainsDot(domain string) bool {
 func FloatToString(f float64) string {
 	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.6f", f), "0"), ".")
 }
-
-func NewFileHeader(filename string) *multipart.FileHeader {
-	return &multipart.FileHeader{
-		Filename: filename,
-		Header:   textproto.MIMEHeader{"Content-Type": []string{"application/octet-stream"}},
-		Size:     1024,
-	}
-}
-
-func NewFileHeaderWithMime(filename string, mimeType string, size int64) *multipart.FileHeader {
-	return &multipart.FileHeader{
-		Filename: filename,
-		Size:     size,
-		Header:   textproto.MIMEHeader{"Content-Type": []string{mimeType}},
-	}
-}
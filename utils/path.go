@@ -0,0 +1,311 @@
+package utils
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GetPath resolves a dot-notation path such as "items.0.name" against nested maps and slices.
+// At any level, a key holding the rest of the path exactly ("b.c" in {"a": {"b.c": 1}} for
+// "a.b.c") takes precedence over splitting it on dots.
+func GetPath(data map[string]any, path string) (any, bool) {
+	if data == nil {
+		return nil, false
+	}
+
+	var current any = data
+	for {
+		if value, ok := pathSegment(current, path); ok {
+			return value, true
+		}
+		segment, rest, nested := strings.Cut(path, ".")
+		if !nested {
+			return nil, false
+		}
+		next, ok := pathSegment(current, segment)
+		if !ok {
+			return nil, false
+		}
+		current, path = next, rest
+	}
+}
+
+// pathSegment returns the child of container identified by segment
+func pathSegment(container any, segment string) (any, bool) {
+	switch c := container.(type) {
+	case map[string]any:
+		value, ok := c[segment]
+		return value, ok
+	case []any:
+		index, err := strconv.Atoi(segment)
+		if err != nil || index < 0 || index >= len(c) {
+			return nil, false
+		}
+		return c[index], true
+	}
+
+	val := reflect.ValueOf(container)
+	switch val.Kind() {
+	case reflect.Map:
+		if val.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		item := val.MapIndex(reflect.ValueOf(segment).Convert(val.Type().Key()))
+		if !item.IsValid() {
+			return nil, false
+		}
+		return item.Interface(), true
+	case reflect.Slice, reflect.Array:
+		index, err := strconv.Atoi(segment)
+		if err != nil || index < 0 || index >= val.Len() {
+			return nil, false
+		}
+		return val.Index(index).Interface(), true
+	}
+	return nil, false
+}
+
+// SetPath stores value at a dot-notation path, creating intermediate maps as needed.
+// Like GetPath, an existing key holding the rest of the path exactly is written in place.
+// Existing []any containers are indexed in place; out-of-range indexes are ignored.
+func SetPath(data map[string]any, path string, value any) {
+	setPath(data, path, value)
+}
+
+// setPath stores value under the rest of path in container
+func setPath(container any, path string, value any) any {
+	segment, rest, nested := strings.Cut(path, ".")
+	switch c := container.(type) {
+	case map[string]any:
+		if _, exact := c[path]; exact || !nested {
+			c[path] = value
+			return c
+		}
+		c[segment] = setPath(c[segment], rest, value)
+		return c
+	case []any:
+		index, err := strconv.Atoi(segment)
+		if err != nil || index < 0 || index >= len(c) {
+			return c
+		}
+		if nested {
+			c[index] = setPath(c[index], rest, value)
+		} else {
+			c[index] = value
+		}
+		return c
+	default:
+		return setPath(make(map[string]any), path, value)
+	}
+}
+
+// CloneData deep-copies nested map[string]any and []any containers so that the
+// copy can be modified without affecting the original input.
+func CloneData(data map[string]any) map[string]any {
+	if data == nil {
+		return nil
+	}
+	clone := make(map[string]any, len(data))
+	for key, value := range data {
+		clone[key] = cloneValue(value)
+	}
+	return clone
+}
+
+// cloneValue deep-copies a single container value
+func cloneValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return CloneData(v)
+	case []any:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = cloneValue(item)
+		}
+		return items
+	default:
+		return value
+	}
+}
+
+// HasPathPattern reports whether path contains a wildcard (*) or index range (0-4) segment
+func HasPathPattern(path string) bool {
+	for _, segment := range strings.Split(path, ".") {
+		if segment == "*" {
+			return true
+		}
+		if _, _, ok := parseRange(segment); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandPath expands wildcard (*) and inclusive index range (0-4) segments of pattern
+// into the concrete paths that exist in data, e.g. "items.*.sku" into "items.0.sku",
+// "items.1.sku". Paths without patterns are returned unchanged, even when missing.
+func ExpandPath(data map[string]any, pattern string) []string {
+	if !HasPathPattern(pattern) {
+		return []string{pattern}
+	}
+	return expandSegments(data, "", strings.Split(pattern, "."))
+}
+
+// expandSegments walks container along segments and collects the matching concrete paths
+func expandSegments(container any, prefix string, segments []string) []string {
+	if len(segments) == 0 {
+		return []string{prefix}
+	}
+
+	segment, rest := segments[0], segments[1:]
+	var keys []string
+	switch {
+	case segment == "*":
+		keys = containerKeys(container)
+	default:
+		if low, high, ok := parseRange(segment); ok && isList(container) {
+			for _, key := range containerKeys(container) {
+				index, _ := strconv.Atoi(key)
+				if index >= low && index <= high {
+					keys = append(keys, key)
+				}
+			}
+		} else {
+			keys = []string{segment}
+		}
+	}
+
+	var paths []string
+	for _, key := range keys {
+		child, ok := pathSegment(container, key)
+		if !ok && len(rest) > 0 && HasPathPattern(strings.Join(rest, ".")) {
+			continue
+		}
+		paths = append(paths, expandSegments(child, joinPath(prefix, key), rest)...)
+	}
+	return paths
+}
+
+// LeafPaths returns the sorted dot-notation paths of the scalar values and empty containers of
+// data, e.g. "name", "items.0.sku" and "tags" for an empty tag list
+func LeafPaths(data map[string]any) []string {
+	paths := leafPaths(data, "")
+	sort.Strings(paths)
+	return paths
+}
+
+// leafPaths collects the leaf paths below container
+func leafPaths(container any, prefix string) []string {
+	keys := containerKeys(container)
+	if len(keys) == 0 {
+		if prefix == "" {
+			return nil
+		}
+		return []string{prefix}
+	}
+	var paths []string
+	for _, key := range keys {
+		child, _ := pathSegment(container, key)
+		paths = append(paths, leafPaths(child, joinPath(prefix, key))...)
+	}
+	return paths
+}
+
+// FilterPaths returns a deep copy of data holding only the leaf values whose path keep accepts,
+// with the sorted paths of the leaves it dropped. Containers whose entries were all dropped are
+// dropped as well; lists keep their remaining elements in order.
+func FilterPaths(data map[string]any, keep func(path string) bool) (map[string]any, []string) {
+	var dropped []string
+	filtered, _ := filterValue(data, "", keep, &dropped)
+	sort.Strings(dropped)
+	result, _ := filtered.(map[string]any)
+	if result == nil {
+		result = make(map[string]any)
+	}
+	return result, dropped
+}
+
+// filterValue filters value at path, reporting false when nothing of it is kept
+func filterValue(value any, path string, keep func(string) bool, dropped *[]string) (any, bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) > 0 {
+			filtered := make(map[string]any, len(v))
+			for key, item := range v {
+				if kept, ok := filterValue(item, joinPath(path, key), keep, dropped); ok {
+					filtered[key] = kept
+				}
+			}
+			return filtered, len(filtered) > 0 || path == ""
+		}
+	case []any:
+		if len(v) > 0 {
+			filtered := make([]any, 0, len(v))
+			for i, item := range v {
+				if kept, ok := filterValue(item, joinPath(path, strconv.Itoa(i)), keep, dropped); ok {
+					filtered = append(filtered, kept)
+				}
+			}
+			return filtered, len(filtered) > 0
+		}
+	}
+	if path == "" || keep(path) {
+		return value, true
+	}
+	*dropped = append(*dropped, path)
+	return nil, false
+}
+
+// containerKeys lists the keys of a map (sorted) or the indexes of a slice
+func containerKeys(container any) []string {
+	val := reflect.ValueOf(container)
+	switch val.Kind() {
+	case reflect.Map:
+		if val.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		keys := make([]string, 0, val.Len())
+		for _, key := range val.MapKeys() {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+		return keys
+	case reflect.Slice, reflect.Array:
+		keys := make([]string, val.Len())
+		for i := range keys {
+			keys[i] = strconv.Itoa(i)
+		}
+		return keys
+	}
+	return nil
+}
+
+// isList reports whether container is a slice or array
+func isList(container any) bool {
+	kind := reflect.ValueOf(container).Kind()
+	return kind == reflect.Slice || kind == reflect.Array
+}
+
+// parseRange parses an inclusive index range segment such as "0-4"
+func parseRange(segment string) (low, high int, ok bool) {
+	lowPart, highPart, found := strings.Cut(segment, "-")
+	if !found {
+		return 0, 0, false
+	}
+	low, errLow := strconv.Atoi(lowPart)
+	high, errHigh := strconv.Atoi(highPart)
+	if errLow != nil || errHigh != nil || low < 0 || high < low {
+		return 0, 0, false
+	}
+	return low, high, true
+}
+
+// joinPath appends key to a dot-notation prefix
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetPath(t *testing.T) {
+	data := map[string]any{
+		"user":       map[string]any{"name": "Ann", "tags": []string{"a", "b"}},
+		"items":      []any{map[string]any{"name": "first"}},
+		"flat.key":   "exact",
+		"meta":       map[string]any{"labels.app": "api", "labels": map[string]any{"app": "split"}},
+		"scores":     map[string]int{"math": 9},
+		"nil_parent": nil,
+	}
+
+	tests := []struct {
+		path   string
+		want   any
+		wantOK bool
+	}{
+		{"user.name", "Ann", true},
+		{"user.tags.1", "b", true},
+		{"items.0.name", "first", true},
+		{"flat.key", "exact", true},
+		{"meta.labels.app", "api", true},
+		{"scores.math", 9, true},
+		{"items.1.name", nil, false},
+		{"user.missing", nil, false},
+		{"nil_parent.child", nil, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := GetPath(data, tt.path)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("GetPath(%q) = %v, %v; want %v, %v", tt.path, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestSetPathAndCloneData(t *testing.T) {
+	original := map[string]any{
+		"items": []any{map[string]any{"name": " a "}},
+	}
+	data := CloneData(original)
+
+	SetPath(data, "items.0.name", "a")
+	SetPath(data, "user.email", "x@y.z")
+	SetPath(data, "items.5.name", "ignored")
+	data["meta"] = map[string]any{"labels.app": "api"}
+	SetPath(data, "meta.labels.app", "web")
+
+	if got, _ := GetPath(data, "items.0.name"); got != "a" {
+		t.Fatalf("expected nested slice value to be set, got %v", got)
+	}
+	if got, _ := GetPath(data, "user.email"); got != "x@y.z" {
+		t.Fatalf("expected intermediate map to be created, got %v", got)
+	}
+	if meta := data["meta"].(map[string]any); meta["labels.app"] != "web" || len(meta) != 1 {
+		t.Fatalf("expected the exact nested key to be written in place, got %#v", meta)
+	}
+	if got, _ := GetPath(original, "items.0.name"); got != " a " {
+		t.Fatalf("expected original to be untouched, got %v", got)
+	}
+}
+
+func TestExpandPath(t *testing.T) {
+	data := map[string]any{
+		"items": []any{
+			map[string]any{"sku": "a"},
+			map[string]any{"sku": "b"},
+			map[string]any{},
+		},
+		"meta": map[string]any{"x": 1, "y": 2},
+	}
+
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{"items.*.sku", []string{"items.0.sku", "items.1.sku", "items.2.sku"}},
+		{"items.1-5.sku", []string{"items.1.sku", "items.2.sku"}},
+		{"items.0.sku", []string{"items.0.sku"}},
+		{"meta.*", []string{"meta.x", "meta.y"}},
+		{"missing.*.sku", nil},
+	}
+
+	for _, tt := range tests {
+		got := ExpandPath(data, tt.pattern)
+		if len(got) != len(tt.want) {
+			t.Fatalf("ExpandPath(%q) = %v, want %v", tt.pattern, got, tt.want)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Fatalf("ExpandPath(%q) = %v, want %v", tt.pattern, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestLeafPaths(t *testing.T) {
+	data := map[string]any{
+		"name":  "x",
+		"items": []any{map[string]any{"sku": "a", "qty": 1}},
+		"tags":  []any{},
+		"meta":  map[string]any{"x": map[string]any{"y": true}},
+	}
+
+	got := LeafPaths(data)
+	want := []string{"items.0.qty", "items.0.sku", "meta.x.y", "name", "tags"}
+	if len(got) != len(want) {
+		t.Fatalf("LeafPaths() = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("LeafPaths() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestFilterPaths(t *testing.T) {
+	data := map[string]any{
+		"name":  "x",
+		"admin": true,
+		"items": []any{map[string]any{"sku": "a", "qty": 1}, map[string]any{"qty": 2}},
+	}
+	keep := func(path string) bool { return path == "name" || strings.HasSuffix(path, ".sku") }
+
+	got, dropped := FilterPaths(data, keep)
+	want := map[string]any{"name": "x", "items": []any{map[string]any{"sku": "a"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("FilterPaths() = %#v, want %#v", got, want)
+	}
+	if !reflect.DeepEqual(dropped, []string{"admin", "items.0.qty", "items.1.qty"}) {
+		t.Fatalf("unexpected dropped paths %v", dropped)
+	}
+	if data["admin"] != true {
+		t.Fatal("expected the input to be untouched")
+	}
+}
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"database/sql/driver"
+	"reflect"
+)
+
+// Unwrap resolves database wrapper types before rule evaluation. driver.Valuer
+// implementations (sql.NullString, sql.NullInt64, sql.NullTime, ...) are replaced by
+// their driver value, so an invalid Null* becomes nil and []byte values become strings.
+// time.Time and all other values are returned unchanged.
+func Unwrap(value any) any {
+	valuer, ok := value.(driver.Valuer)
+	if !ok {
+		return value
+	}
+	if val := reflect.ValueOf(value); val.Kind() == reflect.Ptr && val.IsNil() {
+		return nil
+	}
+
+	driverValue, err := valuer.Value()
+	if err != nil {
+		return value
+	}
+	if raw, isBytes := driverValue.([]byte); isBytes {
+		return string(raw)
+	}
+	return driverValue
+}
+
+// UnwrapData applies Unwrap to every value of data, including those of nested maps and []any
+// slices. data is returned as is when there is nothing to unwrap and copied otherwise, so the
+// input is never modified.
+func UnwrapData(data map[string]any) map[string]any {
+	unwrapped, _ := unwrapMap(data)
+	return unwrapped
+}
+
+// UnwrapValue applies Unwrap to value and, for nested maps and []any slices, to the values they
+// hold. Containers are copied when anything changes.
+func UnwrapValue(value any) any {
+	unwrapped, _ := unwrapNested(value)
+	return unwrapped
+}
+
+// unwrapMap unwraps the values of data, reporting whether any changed
+func unwrapMap(data map[string]any) (map[string]any, bool) {
+	var copied map[string]any
+	for key, value := range data {
+		unwrapped, changed := unwrapNested(value)
+		if !changed {
+			continue
+		}
+		if copied == nil {
+			copied = make(map[string]any, len(data))
+			for k, v := range data {
+				copied[k] = v
+			}
+		}
+		copied[key] = unwrapped
+	}
+	if copied == nil {
+		return data, false
+	}
+	return copied, true
+}
+
+// unwrapNested unwraps value or the values it holds, reporting whether any changed
+func unwrapNested(value any) (any, bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		return unwrapMap(v)
+	case []any:
+		var copied []any
+		for i, item := range v {
+			if unwrapped, changed := unwrapNested(item); changed {
+				if copied == nil {
+					copied = append([]any(nil), v...)
+				}
+				copied[i] = unwrapped
+			}
+		}
+		if copied == nil {
+			return v, false
+		}
+		return copied, true
+	case driver.Valuer:
+		return Unwrap(v), true
+	}
+	return value, false
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUnwrap(t *testing.T) {
+	now := time.Now()
+	var nilNull *sql.NullString
+
+	tests := []struct {
+		name  string
+		value any
+		want  any
+	}{
+		{"valid null string", sql.NullString{String: "x", Valid: true}, "x"},
+		{"invalid null string", sql.NullString{}, nil},
+		{"valid null int", sql.NullInt64{Int64: 7, Valid: true}, int64(7)},
+		{"null time", sql.NullTime{Time: now, Valid: true}, now},
+		{"raw bytes", sql.RawBytes("abc"), sql.RawBytes("abc")},
+		{"nil pointer valuer", nilNull, nil},
+		{"time untouched", now, now},
+		{"plain value", 3, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Unwrap(tt.value)
+			if raw, ok := tt.want.(sql.RawBytes); ok {
+				if string(got.(sql.RawBytes)) != string(raw) {
+					t.Fatalf("Unwrap() = %#v, want %#v", got, tt.want)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Fatalf("Unwrap() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnwrapData(t *testing.T) {
+	plain := map[string]any{"name": "Ann", "tags": []any{"a"}}
+	if got := UnwrapData(plain); reflect.ValueOf(got).Pointer() != reflect.ValueOf(plain).Pointer() {
+		t.Fatal("expected data without database values to be returned as is")
+	}
+
+	data := map[string]any{
+		"name":  sql.NullString{String: "Ann", Valid: true},
+		"items": []any{map[string]any{"qty": sql.NullInt64{Int64: 2, Valid: true}}, "x"},
+	}
+	got := UnwrapData(data)
+	items := got["items"].([]any)
+	if got["name"] != "Ann" || items[0].(map[string]any)["qty"] != int64(2) || items[1] != "x" {
+		t.Fatalf("unexpected unwrapped data: %#v", got)
+	}
+	if _, wrapped := data["name"].(sql.NullString); !wrapped {
+		t.Fatal("expected the input to be left untouched")
+	}
+}
+
+func TestUnwrapValue(t *testing.T) {
+	nested := map[string]any{"city": sql.NullString{String: "Oslo", Valid: true}}
+	if got := UnwrapValue(nested).(map[string]any); got["city"] != "Oslo" {
+		t.Fatalf("unexpected unwrapped value: %#v", got)
+	}
+	if got := UnwrapValue(sql.NullInt64{}); got != nil {
+		t.Fatalf("expected an invalid null to unwrap to nil, got %#v", got)
+	}
+}
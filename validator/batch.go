@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"sort"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+)
+
+// RuleCount is how often a rule failed across a batch
+type RuleCount struct {
+	Rule  string
+	Count int
+}
+
+// BatchResult holds the result of every record of a batch, by index, and aggregate stats
+type BatchResult struct {
+	Results []contract.Result
+	Valid   int
+	Invalid int
+
+	// RuleFailures counts the failures of each rule across all records and fields
+	RuleFailures map[string]int
+}
+
+// InvalidIndexes returns the indexes of the records that failed validation, in order
+func (b *BatchResult) InvalidIndexes() []int {
+	indexes := make([]int, 0, b.Invalid)
+	for i, result := range b.Results {
+		if !result.IsValid() {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// TopFailingRules returns the n rules that failed most, most frequent first (all when n <= 0)
+func (b *BatchResult) TopFailingRules(n int) []RuleCount {
+	counts := make([]RuleCount, 0, len(b.RuleFailures))
+	for rule, count := range b.RuleFailures {
+		counts = append(counts, RuleCount{Rule: rule, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Rule < counts[j].Rule
+	})
+	if n > 0 && n < len(counts) {
+		counts = counts[:n]
+	}
+	return counts
+}
+
+// ValidateBatch validates every record against the same rules, e.g. the rows of an import job,
+// and returns their results by index with aggregate stats
+func (v *Validator) ValidateBatch(records []map[string]any, rules map[string]string) *BatchResult {
+	batch := &BatchResult{
+		Results:      make([]contract.Result, len(records)),
+		RuleFailures: make(map[string]int),
+	}
+	requestEngine := v.createRequestScopedEngine()
+	for i, record := range records {
+		result := requestEngine.Execute(engine.NewDataProvider(record), rules)
+		batch.add(i, result)
+	}
+	return batch
+}
+
+// add records the result of the record at index i
+func (b *BatchResult) add(i int, result contract.Result) {
+	b.Results[i] = result
+	if result.IsValid() {
+		b.Valid++
+		return
+	}
+	b.Invalid++
+	for rule, fields := range contract.ByRuleOf(result) {
+		b.RuleFailures[rule] += len(fields)
+	}
+}
@@ -0,0 +1,31 @@
+package validator
+
+import "testing"
+
+func TestValidator_ValidateBatch(t *testing.T) {
+	records := []map[string]any{
+		{"email": "jane@example.com", "name": "Jane"},
+		{"email": "nope", "name": ""},
+		{"email": "bad", "name": "Joe"},
+	}
+	rules := map[string]string{"email": "required|email", "name": "required"}
+
+	batch := New().ValidateBatch(records, rules)
+	if len(batch.Results) != 3 || batch.Valid != 1 || batch.Invalid != 2 {
+		t.Fatalf("unexpected counts: valid %d, invalid %d", batch.Valid, batch.Invalid)
+	}
+	if !batch.Results[0].IsValid() || batch.Results[2].FieldError("email") == "" {
+		t.Fatal("expected the results to keep the record order")
+	}
+	if got := batch.InvalidIndexes(); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("unexpected invalid indexes: %v", got)
+	}
+
+	top := batch.TopFailingRules(1)
+	if len(top) != 1 || top[0] != (RuleCount{Rule: "email", Count: 2}) {
+		t.Fatalf("unexpected top failing rules: %#v", top)
+	}
+	if all := batch.TopFailingRules(0); len(all) != 2 || all[1].Rule != "required" {
+		t.Fatalf("unexpected failing rules: %#v", all)
+	}
+}
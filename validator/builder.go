@@ -0,0 +1,14 @@
+package validator
+
+import (
+	"github.com/next-trace/scg-validator/builder"
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+)
+
+// ValidateBuilder resolves the builder's conditional blocks against data and validates the result,
+// applying the messages and attributes of the rule sets it extends
+func (v *Validator) ValidateBuilder(data any, b *builder.Builder) contract.Result {
+	dataProvider := engine.NewDataProvider(v.toDataMap(data))
+	return v.validateRuleSet(dataProvider, b.BuildRuleSet(dataProvider))
+}
@@ -0,0 +1,27 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/builder"
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func TestValidator_ValidateBuilder(t *testing.T) {
+	b := builder.New().
+		Field("type", "required").
+		When(func(data contract.DataProvider) bool {
+			kind, _ := data.Get("type")
+			return kind == "company"
+		}, func(b *builder.Builder) {
+			b.Field("vat_id", "required")
+		})
+
+	v := New()
+	if res := v.ValidateBuilder(map[string]any{"type": "person"}, b); !res.IsValid() {
+		t.Fatalf("expected person to pass, got %#v", res.Errors())
+	}
+	if res := v.ValidateBuilder(map[string]any{"type": "company"}, b); !res.HasFieldError("vat_id") {
+		t.Fatalf("expected vat_id to be required for companies, got %#v", res.Errors())
+	}
+}
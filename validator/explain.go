@@ -0,0 +1,36 @@
+package validator
+
+import (
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+)
+
+// explainer is implemented by engines that can describe their execution plan
+type explainer interface {
+	Explain(rulesMap map[string]string) []contract.FieldPlan
+}
+
+// planner is implemented by engines that can describe their execution plan for given data
+type planner interface {
+	Plan(data contract.DataProvider, rulesMap map[string]string) []contract.FieldPlan
+}
+
+// ExplainRules returns the fully normalized rule plan for rules, sorted by field,
+// so developers can verify what will actually run. It returns nil when the
+// underlying engine cannot explain its plan.
+func (v *Validator) ExplainRules(rules map[string]string) []contract.FieldPlan {
+	if e, ok := v.engine.(explainer); ok {
+		return e.Explain(rules)
+	}
+	return nil
+}
+
+// PlanRules returns the rules that would run for each field of data without validating it:
+// wildcards are expanded and conditional rules only appear, with their Reason, while their
+// condition holds. It returns nil when the underlying engine cannot plan.
+func (v *Validator) PlanRules(data any, rules map[string]string) []contract.FieldPlan {
+	if e, ok := v.engine.(planner); ok {
+		return e.Plan(engine.NewDataProvider(v.toDataMap(data)), rules)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package validator
+
+import "testing"
+
+func TestValidator_ExplainRules(t *testing.T) {
+	v := New()
+	plans := v.ExplainRules(map[string]string{
+		"name":  "required| min:2 ",
+		"email": "bail|required|email|no_such_rule:x",
+	})
+
+	if len(plans) != 2 || plans[0].Field != "email" || plans[1].Field != "name" {
+		t.Fatalf("expected plans sorted by field, got %#v", plans)
+	}
+
+	if got := plans[0].String(); got != "email: bail|required|email|no_such_rule:x?" {
+		t.Fatalf("unexpected email plan: %q", got)
+	}
+	if plans[0].Rules[2].Registered {
+		t.Fatalf("expected unknown rule to be reported as unregistered")
+	}
+	if got := plans[1].String(); got != "name: required|min:2" {
+		t.Fatalf("unexpected name plan: %q", got)
+	}
+}
+
+func TestValidator_PlanRules(t *testing.T) {
+	v := New()
+	rules := map[string]string{
+		"company":     "required_if:type,premium|max:50",
+		"vat_id":      "required_unless:type,premium",
+		"nickname":    "nullable|min:3",
+		"items.*.sku": "required|alpha",
+	}
+
+	data := map[string]any{
+		"type":     "premium",
+		"nickname": nil,
+		"items":    []any{map[string]any{"sku": "a"}, map[string]any{"sku": "b"}},
+	}
+	plans := v.PlanRules(data, rules)
+
+	var got []string
+	for _, plan := range plans {
+		got = append(got, plan.String())
+	}
+	want := []string{
+		"company: required_if:type,premium|max:50",
+		"items.0.sku: required|alpha",
+		"items.1.sku: required|alpha",
+		"nickname: ",
+		"vat_id: ",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected plans: %q", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("unexpected plans: %q", got)
+		}
+	}
+	if reason := plans[0].Rules[0].Reason; reason != "type=premium" {
+		t.Fatalf("expected the condition as reason, got %q", reason)
+	}
+
+	plans = v.PlanRules(map[string]any{"type": "basic"}, map[string]string{"vat_id": "required_unless:type,premium"})
+	if len(plans[0].Rules) != 1 || plans[0].Rules[0].Reason != "type!=premium" {
+		t.Fatalf("expected required_unless to apply, got %#v", plans)
+	}
+}
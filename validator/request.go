@@ -0,0 +1,62 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+)
+
+// ValidateRequest validates data against the rules, messages and attributes
+// declared by a request DTO (Laravel Form Request style).
+// If req also implements contract.AuthorizingRequest and Authorize returns false,
+// contract.ErrUnauthorized is returned and no rule is evaluated.
+func (v *Validator) ValidateRequest(ctx context.Context, req contract.ValidatedRequest, data any) error {
+	if authorizer, ok := req.(contract.AuthorizingRequest); ok && !authorizer.Authorize(ctx) {
+		return contract.ErrUnauthorized
+	}
+
+	// Request messages and attributes only apply to this request-scoped engine
+	requestEngine := v.createRequestScopedEngine()
+	withContext(requestEngine, ctx)
+	for rule, message := range req.Messages() {
+		requestEngine.SetCustomMessage(rule, message)
+	}
+	for field, attribute := range req.Attributes() {
+		requestEngine.SetCustomAttribute(field, attribute)
+	}
+
+	input := v.toDataMap(data)
+	if sanitizing, ok := req.(contract.SanitizingRequest); ok {
+		sanitized, failures := sanitize(input, sanitizing.Sanitizers())
+		if failures != nil {
+			return failures
+		}
+		input = sanitized
+	}
+
+	dataProvider := engine.NewDataProvider(input)
+	return resultError(requestEngine.Execute(dataProvider, req.Rules()))
+}
+
+// contextualEngine is implemented by engines that hand a request context to rules
+type contextualEngine interface {
+	SetContext(ctx context.Context)
+}
+
+// withContext sets ctx on a request-scoped engine when it supports contexts
+func withContext(requestEngine contract.ValidationEngine, ctx context.Context) {
+	if e, ok := requestEngine.(contextualEngine); ok {
+		e.SetContext(ctx)
+	}
+}
+
+// ValidateWithContext validates data like ValidateWithResult, handing ctx to rules that
+// perform I/O (e.g. exists/unique presence verifiers) so cancellation and deadlines apply.
+func (v *Validator) ValidateWithContext(ctx context.Context, data any, rules map[string]string) contract.Result {
+	requestEngine := v.createRequestScopedEngine()
+	withContext(requestEngine, ctx)
+
+	dataProvider := engine.NewDataProvider(v.toDataMap(data))
+	return requestEngine.Execute(dataProvider, rules)
+}
@@ -0,0 +1,83 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/registry/database"
+)
+
+type signupRequest struct{ allowed bool }
+
+func (r signupRequest) Rules() map[string]string {
+	return map[string]string{"email": "required|email"}
+}
+
+func (r signupRequest) Messages() map[string]string {
+	return map[string]string{"required.email": "We need your :attribute"}
+}
+
+func (r signupRequest) Attributes() map[string]string {
+	return map[string]string{"email": "e-mail"}
+}
+
+func (r signupRequest) Authorize(_ context.Context) bool { return r.allowed }
+
+func TestValidator_ValidateRequest(t *testing.T) {
+	v := New()
+
+	err := v.ValidateRequest(context.Background(), signupRequest{allowed: true}, map[string]any{"email": ""})
+	var ve *contract.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+	if got := ve.FieldError("email"); got != "We need your e-mail" {
+		t.Fatalf("unexpected message: %q", got)
+	}
+
+	if err := v.ValidateRequest(context.Background(), signupRequest{allowed: true},
+		map[string]any{"email": "a@example.com"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	err = v.ValidateRequest(context.Background(), signupRequest{allowed: false}, map[string]any{"email": ""})
+	if !errors.Is(err, contract.ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+
+	// request-level messages must not leak into the shared validator
+	res := v.ValidateWithResult(map[string]any{"email": ""}, map[string]string{"email": "required"})
+	if got := res.FieldError("email"); got == "We need your e-mail" {
+		t.Fatalf("request message leaked into validator: %q", got)
+	}
+}
+
+type requestIDKey struct{}
+
+// contextVerifier records the request context of the presence query it receives
+type contextVerifier struct{ requestID any }
+
+func (v *contextVerifier) Exists(_, _ string, _ any) (bool, error) { return true, nil }
+func (v *contextVerifier) Unique(_, _ string, _ any) (bool, error) { return true, nil }
+func (v *contextVerifier) Count(query contract.PresenceQuery) (int64, error) {
+	v.requestID = query.Context.Value(requestIDKey{})
+	return 0, nil
+}
+
+func TestValidator_ValidateWithContext(t *testing.T) {
+	verifier := &contextVerifier{}
+	database.RegisterPresenceVerifier("ctx_users", verifier)
+
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "req-1")
+	res := New().ValidateWithContext(ctx, map[string]any{"email": "a@b.co"}, map[string]string{
+		"email": "unique:ctx_users,email",
+	})
+	if !res.IsValid() {
+		t.Fatalf("expected valid result, got %#v", res.Errors())
+	}
+	if verifier.requestID != "req-1" {
+		t.Fatalf("expected request context to reach the verifier, got %v", verifier.requestID)
+	}
+}
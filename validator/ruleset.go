@@ -0,0 +1,107 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+	"github.com/next-trace/scg-validator/registry/ruleset"
+)
+
+// RegisterRuleSet registers a named, reusable rule set (e.g. "user.create") with its
+// custom messages, so handlers and tests can validate against it with ValidateNamed.
+func RegisterRuleSet(name string, rules, messages map[string]string) {
+	ruleset.RegisterRuleSet(name, newRuleSet(rules, messages))
+}
+
+// RegisterRuleSetVersion registers version of a named rule set (e.g. version 2 of "user.create"),
+// so services can keep validating older API versions with ValidateNamedVersion.
+// ValidateNamed uses the latest registered version.
+func RegisterRuleSetVersion(name string, version int, rules, messages map[string]string) {
+	ruleset.RegisterVersion(name, version, newRuleSet(rules, messages))
+}
+
+// newRuleSet builds a rule set from rules and messages
+func newRuleSet(rules, messages map[string]string) *contract.RuleSet {
+	set := contract.NewRuleSet()
+	for field, rule := range rules {
+		set.Rules[field] = rule
+	}
+	for key, message := range messages {
+		set.Messages[key] = message
+	}
+	return set
+}
+
+// ValidateNamed validates data against the rule set registered under name.
+// It returns contract.ErrRuleSetNotFound when no such rule set exists.
+func (v *Validator) ValidateNamed(data any, name string) (contract.Result, error) {
+	set, ok := ruleset.FindRuleSet(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", contract.ErrRuleSetNotFound, name)
+	}
+	return v.ValidateRuleSet(data, set), nil
+}
+
+// ValidateNamedVersion validates data against a specific version of the rule set registered under name.
+// It returns contract.ErrRuleSetNotFound when no such version exists.
+func (v *Validator) ValidateNamedVersion(data any, name string, version int) (contract.Result, error) {
+	set, err := findRuleSetVersion(name, version)
+	if err != nil {
+		return nil, err
+	}
+	return v.ValidateRuleSet(data, set), nil
+}
+
+// DiffRuleSetVersions lists the rule changes between two versions of a named rule set.
+// It returns contract.ErrRuleSetNotFound when either version does not exist.
+func DiffRuleSetVersions(name string, from, to int) ([]contract.RuleChange, error) {
+	fromSet, err := findRuleSetVersion(name, from)
+	if err != nil {
+		return nil, err
+	}
+	toSet, err := findRuleSetVersion(name, to)
+	if err != nil {
+		return nil, err
+	}
+	return fromSet.Diff(toSet), nil
+}
+
+// findRuleSetVersion looks up a rule set version, wrapping contract.ErrRuleSetNotFound
+func findRuleSetVersion(name string, version int) (*contract.RuleSet, error) {
+	set, ok := ruleset.FindVersion(name, version)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s@v%d", contract.ErrRuleSetNotFound, name, version)
+	}
+	return set, nil
+}
+
+// profiledEngine is implemented by engines configured with a rule set profile
+type profiledEngine interface {
+	ActiveProfile() string
+}
+
+// ValidateRuleSet validates data against a rule set, applying its messages and
+// attributes to this validation only. Overrides of the engine's profile
+// (see engine.WithProfile) replace the base rules.
+func (v *Validator) ValidateRuleSet(data any, set *contract.RuleSet) contract.Result {
+	return v.validateRuleSet(engine.NewDataProvider(v.toDataMap(data)), set)
+}
+
+// validateRuleSet validates the data of dataProvider against set, see ValidateRuleSet
+func (v *Validator) validateRuleSet(dataProvider contract.DataProvider, set *contract.RuleSet) contract.Result {
+	profile := ""
+	if e, ok := v.engine.(profiledEngine); ok {
+		profile = e.ActiveProfile()
+	}
+
+	requestEngine := v.createRequestScopedEngine()
+	for rule, message := range set.Messages {
+		requestEngine.SetCustomMessage(rule, message)
+	}
+	for field, attribute := range set.Attributes {
+		requestEngine.SetCustomAttribute(field, attribute)
+	}
+
+	return requestEngine.Execute(dataProvider, set.RulesFor(profile))
+}
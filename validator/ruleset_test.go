@@ -0,0 +1,93 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+	"github.com/next-trace/scg-validator/rules/loader"
+)
+
+func TestValidator_ValidateRuleSet(t *testing.T) {
+	set, err := loader.LoadJSON([]byte(`{
+		"fields": {"email": {"rules": ["required", "email"], "messages": {"required": "We need your :attribute"}}},
+		"attributes": {"email": "e-mail"}
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected load error: %v", err)
+	}
+
+	v := New()
+	res := v.ValidateRuleSet(map[string]any{}, set)
+	if msg := res.FieldError("email"); msg != "We need your e-mail" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+
+	// rule set messages must not leak into later validations
+	res = v.ValidateWithResult(map[string]any{}, map[string]string{"email": "required"})
+	if msg := res.FieldError("email"); msg != "The email field is required" {
+		t.Fatalf("rule set message leaked: %q", msg)
+	}
+}
+
+func TestValidator_ValidateNamed(t *testing.T) {
+	RegisterRuleSet("user.signup", map[string]string{"email": "required|email"}, map[string]string{
+		"email": "Please provide a real :attribute",
+	})
+
+	v := New()
+	res, err := v.ValidateNamed(map[string]any{"email": "nope"}, "user.signup")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg := res.FieldError("email"); msg != "Please provide a real email" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+
+	if _, err := v.ValidateNamed(nil, "user.unknown"); !errors.Is(err, contract.ErrRuleSetNotFound) {
+		t.Fatalf("expected rule set not found, got %v", err)
+	}
+}
+
+func TestValidator_ValidateNamedVersion(t *testing.T) {
+	RegisterRuleSetVersion("order.create", 1, map[string]string{"sku": "required"}, nil)
+	RegisterRuleSetVersion("order.create", 2, map[string]string{"sku": "required|uuid"}, nil)
+
+	v := New()
+	data := map[string]any{"sku": "ABC-1"}
+	if res, err := v.ValidateNamedVersion(data, "order.create", 1); err != nil || !res.IsValid() {
+		t.Fatalf("expected version 1 to accept legacy skus, got %v %v", res, err)
+	}
+	if res, err := v.ValidateNamed(data, "order.create"); err != nil || !res.HasFieldError("sku") {
+		t.Fatalf("expected the latest version to require a uuid, got %v %v", res, err)
+	}
+	if _, err := v.ValidateNamedVersion(data, "order.create", 3); !errors.Is(err, contract.ErrRuleSetNotFound) {
+		t.Fatalf("expected rule set not found, got %v", err)
+	}
+
+	changes, err := DiffRuleSetVersions("order.create", 1, 2)
+	if err != nil || len(changes) != 1 || changes[0].String() != "~ sku: required -> required|uuid" {
+		t.Fatalf("unexpected diff: %v %v", changes, err)
+	}
+}
+
+func TestValidator_ValidateRuleSet_Profile(t *testing.T) {
+	set, err := loader.LoadYAML([]byte(`
+fields:
+  website: required|url
+profiles:
+  staging:
+    website: nullable|url
+`))
+	if err != nil {
+		t.Fatalf("unexpected load error: %v", err)
+	}
+
+	if res := New().ValidateRuleSet(map[string]any{}, set); !res.HasFieldError("website") {
+		t.Fatal("expected base rules to require website")
+	}
+	if res := New(engine.WithProfile("staging")).ValidateRuleSet(map[string]any{}, set); !res.IsValid() {
+		t.Fatalf("expected staging profile to relax website, got %#v", res.Errors())
+	}
+}
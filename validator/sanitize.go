@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+	"github.com/next-trace/scg-validator/sanitizer"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// ValidateSanitized runs the per-field sanitizers (e.g. "email": "trim|lower") over a copy
+// of data and validates the sanitized values. The result's Validated() holds the sanitized input.
+func (v *Validator) ValidateSanitized(data any, sanitizers, rules map[string]string) contract.Result {
+	sanitized, failures := sanitize(v.toDataMap(data), sanitizers)
+	if failures != nil {
+		return failures
+	}
+
+	requestEngine := v.createRequestScopedEngine()
+	return requestEngine.Execute(engine.NewDataProvider(sanitized), rules)
+}
+
+// sanitize applies the sanitizers to a deep copy of data. Unknown sanitizers are
+// reported as field errors.
+func sanitize(data map[string]any, sanitizers map[string]string) (map[string]any, *contract.ValidationErrors) {
+	sanitized := utils.CloneData(data)
+	var failures *contract.ValidationErrors
+
+	for field, spec := range sanitizers {
+		value, exists := utils.GetPath(sanitized, field)
+		if !exists {
+			continue
+		}
+		cleaned, err := sanitizer.Apply(value, spec)
+		if err != nil {
+			if failures == nil {
+				failures = contract.NewValidationErrors()
+			}
+			failures.AddError(field, err.Error())
+			continue
+		}
+		utils.SetPath(sanitized, field, cleaned)
+	}
+	return sanitized, failures
+}
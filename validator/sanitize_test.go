@@ -0,0 +1,42 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func TestValidator_ValidateSanitized(t *testing.T) {
+	v := New()
+	data := map[string]any{
+		"email": "  John@Example.COM ",
+		"bio":   "<p>hello</p>",
+		"extra": "not validated",
+	}
+
+	res := v.ValidateSanitized(data,
+		map[string]string{"email": "trim|lower", "bio": "strip_tags"},
+		map[string]string{"email": "required|email", "bio": "max:5"},
+	)
+	if !res.IsValid() {
+		t.Fatalf("expected valid result, got %#v", res.Errors())
+	}
+
+	validated := contract.ValidatedOf(res)
+	if validated["email"] != "john@example.com" || validated["bio"] != "hello" {
+		t.Fatalf("unexpected validated data: %#v", validated)
+	}
+	if _, ok := validated["extra"]; ok {
+		t.Fatal("fields without rules must not be part of validated data")
+	}
+	if data["email"] != "  John@Example.COM " {
+		t.Fatal("input data must not be modified")
+	}
+}
+
+func TestValidator_ValidateSanitized_UnknownSanitizer(t *testing.T) {
+	res := New().ValidateSanitized(map[string]any{"name": "x"}, map[string]string{"name": "nope"}, nil)
+	if res.IsValid() || res.FieldError("name") != "unknown sanitizer: nope" {
+		t.Fatalf("expected unknown sanitizer error, got %#v", res.Errors())
+	}
+}
@@ -0,0 +1,18 @@
+package validator
+
+import "github.com/next-trace/scg-validator/engine"
+
+// tenantEngine is implemented by engines that validate with per-tenant policies
+type tenantEngine interface {
+	ForTenant(id string) *engine.Engine
+}
+
+// ForTenant returns a validator applying the policy registered for tenant id (see
+// tenant.RegisterTenant) on top of this validator's rules and messages. Engines without
+// tenant support return v itself.
+func (v *Validator) ForTenant(id string) *Validator {
+	if e, ok := v.engine.(tenantEngine); ok {
+		return &Validator{engine: e.ForTenant(id)}
+	}
+	return v
+}
@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+	"github.com/next-trace/scg-validator/registry/tenant"
+)
+
+type vatRule struct{}
+
+func (vatRule) Name() string { return "vat_id" }
+func (vatRule) Validate(ctx contract.RuleContext) error {
+	if ctx.Value() != "DE123" {
+		return contract.ErrInvalidData
+	}
+	return nil
+}
+
+func TestValidator_ForTenant(t *testing.T) {
+	tenant.RegisterTenant("validator_test.acme", contract.Config{
+		CustomRules: map[string]contract.RuleCreator{
+			"vat_id": func([]string) (contract.Rule, error) { return vatRule{}, nil },
+		},
+		CustomMessages: map[string]string{"required": "Acme needs :attribute"},
+		ExcludeRules:   map[string]bool{"email": true},
+	})
+
+	v := New()
+	rules := map[string]string{"name": "required", "contact": "email", "vat": "vat_id"}
+	data := map[string]any{"contact": "nope", "vat": "FR1"}
+
+	shared := v.ValidateWithResult(data, rules)
+	if shared.FieldError("name") != "The name field is required" || !shared.HasFieldError("contact") {
+		t.Fatalf("expected the shared policy, got %#v", shared.Errors())
+	}
+	if msg := shared.FieldError("vat"); msg == "" || v.HasRule("vat_id") {
+		t.Fatalf("expected vat_id to be unknown outside the tenant, got %q", msg)
+	}
+
+	res := v.ForTenant("validator_test.acme").ValidateWithResult(data, rules)
+	if msg := res.FieldError("name"); msg != "Acme needs name" {
+		t.Fatalf("expected the tenant message, got %q", msg)
+	}
+	if msg := res.FieldError("contact"); msg != engine.UnknownRuleErrorMsg+"email" {
+		t.Fatalf("expected email to be disabled for the tenant, got %q", msg)
+	}
+	if !res.HasFieldError("vat") || res.FieldError("vat") == engine.UnknownRuleErrorMsg+"vat_id" {
+		t.Fatalf("expected the tenant rule to run, got %#v", res.Errors())
+	}
+
+	// Request messages still win over tenant messages
+	set := contract.NewRuleSet()
+	set.Rules["name"] = "required"
+	set.Messages["required"] = "Request needs :attribute"
+	scoped := v.ForTenant("validator_test.acme").ValidateRuleSet(map[string]any{}, set)
+	if msg := scoped.FieldError("name"); msg != "Request needs name" {
+		t.Fatalf("expected the rule set message, got %q", msg)
+	}
+
+	tenantEngine := engine.NewEngine(engine.WithTenant("validator_test.acme"))
+	direct := tenantEngine.Execute(engine.NewDataProvider(map[string]any{}), map[string]string{"name": "required"})
+	if msg := direct.FieldError("name"); msg != "Acme needs name" {
+		t.Fatalf("expected WithTenant to apply the tenant message, got %q", msg)
+	}
+	shared = v.ValidateWithResult(map[string]any{}, map[string]string{"name": "required"})
+	if msg := shared.FieldError("name"); msg != "The name field is required" {
+		t.Fatalf("tenant message leaked into the shared validator: %q", msg)
+	}
+}
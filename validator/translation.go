@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/message"
+	"github.com/next-trace/scg-validator/registry/ruleset"
+)
+
+// keyCollector is implemented by message resolvers that can list their translation keys
+type keyCollector interface {
+	CollectKeys(c *message.KeyCollector, rules []string)
+}
+
+// TranslationKeys returns every message key translators need: the registered rules' catalog
+// messages (or the rule's own message when the catalog has none), custom messages and the
+// messages of registered rule sets, each with its placeholders. Write them as a skeleton with
+// message.WritePOT or message.WriteKeysJSON.
+func (v *Validator) TranslationKeys() []message.TranslationKey {
+	collector := message.NewKeyCollector()
+	rules := v.engine.GetRegistry().List()
+	if resolver, ok := v.engine.GetMessageResolver().(keyCollector); ok {
+		resolver.CollectKeys(collector, rules)
+	}
+
+	for _, name := range rules {
+		collector.Add(name, ruleMessage(v.engine.GetRegistry(), name), message.SourceRule)
+	}
+	for _, name := range ruleset.Names() {
+		set, _ := ruleset.FindRuleSet(name)
+		for key, msg := range set.Messages {
+			collector.Add(key, msg, message.SourceRuleSetPrefix+name)
+		}
+	}
+	return collector.Keys()
+}
+
+// ruleMessage returns the default message of a rule that can be created without parameters
+func ruleMessage(registry contract.Registry, name string) string {
+	creator, ok := registry.Get(name)
+	if !ok {
+		return ""
+	}
+	rule, err := creator(nil)
+	if err != nil {
+		return ""
+	}
+	if provider, ok := rule.(contract.MessageProvider); ok {
+		return provider.Message()
+	}
+	return ""
+}
@@ -0,0 +1,45 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/message"
+)
+
+func TestValidator_TranslationKeys(t *testing.T) {
+	RegisterRuleSet("translation.signup", map[string]string{"email": "required|email"}, map[string]string{
+		"email.email": "Please use a real address for :attribute",
+	})
+	v := New()
+	v.SetCustomMessage("required", "{{.Attribute}} is mandatory")
+	v.SetCustomMessageFunc("max.title", func(contract.RuleContext) string { return "Too long" })
+
+	keys := make(map[string]message.TranslationKey)
+	for _, key := range v.TranslationKeys() {
+		keys[key.Key] = key
+	}
+
+	for _, rule := range v.GetAvailableRules() {
+		if _, ok := keys[rule]; !ok {
+			t.Errorf("missing key for registered rule %q", rule)
+		}
+	}
+
+	between := keys["between"]
+	if between.Message != "The :attribute must be between :param0 and :param1" ||
+		!reflect.DeepEqual(between.Placeholders, []string{":attribute", ":param0", ":param1"}) {
+		t.Errorf("unexpected catalog key: %#v", between)
+	}
+	if required := keys["required"]; required.Message != "{{.Attribute}} is mandatory" ||
+		!reflect.DeepEqual(required.Placeholders, []string{".Attribute"}) {
+		t.Errorf("expected the custom message to win, got %#v", required)
+	}
+	if email := keys["email.email"]; !reflect.DeepEqual(email.Sources, []string{"ruleset:translation.signup"}) {
+		t.Errorf("unexpected rule set key: %#v", email)
+	}
+	if _, ok := keys["max.title"]; !ok {
+		t.Errorf("expected message function keys to be listed")
+	}
+}
@@ -7,11 +7,11 @@
 package validator
 
 import (
-	"errors"
-
 	"github.com/next-trace/scg-validator/contract"
 	"github.com/next-trace/scg-validator/engine"
 	"github.com/next-trace/scg-validator/message"
+	"github.com/next-trace/scg-validator/parser"
+	"github.com/next-trace/scg-validator/rules"
 )
 
 // Validator is the main facade that provides a simple interface for validator
@@ -20,43 +20,84 @@ type Validator struct {
 }
 
 // New creates a new validator with all Laravel rules registered
-func New() *Validator {
-	eng := engine.NewEngine()
+func New(options ...engine.Option) *Validator {
+	eng := engine.NewEngine(options...)
 
 	return &Validator{
 		engine: eng,
 	}
 }
 
+// NewModular creates a validator with only the built-in rules of modules, see engine.NewModularEngine
+func NewModular(modules []rules.Module, options ...engine.Option) *Validator {
+	return &Validator{
+		engine: engine.NewModularEngine(modules, options...),
+	}
+}
+
 // Validate validates data against the provided rules and returns an error
 func (v *Validator) Validate(data any, rules map[string]string) error {
-	result := v.ValidateWithResult(data, rules)
+	return resultError(v.ValidateWithResult(data, rules))
+}
+
+// resultError converts a failed result into an error, or returns nil when it is valid
+func resultError(result contract.Result) error {
 	if !result.IsValid() {
-		if validationErrors, ok := result.(*contract.ValidationErrors); ok {
-			return validationErrors
+		if err, ok := result.(error); ok {
+			return err
 		}
-		return errors.New("validator failed")
+		return contract.MergeResults(result)
 	}
 	return nil
 }
 
 // ValidateWithResult validates data against the provided rules and returns the full result
 func (v *Validator) ValidateWithResult(data any, rules map[string]string) contract.Result {
-	// Convert data to map[strings]any if needed
-	var dataMap map[string]any
+	// Create a request-scoped engine to ensure isolation between validation requests
+	requestEngine := v.createRequestScopedEngine()
+
+	dataProvider := engine.NewDataProvider(v.toDataMap(data))
+	return requestEngine.Execute(dataProvider, rules)
+}
+
+// ValidateScenario validates data against the rules of scenario (e.g. "create", "update").
+// Rule keys may be tagged with scenarios ("password@create,update"); untagged keys always apply
+// and are joined with the tagged rules of the same field, see parser.SelectScenario.
+func (v *Validator) ValidateScenario(data any, rules map[string]string, scenario string) contract.Result {
+	return v.ValidateWithResult(data, parser.SelectScenario(rules, scenario))
+}
+
+// structEngine is implemented by engines that choose how embedded struct fields are keyed
+type structEngine interface {
+	StructEmbedding() engine.EmbedMode
+}
+
+// embedMode returns how the engine keys embedded struct fields
+func (v *Validator) embedMode() engine.EmbedMode {
+	if e, ok := v.engine.(structEngine); ok {
+		return e.StructEmbedding()
+	}
+	return engine.EmbedPrefixed
+}
+
+// toDataMap converts supported input types to map[string]any
+func (v *Validator) toDataMap(data any) map[string]any {
 	switch d := data.(type) {
 	case map[string]any:
-		dataMap = d
+		return d
 	default:
-		// TODO: Handle other data types like structs
-		dataMap = make(map[string]any)
+		if structData := engine.StructToMapWith(d, v.embedMode()); structData != nil {
+			return structData
+		}
+		return make(map[string]any)
 	}
+}
 
-	// Create a request-scoped engine to ensure isolation between validation requests
-	requestEngine := v.createRequestScopedEngine()
-
-	dataProvider := engine.NewDataProvider(dataMap)
-	return requestEngine.Execute(dataProvider, rules)
+// ValidateStruct validates a struct (or pointer to a struct) against the rules declared
+// in its `validate` tags and those of the structs nested in it. Fields are keyed by their json tag
+// names, nested fields by dotted paths ("lines.0.sku").
+func (v *Validator) ValidateStruct(value any) contract.Result {
+	return v.ValidateWithResult(value, engine.StructRulesWith(value, v.embedMode()))
 }
 
 // AddRule adds a custom rule to the validator
@@ -101,11 +142,27 @@ func (v *Validator) SetCustomMessage(rule, message string) {
 	v.engine.SetCustomMessage(rule, message)
 }
 
+// SetCustomMessageFunc sets a function that builds the custom message for a rule from the
+// failing value and parameters, keyed like SetCustomMessage
+func (v *Validator) SetCustomMessageFunc(rule string, messageFunc contract.MessageFunc) {
+	if resolver, ok := v.engine.GetMessageResolver().(contract.MessageFuncResolver); ok {
+		resolver.SetCustomMessageFunc(rule, messageFunc)
+	}
+}
+
 // SetCustomAttribute sets a custom attribute name for a field
 func (v *Validator) SetCustomAttribute(field, name string) {
 	v.engine.SetCustomAttribute(field, name)
 }
 
+// SetLocaleAttribute sets the attribute name of a field for a locale, so localized messages
+// don't embed English field names
+func (v *Validator) SetLocaleAttribute(field, locale, name string) {
+	if resolver, ok := v.engine.GetMessageResolver().(contract.LocaleAttributeResolver); ok {
+		resolver.SetLocaleAttribute(field, locale, name)
+	}
+}
+
 // createRequestScopedEngine creates a new engine instance with isolated message resolver
 // This ensures that custom messages and attributes don't interfere between validation requests
 func (v *Validator) createRequestScopedEngine() contract.ValidationEngine {
@@ -2,9 +2,15 @@ package validator
 
 import (
 	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/engine"
+	"github.com/next-trace/scg-validator/registry"
+	"github.com/next-trace/scg-validator/rules"
 )
 
 func TestValidator_Validate_Success(t *testing.T) {
@@ -66,6 +72,32 @@ func TestValidator_CustomMessageAndAttribute(t *testing.T) {
 	}
 }
 
+func TestValidator_CustomMessageFunc(t *testing.T) {
+	v := New()
+	v.SetCustomMessageFunc("max.tags", func(ctx contract.RuleContext) string {
+		return fmt.Sprintf("Remove %d of your :attribute", len(ctx.Value().([]any))-3)
+	})
+
+	data := map[string]any{"tags": []any{"a", "b", "c", "d", "e"}}
+	res := v.ValidateWithResult(data, map[string]string{"tags": "max:3"})
+	if got := res.FieldError("tags"); got != "Remove 2 of your tags" {
+		t.Fatalf("unexpected message: %q", got)
+	}
+}
+
+func TestValidator_LocaleAttribute(t *testing.T) {
+	v := New(
+		engine.WithLocaleCatalog("de", map[string]string{"email": "Das Feld :attribute ist ungültig"}),
+		engine.WithLocale("de"),
+	)
+	v.SetLocaleAttribute("email", "de", "E-Mail-Adresse")
+
+	res := v.ValidateWithResult(map[string]any{"email": "nope"}, map[string]string{"email": "email"})
+	if got := res.FieldError("email"); got != "Das Feld E-Mail-Adresse ist ungültig" {
+		t.Fatalf("unexpected message: %q", got)
+	}
+}
+
 func TestValidator_HasRuleAndAvailable(t *testing.T) {
 	v := New()
 	if !v.HasRule("required") {
@@ -177,3 +209,134 @@ func TestValidator_MultiRuleIntegration(t *testing.T) {
 		}
 	}
 }
+
+type signupDTO struct {
+	Email   string `json:"email" validate:"required|email"`
+	Profile struct {
+		Nick string `json:"nick"`
+	} `json:"profile"`
+}
+
+func TestValidateStruct(t *testing.T) {
+	v := New()
+	if res := v.ValidateStruct(&signupDTO{Email: "a@b.co"}); !res.IsValid() {
+		t.Fatalf("expected valid struct, got %#v", res.Errors())
+	}
+
+	res := v.ValidateStruct(signupDTO{Email: "nope"})
+	if !res.HasFieldError("email") {
+		t.Fatalf("expected email error, got %#v", res.Errors())
+	}
+
+	// structs are also accepted by the rule-map API, including nested paths
+	dto := signupDTO{}
+	dto.Profile.Nick = "x"
+	if res := v.ValidateWithResult(dto, map[string]string{"profile.nick": "min:2"}); !res.HasFieldError("profile.nick") {
+		t.Fatalf("expected nested struct field error, got %#v", res.Errors())
+	}
+}
+
+type lineDTO struct {
+	SKU string `json:"sku" validate:"required|alpha_num"`
+}
+
+type orderDTO struct {
+	Email string    `json:"email" validate:"required|email"`
+	Lines []lineDTO `json:"lines" validate:"required"`
+	Ship  *struct {
+		City string `json:"city" validate:"required"`
+	} `json:"ship"`
+}
+
+func TestValidateStruct_Nested(t *testing.T) {
+	res := New().ValidateStruct(orderDTO{Email: "a@b.co", Lines: []lineDTO{{SKU: "A1"}, {SKU: "-"}}})
+	if len(res.Errors()) != 1 || !res.HasFieldError("lines.1.sku") {
+		t.Fatalf("expected an error for lines.1.sku only, got %#v", res.Errors())
+	}
+
+	dto := orderDTO{Email: "a@b.co", Lines: []lineDTO{{SKU: "A1"}}}
+	dto.Ship = &struct {
+		City string `json:"city" validate:"required"`
+	}{}
+	if res := New().ValidateStruct(dto); !res.HasFieldError("ship.city") {
+		t.Fatalf("expected an error for ship.city, got %#v", res.Errors())
+	}
+}
+
+type AuditFields struct {
+	CreatedBy string `json:"created_by" validate:"required"`
+}
+
+type articleDTO struct {
+	AuditFields
+	Title string `json:"title" validate:"required"`
+}
+
+func TestValidateStruct_EmbeddedStructs(t *testing.T) {
+	if res := New().ValidateStruct(articleDTO{Title: "x"}); !res.HasFieldError("AuditFields.created_by") {
+		t.Fatalf("expected a prefixed key by default, got %#v", res.Errors())
+	}
+
+	v := New(engine.WithEmbeddedStructs(engine.EmbedFlattened))
+	if res := v.ValidateStruct(articleDTO{Title: "x"}); !res.HasFieldError("created_by") {
+		t.Fatalf("expected a flattened key, got %#v", res.Errors())
+	}
+	if res := v.ValidateStruct(articleDTO{AuditFields: AuditFields{CreatedBy: "ann"}, Title: "x"}); !res.IsValid() {
+		t.Fatalf("expected the promoted field to be found in the data, got %#v", res.Errors())
+	}
+}
+
+// contactID is a value object that rules only understand through a registered type handler
+type contactID struct {
+	email string
+	rank  int
+}
+
+func TestValidator_CustomTypeHandlers(t *testing.T) {
+	registry.RegisterTypeHandler(reflect.TypeOf(contactID{}), contract.TypeHandler{
+		String: func(value any) (string, bool) { return value.(contactID).email, true },
+		Number: func(value any) (float64, bool) { return float64(value.(contactID).rank), true },
+		Empty:  func(value any) bool { return value.(contactID).email == "" },
+	})
+
+	v := New()
+	rules := map[string]string{"contact": "required|email|min:2|max:5"}
+	if err := v.Validate(map[string]any{"contact": contactID{email: "a@b.co", rank: 3}}, rules); err != nil {
+		t.Fatalf("expected handled type to pass, got %v", err)
+	}
+
+	res := v.ValidateWithResult(map[string]any{"contact": contactID{rank: 9}}, rules)
+	if len(res.Errors()["contact"]) != 3 {
+		t.Fatalf("expected required, email and max to fail, got %#v", res.Errors())
+	}
+}
+
+func TestValidator_ValidateScenario(t *testing.T) {
+	rules := map[string]string{
+		"email":           "required|email",
+		"password@create": "required|min:8",
+		"password@update": "nullable|min:8",
+	}
+
+	v := New()
+	if res := v.ValidateScenario(map[string]any{"email": "a@b.co"}, rules, "create"); !res.HasFieldError("password") {
+		t.Fatalf("expected create to require a password, got %#v", res.Errors())
+	}
+	if res := v.ValidateScenario(map[string]any{"email": "a@b.co", "password": nil}, rules, "update"); !res.IsValid() {
+		t.Fatalf("expected update to accept a missing password, got %#v", res.Errors())
+	}
+	if res := v.ValidateScenario(map[string]any{"password": "short"}, rules, "update"); len(res.Errors()) != 2 {
+		t.Fatalf("expected untagged and update rules to run, got %#v", res.Errors())
+	}
+}
+
+func TestNewModular(t *testing.T) {
+	v := NewModular([]rules.Module{rules.Core()})
+	if err := v.Validate(map[string]any{"age": 20}, map[string]string{"age": "required|min:18"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err := v.Validate(map[string]any{"email": "a@b.co"}, map[string]string{"email": "email"})
+	if err == nil || !strings.Contains(err.Error(), "Unknown rule") {
+		t.Fatalf("expected the email rule to be unknown, got %v", err)
+	}
+}
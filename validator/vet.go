@@ -0,0 +1,141 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/parser"
+	"github.com/next-trace/scg-validator/registry"
+	"github.com/next-trace/scg-validator/utils"
+)
+
+// fieldParamRules are the rules whose leading parameters name other fields: how many of them,
+// or -1 for all of them
+var fieldParamRules = map[string]int{
+	"required_if": 1, "required_unless": 1, "prohibited_if": 1, "prohibited_unless": 1,
+	"accepted_if": 1, "declined_if": 1, "same": 1, "different": 1,
+	"required_with": -1, "required_without": -1, "required_with_all": -1, "required_without_all": -1,
+	"required_together": -1, "prohibits": -1,
+}
+
+// scalarRules are the rules that only accept scalar values, so fields below them cannot exist
+var scalarRules = map[string]bool{
+	"boolean": true, "numeric": true, "integer": true, "decimal": true, "money": true, "date": true,
+	"alpha": true, "alpha_num": true, "alpha_dash": true, "email": true, "uuid": true, "url": true,
+	"active_url": true, "ip": true, "mac": true, "lowercase": true, "uppercase": true, "ascii": true,
+	"ulid": true, "slug": true,
+}
+
+// Vet checks a rule map against the default rules, see Validator.Vet
+func Vet(rules map[string]string) error {
+	return New().Vet(rules)
+}
+
+// Vet checks a rule map without validating anything, so misconfigured schemas fail at startup
+// or in tests rather than at request time: every rule must be registered (deprecated names
+// count when their replacement is), accept its parameters, wildcard fields must sit below a
+// list or map rather than a scalar field, and fields named by conditional rules (required_if,
+// same, ...) must appear in the map. It returns nil or an error wrapping contract.ErrInvalidRule
+// that lists every problem, sorted by field. Scenario tags ("password@create") are ignored.
+func (v *Validator) Vet(rules map[string]string) error {
+	fields := make(map[string]string, len(rules))
+	for key, ruleString := range rules {
+		field, _, _ := strings.Cut(key, parser.ScenarioSeparator)
+		fields[field] = joinRuleStrings(fields[field], ruleString)
+	}
+	ast := parser.Parse(fields)
+
+	var problems []string
+	for _, field := range ast.Fields() {
+		for _, node := range ast[field] {
+			if problem := v.vetRule(node); problem != "" {
+				problems = append(problems, field+": "+problem)
+			}
+			for _, ref := range referencedFields(node) {
+				if !declaresField(fields, ref) {
+					problems = append(problems, fmt.Sprintf("%s: %s references unknown field %q", field, node.Name, ref))
+				}
+			}
+		}
+		if parent, scalar := scalarParent(ast, field); scalar {
+			problems = append(problems, fmt.Sprintf("%s: wildcard below %s, whose rules only accept scalars", field, parent))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", contract.ErrInvalidRule, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// vetRule describes what is wrong with a single rule node, or returns ""
+func (v *Validator) vetRule(node parser.RuleNode) string {
+	reg := v.engine.GetRegistry()
+	name := node.Name
+	if replacement, deprecated := registry.FindDeprecation(name); deprecated && reg.Has(replacement) {
+		name = replacement
+	}
+	creator, ok := reg.Get(name)
+	if !ok {
+		return "unknown rule " + node.Name
+	}
+	if _, err := creator(node.Params); err != nil {
+		return node.Name + ": " + err.Error()
+	}
+	return ""
+}
+
+// referencedFields returns the fields a rule node names in its parameters
+func referencedFields(node parser.RuleNode) []string {
+	count, ok := fieldParamRules[node.Name]
+	if !ok {
+		return nil
+	}
+	if count < 0 || count > len(node.Params) {
+		count = len(node.Params)
+	}
+	return node.Params[:count]
+}
+
+// declaresField reports whether ref is one of fields, lies below one or contains one
+func declaresField(fields map[string]string, ref string) bool {
+	for field := range fields {
+		if ref == field || strings.HasPrefix(ref, field+".") || strings.HasPrefix(field, ref+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// scalarParent returns the field above the first wildcard of field and whether its rules only
+// accept scalar values
+func scalarParent(ast parser.AST, field string) (string, bool) {
+	if !utils.HasPathPattern(field) {
+		return "", false
+	}
+	segments := strings.Split(field, ".")
+	for i := range segments {
+		if !utils.HasPathPattern(segments[i]) {
+			continue
+		}
+		parent := strings.Join(segments[:i], ".")
+		for _, node := range ast[parent] {
+			if scalarRules[node.Name] && !node.Negated {
+				return parent, true
+			}
+		}
+		return parent, false
+	}
+	return "", false
+}
+
+// joinRuleStrings concatenates two pipe-separated rule strings
+func joinRuleStrings(existing, addition string) string {
+	if existing == "" {
+		return addition
+	}
+	if addition == "" {
+		return existing
+	}
+	return existing + "|" + addition
+}
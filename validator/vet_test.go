@@ -0,0 +1,49 @@
+package validator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/next-trace/scg-validator/contract"
+)
+
+func TestVet(t *testing.T) {
+	valid := map[string]string{
+		"email":           "bail|required|email",
+		"type":            "in:basic,premium",
+		"card":            "required_if:type,premium",
+		"items":           "required|min:1",
+		"items.*.sku":     "required|alpha_num",
+		"password@create": "required|min:8",
+		"password_check":  "same:password",
+	}
+	if err := Vet(valid); err != nil {
+		t.Fatalf("expected valid rules, got %v", err)
+	}
+
+	err := Vet(map[string]string{
+		"name":      "required|alpha|colour",
+		"age":       "between:1",
+		"tag":       "alpha",
+		"tag.*":     "required",
+		"card":      "required_with:typo",
+		"prefs.*.x": "required",
+	})
+	if !errors.Is(err, contract.ErrInvalidRule) {
+		t.Fatalf("expected an invalid rule error, got %v", err)
+	}
+	for _, want := range []string{
+		"age: between:",
+		`card: required_with references unknown field "typo"`,
+		"name: unknown rule colour",
+		"tag.*: wildcard below tag",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in %v", want, err)
+		}
+	}
+	if strings.Contains(err.Error(), "prefs") {
+		t.Errorf("did not expect a problem for a wildcard below an undeclared field: %v", err)
+	}
+}
@@ -0,0 +1,7 @@
+// Package webhook validates incoming webhook requests end to end: the signature (through a
+// pluggable Scheme), the freshness of the signed timestamp, then the JSON body against a rule
+// set. Failures are reported as a single *Error naming the stage that failed.
+//
+//	verifier := webhook.New(validator.New(), webhook.TimestampedHMAC(secret, "Webhook-Signature"), set)
+//	payload, err := verifier.Verify(r)
+package webhook
@@ -0,0 +1,123 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	errMissingSignature = errors.New("missing signature")
+	errInvalidSignature = errors.New("signature mismatch")
+	errMalformedHeader  = errors.New("malformed signature header")
+	errMissingTimestamp = errors.New("missing timestamp")
+)
+
+// Scheme verifies the signature of a webhook request. Schemes whose signature covers a
+// timestamp return it, so its freshness can be checked; others return the zero time.
+type Scheme interface {
+	Verify(r *http.Request, body []byte) (time.Time, error)
+}
+
+// SchemeFunc adapts a function to the Scheme interface
+type SchemeFunc func(r *http.Request, body []byte) (time.Time, error)
+
+// Verify calls f
+func (f SchemeFunc) Verify(r *http.Request, body []byte) (time.Time, error) {
+	return f(r, body)
+}
+
+// HMAC verifies a hex HMAC-SHA256 of the body sent in header, optionally prefixed with
+// "sha256=" (GitHub style)
+func HMAC(secret []byte, header string) Scheme {
+	return SchemeFunc(func(r *http.Request, body []byte) (time.Time, error) {
+		signature := strings.TrimPrefix(r.Header.Get(header), "sha256=")
+		if signature == "" {
+			return time.Time{}, errMissingSignature
+		}
+		return time.Time{}, checkSignature(secret, body, signature)
+	})
+}
+
+// HMACWithTimestamp verifies a hex HMAC-SHA256 sent in header, optionally prefixed with
+// "sha256=", for senders putting the timestamp, in unix seconds, in a header of its own. The
+// signature must cover "<timestamp>.<body>" so a replayed request cannot refresh the timestamp.
+func HMACWithTimestamp(secret []byte, header, timestampHeader string) Scheme {
+	return SchemeFunc(func(r *http.Request, body []byte) (time.Time, error) {
+		signature := strings.TrimPrefix(r.Header.Get(header), "sha256=")
+		if signature == "" {
+			return time.Time{}, errMissingSignature
+		}
+		timestamp := r.Header.Get(timestampHeader)
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return time.Time{}, errMissingTimestamp
+		}
+		if err := checkSignature(secret, append([]byte(timestamp+"."), body...), signature); err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(seconds, 0), nil
+	})
+}
+
+// TimestampedHMAC verifies a header of the form "t=<unix seconds>,v1=<hex signature>"
+// (Stripe style), where the HMAC-SHA256 covers "<t>.<body>". Several v1 signatures may be
+// sent during secret rotation; one matching is enough.
+func TimestampedHMAC(secret []byte, header string) Scheme {
+	return SchemeFunc(func(r *http.Request, body []byte) (time.Time, error) {
+		value := r.Header.Get(header)
+		if value == "" {
+			return time.Time{}, errMissingSignature
+		}
+
+		var timestamp string
+		var signatures []string
+		for _, part := range strings.Split(value, ",") {
+			key, val, _ := strings.Cut(strings.TrimSpace(part), "=")
+			switch key {
+			case "t":
+				timestamp = val
+			case "v1":
+				signatures = append(signatures, val)
+			}
+		}
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil || len(signatures) == 0 {
+			return time.Time{}, errMalformedHeader
+		}
+
+		signed := append([]byte(timestamp+"."), body...)
+		for _, signature := range signatures {
+			if checkSignature(secret, signed, signature) == nil {
+				return time.Unix(seconds, 0), nil
+			}
+		}
+		return time.Time{}, errInvalidSignature
+	})
+}
+
+// Sign returns the hex HMAC-SHA256 of payload, e.g. to sign outgoing webhooks or tests
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// checkSignature compares the hex signature of payload in constant time
+func checkSignature(secret, payload []byte, signature string) error {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return errMalformedHeader
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return errInvalidSignature
+	}
+	return nil
+}
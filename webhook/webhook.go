@@ -0,0 +1,152 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+	validatorErrors "github.com/next-trace/scg-validator/errors"
+	"github.com/next-trace/scg-validator/validator"
+)
+
+// Stage names the step of the verification that failed
+type Stage string
+
+// Verification stages, in order
+const (
+	StageRead      Stage = "read"
+	StageSignature Stage = "signature"
+	StageTimestamp Stage = "timestamp"
+	StageBody      Stage = "body"
+)
+
+// Defaults of a Verifier
+const (
+	DefaultTolerance   = 5 * time.Minute
+	DefaultMaxBodySize = 1 << 20
+)
+
+var (
+	errStaleTimestamp = errors.New("timestamp outside the tolerance")
+	errBodyTooLarge   = errors.New("body too large")
+)
+
+// Error describes which stage of a webhook verification failed. Result holds the validation
+// errors of the body stage.
+type Error struct {
+	Stage  Stage
+	Err    error
+	Result contract.Result
+}
+
+// Error renders the stage and its cause
+func (e *Error) Error() string {
+	if e.Result != nil {
+		return fmt.Sprintf("webhook %s: %s", e.Stage, e.Result.FirstError())
+	}
+	return fmt.Sprintf("webhook %s: %v", e.Stage, e.Err)
+}
+
+// Unwrap returns the cause of the failure
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Verifier checks webhook requests against a signature scheme and a rule set
+type Verifier struct {
+	validator   *validator.Validator
+	scheme      Scheme
+	set         *contract.RuleSet
+	tolerance   time.Duration
+	maxBodySize int64
+	now         func() time.Time
+}
+
+// Option configures a Verifier
+type Option func(*Verifier)
+
+// WithTolerance sets how old or far in the future a signed timestamp may be
+// (DefaultTolerance by default, 0 disables the check)
+func WithTolerance(tolerance time.Duration) Option {
+	return func(w *Verifier) {
+		w.tolerance = tolerance
+	}
+}
+
+// WithMaxBodySize bounds the body read from the request (DefaultMaxBodySize by default)
+func WithMaxBodySize(size int64) Option {
+	return func(w *Verifier) {
+		w.maxBodySize = size
+	}
+}
+
+// WithClock replaces the clock used for the freshness check
+func WithClock(now func() time.Time) Option {
+	return func(w *Verifier) {
+		w.now = now
+	}
+}
+
+// New creates a Verifier validating bodies against set with v
+func New(v *validator.Validator, scheme Scheme, set *contract.RuleSet, options ...Option) *Verifier {
+	w := &Verifier{
+		validator:   v,
+		scheme:      scheme,
+		set:         set,
+		tolerance:   DefaultTolerance,
+		maxBodySize: DefaultMaxBodySize,
+		now:         time.Now,
+	}
+	for _, option := range options {
+		option(w)
+	}
+	return w
+}
+
+// Verify reads the body of r, checks its signature and timestamp and validates it, returning
+// the decoded payload. r.Body is replaced so handlers can read it again.
+func (w *Verifier) Verify(r *http.Request) (map[string]any, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, w.maxBodySize+1))
+	if err != nil {
+		return nil, &Error{Stage: StageRead, Err: err}
+	}
+	if int64(len(body)) > w.maxBodySize {
+		return nil, &Error{Stage: StageRead, Err: errBodyTooLarge}
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	timestamp, err := w.scheme.Verify(r, body)
+	if err != nil {
+		return nil, &Error{Stage: StageSignature, Err: err}
+	}
+	if err := w.checkTimestamp(timestamp); err != nil {
+		return nil, &Error{Stage: StageTimestamp, Err: err}
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, &Error{Stage: StageBody, Err: err}
+	}
+	if result := w.validator.ValidateRuleSet(payload, w.set); !result.IsValid() {
+		return nil, &Error{Stage: StageBody, Err: validatorErrors.ErrValidationFailed, Result: result}
+	}
+	return payload, nil
+}
+
+// checkTimestamp checks the timestamp signed by the scheme against the tolerance. Only signed
+// timestamps are checked: an unsigned one could be replaced by anyone replaying a request.
+func (w *Verifier) checkTimestamp(timestamp time.Time) error {
+	if timestamp.IsZero() || w.tolerance <= 0 {
+		return nil
+	}
+	age := w.now().Sub(timestamp)
+	if age > w.tolerance || age < -w.tolerance {
+		return errStaleTimestamp
+	}
+	return nil
+}
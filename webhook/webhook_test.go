@@ -0,0 +1,115 @@
+package webhook_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/next-trace/scg-validator/contract"
+	"github.com/next-trace/scg-validator/validator"
+	"github.com/next-trace/scg-validator/webhook"
+)
+
+var (
+	secret = []byte("whsec_test")
+	now    = time.Unix(1700000000, 0)
+)
+
+func newVerifier() *webhook.Verifier {
+	set := contract.NewRuleSet()
+	set.Rules["type"] = "required|in:invoice.paid,invoice.failed"
+	set.Rules["id"] = "required"
+	return webhook.New(validator.New(), webhook.TimestampedHMAC(secret, "Webhook-Signature"), set,
+		webhook.WithClock(func() time.Time { return now }))
+}
+
+func signedRequest(body string, timestamp time.Time, key []byte) *http.Request {
+	r := httptest.NewRequest("POST", "/webhooks", strings.NewReader(body))
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	r.Header.Set("Webhook-Signature", "t="+t+",v1="+webhook.Sign(key, []byte(t+"."+body)))
+	return r
+}
+
+func TestVerifier_Stages(t *testing.T) {
+	valid := `{"type":"invoice.paid","id":"evt_1"}`
+	for _, tc := range []struct {
+		name  string
+		req   *http.Request
+		stage webhook.Stage
+	}{
+		{"wrong secret", signedRequest(valid, now, []byte("other")), webhook.StageSignature},
+		{"missing signature", httptest.NewRequest("POST", "/webhooks", strings.NewReader(valid)), webhook.StageSignature},
+		{"stale timestamp", signedRequest(valid, now.Add(-time.Hour), secret), webhook.StageTimestamp},
+		{"malformed body", signedRequest(`{"type":`, now, secret), webhook.StageBody},
+		{"invalid body", signedRequest(`{"type":"refund"}`, now, secret), webhook.StageBody},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newVerifier().Verify(tc.req)
+			var webhookErr *webhook.Error
+			if !errors.As(err, &webhookErr) || webhookErr.Stage != tc.stage {
+				t.Fatalf("expected a %s failure, got %v", tc.stage, err)
+			}
+		})
+	}
+
+	_, err := newVerifier().Verify(signedRequest(`{"type":"refund"}`, now, secret))
+	var webhookErr *webhook.Error
+	if !errors.As(err, &webhookErr) || !webhookErr.Result.HasFieldError("id") {
+		t.Fatalf("expected the body errors on the result, got %v", err)
+	}
+}
+
+func TestVerifier_AcceptsValidWebhook(t *testing.T) {
+	body := `{"type":"invoice.paid","id":"evt_1"}`
+	r := signedRequest(body, now.Add(-time.Minute), secret)
+
+	payload, err := newVerifier().Verify(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload["id"] != "evt_1" {
+		t.Fatalf("unexpected payload: %#v", payload)
+	}
+	if reread, _ := io.ReadAll(r.Body); string(reread) != body {
+		t.Fatalf("expected the body to be readable again, got %q", reread)
+	}
+}
+
+func TestVerifier_HMACWithTimestamp(t *testing.T) {
+	body := `{"type":"invoice.failed","id":"evt_2"}`
+	set := contract.NewRuleSet()
+	set.Rules["id"] = "required"
+	verifier := webhook.New(validator.New(), webhook.HMACWithTimestamp(secret, "X-Hub-Signature-256", "X-Timestamp"),
+		set, webhook.WithClock(func() time.Time { return now }))
+	request := func(signed, sent time.Time) *http.Request {
+		r := httptest.NewRequest("POST", "/webhooks", strings.NewReader(body))
+		t := strconv.FormatInt(signed.Unix(), 10)
+		r.Header.Set("X-Hub-Signature-256", "sha256="+webhook.Sign(secret, []byte(t+"."+body)))
+		r.Header.Set("X-Timestamp", strconv.FormatInt(sent.Unix(), 10))
+		return r
+	}
+
+	if _, err := verifier.Verify(request(now, now)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := request(now, now)
+	r.Header.Del("X-Timestamp")
+	if _, err := verifier.Verify(r); err == nil {
+		t.Fatal("expected the missing timestamp header to fail")
+	}
+
+	var verr *webhook.Error
+	stale := now.Add(-time.Hour)
+	if _, err := verifier.Verify(request(stale, now)); !errors.As(err, &verr) || verr.Stage != webhook.StageSignature {
+		t.Fatalf("expected a replay with a refreshed timestamp to fail the signature, got %v", err)
+	}
+	if _, err := verifier.Verify(request(stale, stale)); !errors.As(err, &verr) || verr.Stage != webhook.StageTimestamp {
+		t.Fatalf("expected a stale signed timestamp to fail, got %v", err)
+	}
+}